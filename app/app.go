@@ -36,7 +36,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -294,21 +293,23 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:       nil,
-		DexRevenueCollectorName:          nil,
-		icatypes.ModuleName:              nil,
-		ibcfeetypes.ModuleName:           nil,
-		minttypes.ModuleName:             {authtypes.Minter},
-		stakingtypes.BondedPoolName:      {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:   {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:              {authtypes.Burner},
-		ibctransfertypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
-		commitmentmoduletypes.ModuleName: {authtypes.Minter, authtypes.Burner},
-		burnermoduletypes.ModuleName:     {authtypes.Burner},
-		incentivemoduletypes.ModuleName:  nil,
-		ammmoduletypes.ModuleName:        {authtypes.Minter, authtypes.Burner, authtypes.Staking},
-		wasmmoduletypes.ModuleName:       {authtypes.Burner},
-		stablestaketypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
+		authtypes.FeeCollectorName:          nil,
+		DexRevenueCollectorName:             nil,
+		icatypes.ModuleName:                 nil,
+		ibcfeetypes.ModuleName:              nil,
+		minttypes.ModuleName:                {authtypes.Minter},
+		stakingtypes.BondedPoolName:         {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                 {authtypes.Burner},
+		ibctransfertypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
+		commitmentmoduletypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		burnermoduletypes.ModuleName:        {authtypes.Burner},
+		incentivemoduletypes.ModuleName:     nil,
+		ammmoduletypes.ModuleName:           {authtypes.Minter, authtypes.Burner, authtypes.Staking},
+		wasmmoduletypes.ModuleName:          {authtypes.Burner},
+		stablestaketypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
+		marginmoduletypes.ModuleName:        nil,
+		marginmoduletypes.InsuranceFundName: nil,
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 )
@@ -740,6 +741,7 @@ func NewElysApp(
 		&app.CommitmentKeeper,
 		app.AssetprofileKeeper,
 		app.AccountedPoolKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	ammModule := ammmodule.NewAppModule(appCodec, app.AmmKeeper, app.AccountKeeper, app.BankKeeper)
 
@@ -1325,9 +1327,7 @@ func (app *ElysApp) setAnteHandler(txConfig client.TxConfig, wasmConfig wasmmodu
 }
 
 func (app *ElysApp) setPostHandler() {
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
-	)
+	postHandler, err := NewPostHandler(app.AmmKeeper)
 	if err != nil {
 		panic(err)
 	}
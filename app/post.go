@@ -0,0 +1,35 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ammkeeper "github.com/elys-network/elys/x/amm/keeper"
+)
+
+// FlashSwapDecorator fails the tx when an amm flash swap is left unpaid or when a pool
+// lent by a flash swap doesn't hold its recorded reserves at the end of the tx.
+type FlashSwapDecorator struct {
+	ak ammkeeper.Keeper
+}
+
+func NewFlashSwapDecorator(ak ammkeeper.Keeper) FlashSwapDecorator {
+	return FlashSwapDecorator{ak: ak}
+}
+
+func (fsd FlashSwapDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if success {
+		if err := fsd.ak.CheckFlashSwaps(ctx); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// NewPostHandler returns a PostHandler run after the messages of every tx.
+func NewPostHandler(ammKeeper ammkeeper.Keeper) (sdk.PostHandler, error) {
+	postDecorators := []sdk.PostDecorator{
+		NewFlashSwapDecorator(ammKeeper),
+	}
+
+	return sdk.ChainPostDecorators(postDecorators...), nil
+}
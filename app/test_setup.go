@@ -27,6 +27,7 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	assetprofiletypes "github.com/elys-network/elys/x/assetprofile/types"
 	"github.com/elys-network/elys/x/commitment/types"
 	ctypes "github.com/elys-network/elys/x/commitment/types"
 	ptypes "github.com/elys-network/elys/x/parameter/types"
@@ -288,3 +289,20 @@ func AddTestCommitment(app *ElysApp, ctx sdk.Context, address sdk.AccAddress, co
 
 	app.CommitmentKeeper.SetCommitments(ctx, commitment)
 }
+
+// Add asset profile entries allowing the denoms in pool creation
+func AddTestPoolCreationPermissions(app *ElysApp, ctx sdk.Context, denoms ...string) {
+	for _, denom := range denoms {
+		entry, found := app.AssetprofileKeeper.GetEntry(ctx, denom)
+		if !found {
+			entry = assetprofiletypes.Entry{
+				BaseDenom: denom,
+				Denom:     denom,
+			}
+		}
+		if !entry.HasPermission(assetprofiletypes.PermissionPoolCreation) {
+			entry.Permissions = append(entry.Permissions, assetprofiletypes.PermissionPoolCreation)
+		}
+		app.AssetprofileKeeper.SetEntry(ctx, entry)
+	}
+}
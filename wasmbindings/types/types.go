@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"time"
 
 	cosmos_sdk_math "cosmossdk.io/math"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -63,10 +64,11 @@ func AllCapabilities() []string {
 }
 
 type ElysQuery struct {
-	PriceAll            *PriceAll                   `json:"price_all,omitempty"`
-	QuerySwapEstimation *QuerySwapEstimationRequest `json:"query_swap_estimation,omitempty"`
-	AssetInfo           *AssetInfo                  `json:"asset_info,omitempty"`
-	BalanceOfDenom      *QueryBalanceRequest        `json:"balance_of_denom,omitempty"`
+	PriceAll            *PriceAll                     `json:"price_all,omitempty"`
+	QuerySwapEstimation *QuerySwapEstimationRequest   `json:"query_swap_estimation,omitempty"`
+	AssetInfo           *AssetInfo                    `json:"asset_info,omitempty"`
+	BalanceOfDenom      *QueryBalanceRequest          `json:"balance_of_denom,omitempty"`
+	PoolTwap            *ammtype.QueryPoolTwapRequest `json:"pool_twap,omitempty"`
 }
 
 type PriceAll struct {
@@ -125,6 +127,9 @@ type CustomMessenger struct {
 
 type ElysMsg struct {
 	MsgSwapExactAmountIn           *MsgSwapExactAmountIn                                    `json:"msg_swap_exact_amount_in,omitempty"`
+	MsgSwapExactAmountOut          *MsgSwapExactAmountOut                                   `json:"msg_swap_exact_amount_out,omitempty"`
+	MsgFlashSwap                   *MsgFlashSwap                                            `json:"msg_flash_swap,omitempty"`
+	MsgRepayFlashSwap              *MsgRepayFlashSwap                                       `json:"msg_repay_flash_swap,omitempty"`
 	MsgOpen                        *MsgOpen                                                 `json:"msg_open,omitempty"`
 	MsgClose                       *MsgClose                                                `json:"msg_close,omitempty"`
 	MsgStake                       *MsgStake                                                `json:"msg_stake,omitempty"`
@@ -143,6 +148,7 @@ type MsgSwapExactAmountIn struct {
 	TokenIn           sdk.Coin                    `protobuf:"bytes,3,opt,name=tokenIn,proto3" json:"token_in,omitempty"`
 	TokenOutMinAmount cosmos_sdk_math.Int         `protobuf:"bytes,4,opt,name=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_min_amount,omitempty"`
 	MetaData          *[]byte                     `protobuf:"bytes,5,opt,name=tokenData,proto3" json:"meta_data,omitempty"`
+	Deadline          *time.Time                  `protobuf:"bytes,6,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
 }
 
 type MsgSwapExactAmountInResponse struct {
@@ -150,6 +156,43 @@ type MsgSwapExactAmountInResponse struct {
 	MetaData       *[]byte             `protobuf:"bytes,2,opt,name=tokenData,proto3" json:"meta_data,omitempty"`
 }
 
+type MsgSwapExactAmountOut struct {
+	Sender           string                       `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Routes           []ammtype.SwapAmountOutRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes,omitempty"`
+	TokenOut         sdk.Coin                     `protobuf:"bytes,3,opt,name=tokenOut,proto3" json:"token_out,omitempty"`
+	TokenInMaxAmount cosmos_sdk_math.Int          `protobuf:"bytes,4,opt,name=tokenInMaxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_max_amount,omitempty"`
+	MetaData         *[]byte                      `protobuf:"bytes,5,opt,name=tokenData,proto3" json:"meta_data,omitempty"`
+	Deadline         *time.Time                   `protobuf:"bytes,6,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
+}
+
+type MsgSwapExactAmountOutResponse struct {
+	TokenInAmount cosmos_sdk_math.Int `protobuf:"bytes,1,opt,name=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount,omitempty"`
+	MetaData      *[]byte             `protobuf:"bytes,2,opt,name=tokenData,proto3" json:"meta_data,omitempty"`
+}
+
+type MsgFlashSwap struct {
+	PoolId           uint64              `protobuf:"varint,1,opt,name=poolId,proto3" json:"pool_id,omitempty"`
+	TokenOut         sdk.Coin            `protobuf:"bytes,2,opt,name=tokenOut,proto3" json:"token_out,omitempty"`
+	TokenInDenom     string              `protobuf:"bytes,3,opt,name=tokenInDenom,proto3" json:"token_in_denom,omitempty"`
+	TokenInMaxAmount cosmos_sdk_math.Int `protobuf:"bytes,4,opt,name=tokenInMaxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_max_amount,omitempty"`
+	MetaData         *[]byte             `protobuf:"bytes,5,opt,name=tokenData,proto3" json:"meta_data,omitempty"`
+}
+
+type MsgFlashSwapResponse struct {
+	TokenIn  sdk.Coin `protobuf:"bytes,1,opt,name=tokenIn,proto3" json:"token_in,omitempty"`
+	MetaData *[]byte  `protobuf:"bytes,2,opt,name=tokenData,proto3" json:"meta_data,omitempty"`
+}
+
+type MsgRepayFlashSwap struct {
+	PoolId   uint64  `protobuf:"varint,1,opt,name=poolId,proto3" json:"pool_id,omitempty"`
+	MetaData *[]byte `protobuf:"bytes,2,opt,name=tokenData,proto3" json:"meta_data,omitempty"`
+}
+
+type MsgRepayFlashSwapResponse struct {
+	TokenIn  sdk.Coin `protobuf:"bytes,1,opt,name=tokenIn,proto3" json:"token_in,omitempty"`
+	MetaData *[]byte  `protobuf:"bytes,2,opt,name=tokenData,proto3" json:"meta_data,omitempty"`
+}
+
 type MsgOpen struct {
 	Creator          string               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	CollateralAsset  string               `protobuf:"bytes,2,opt,name=collateralAsset,proto3" json:"collateral_asset,omitempty"`
@@ -159,12 +202,14 @@ type MsgOpen struct {
 	Leverage         sdk.Dec              `protobuf:"bytes,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
 	TakeProfitPrice  sdk.Dec              `protobuf:"bytes,7,opt,name=takeProfitPrice,proto3" json:"take_profit_price,omitempty"`
 	MetaData         *[]byte              `protobuf:"bytes,8,opt,name=tokenData,proto3" json:"meta_data,omitempty"`
+	StopLossPrice    sdk.Dec              `protobuf:"bytes,9,opt,name=stopLossPrice,proto3" json:"stop_loss_price,omitempty"`
 }
 
 type MsgClose struct {
 	Creator  string  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Id       int64   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	MetaData *[]byte `protobuf:"bytes,3,opt,name=tokenData,proto3" json:"meta_data,omitempty"`
+	Amount   sdk.Int `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
 type MsgOpenResponse struct {
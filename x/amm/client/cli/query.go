@@ -30,6 +30,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListDenomLiquidity())
 	cmd.AddCommand(CmdShowDenomLiquidity())
 	cmd.AddCommand(CmdSwapEstimation())
+	cmd.AddCommand(CmdSwapSimulation())
+	cmd.AddCommand(CmdSwapSimulationExactAmountOut())
+	cmd.AddCommand(CmdPoolStats())
+	cmd.AddCommand(CmdPausedPools())
+	cmd.AddCommand(CmdPoolTwap())
+	cmd.AddCommand(CmdExternalLiquidityHistory())
 
 // this line is used by starport scaffolding # 1
 
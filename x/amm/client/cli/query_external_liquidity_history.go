@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/elys-network/elys/x/amm/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdExternalLiquidityHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "external-liquidity-history [pool_id]",
+		Short:   "Query the external liquidity depths submitted by price feeders for a pool",
+		Example: "elysd q amm external-liquidity-history 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryExternalLiquidityHistoryRequest{
+				PoolId:     poolId,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ExternalLiquidityHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/elys-network/elys/x/amm/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdPausedPools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "paused-pools",
+		Short:   "Query pools in which swaps and joins are paused",
+		Example: "elysd q amm paused-pools",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PausedPools(cmd.Context(), &types.QueryPausedPoolsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/elys-network/elys/x/amm/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdPoolStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pool-stats [pool_id]",
+		Short:   "Query 24h and 7d volume, fee and APR stats of a pool",
+		Example: "elysd q amm pool-stats 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPoolStatsRequest{
+				PoolId: poolId,
+			}
+
+			res, err := queryClient.PoolStats(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
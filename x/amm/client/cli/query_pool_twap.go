@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/elys-network/elys/x/amm/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdPoolTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pool-twap [pool_id] [base] [quote] [start] [end]",
+		Short:   "Query the time weighted average price of base in quote between two unix timestamps, end defaults to the block time",
+		Example: "elysd q amm pool-twap 1 uelys uusdc 1700000000",
+		Args:    cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			start, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			end := uint64(0)
+			if len(args) > 4 {
+				end, err = strconv.ParseUint(args[4], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPoolTwapRequest{
+				PoolId: poolId,
+				Base:   args[1],
+				Quote:  args[2],
+				Start:  start,
+				End:    end,
+			}
+
+			res, err := queryClient.PoolTwap(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
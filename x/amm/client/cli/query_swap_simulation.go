@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+	"github.com/spf13/cobra"
+)
+
+const FlagSender = "sender"
+
+func CmdSwapSimulation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "swap-simulation [token-in] {pool_id token_out_denom}...",
+		Short:   "Query SwapSimulation with fee and price impact breakdown",
+		Example: "elysd q amm swap-simulation 100token 1 token_out1 2 token_out2 ...",
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqTokenIn, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			if (len(args)-1)%2 != 0 {
+				return errors.New("you must provide pairs of pool_id and token_out_denom for routes")
+			}
+
+			var reqRoutes []types.SwapAmountInRoute
+			for i := 1; i+1 < len(args); i += 2 {
+				poolID, err := strconv.ParseUint(args[i], 10, 64)
+				if err != nil {
+					return err
+				}
+
+				reqRoutes = append(reqRoutes, types.SwapAmountInRoute{
+					PoolId:        poolID,
+					TokenOutDenom: args[i+1],
+				})
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, err := cmd.Flags().GetString(FlagSender)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySwapSimulationRequest{
+				Routes:  reqRoutes,
+				TokenIn: reqTokenIn,
+				Sender:  sender,
+			}
+
+			res, err := queryClient.SwapSimulation(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSender, "", "trader address used to apply its committed Eden swap fee discount")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSwapSimulationExactAmountOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "swap-simulation-exact-amount-out [token-out] {pool_id token_in_denom}...",
+		Short:   "Query SwapSimulationExactAmountOut with fee and price impact breakdown",
+		Example: "elysd q amm swap-simulation-exact-amount-out 100token 1 token_in1 2 token_in2 ...",
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqTokenOut, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			if (len(args)-1)%2 != 0 {
+				return errors.New("you must provide pairs of pool_id and token_in_denom for routes")
+			}
+
+			var reqRoutes []types.SwapAmountOutRoute
+			for i := 1; i+1 < len(args); i += 2 {
+				poolID, err := strconv.ParseUint(args[i], 10, 64)
+				if err != nil {
+					return err
+				}
+
+				reqRoutes = append(reqRoutes, types.SwapAmountOutRoute{
+					PoolId:       poolID,
+					TokenInDenom: args[i+1],
+				})
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, err := cmd.Flags().GetString(FlagSender)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySwapSimulationExactAmountOutRequest{
+				Routes:   reqRoutes,
+				TokenOut: reqTokenOut,
+				Sender:   sender,
+			}
+
+			res, err := queryClient.SwapSimulationExactAmountOut(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSender, "", "trader address used to apply its committed Eden swap fee discount")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
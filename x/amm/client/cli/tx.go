@@ -17,6 +17,7 @@ var (
 
 const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	FlagDeadline               = "deadline"
 	listSeparator              = ","
 )
 
@@ -32,6 +33,8 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdCreatePool())
 	cmd.AddCommand(CmdJoinPool())
+	cmd.AddCommand(CmdZapJoinPool())
+	cmd.AddCommand(CmdLockShares())
 	cmd.AddCommand(CmdExitPool())
 	cmd.AddCommand(CmdSwapExactAmountIn())
 	cmd.AddCommand(CmdSwapExactAmountOut())
@@ -39,3 +42,16 @@ func GetTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// getDeadline parses the optional swap deadline flag, returns nil when it is not set
+func getDeadline(cmd *cobra.Command) (*time.Time, error) {
+	deadlineStr, err := cmd.Flags().GetString(FlagDeadline)
+	if err != nil || deadlineStr == "" {
+		return nil, err
+	}
+	deadline, err := time.Parse(time.RFC3339, deadlineStr)
+	if err != nil {
+		return nil, err
+	}
+	return &deadline, nil
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdLockShares() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lock-shares [pool-id] [amount] [duration-seconds]",
+		Short:   "lock committed pool shares to earn boosted Eden LP rewards",
+		Example: `elysd tx amm lock-shares 1 1000000000000000000 2592000 --from=treasury --keyring-backend=test --chain-id=elystestnet-1 --yes --gas=1000000`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			poolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return errors.New("invalid amount")
+			}
+			duration, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLockShares(
+				clientCtx.GetFromAddress().String(),
+				poolId,
+				amount,
+				duration,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
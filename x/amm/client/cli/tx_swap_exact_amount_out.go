@@ -43,6 +43,10 @@ func CmdSwapExactAmountOut() *cobra.Command {
 				argSwapRoutePoolIds[i] = value
 			}
 			argSwapRouteDenoms := strings.Split(args[3], listSeparator)
+			deadline, err := getDeadline(cmd)
+			if err != nil {
+				return err
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -56,6 +60,7 @@ func CmdSwapExactAmountOut() *cobra.Command {
 				argSwapRoutePoolIds,
 				argSwapRouteDenoms,
 			)
+			msg.Deadline = deadline
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -65,5 +70,7 @@ func CmdSwapExactAmountOut() *cobra.Command {
 
 	flags.AddTxFlagsToCmd(cmd)
 
+	cmd.Flags().String(FlagDeadline, "", "latest block time (RFC3339) at which the swap can be executed")
+
 	return cmd
 }
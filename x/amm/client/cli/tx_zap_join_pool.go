@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdZapJoinPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "zap-join-pool [pool-id] [token-in] [share-amount-out-min]",
+		Short:   "join a pool from a single asset, the optimal portion is swapped internally",
+		Example: `elysd tx amm zap-join-pool 1 100000uusdc 0 --from=treasury --keyring-backend=test --chain-id=elystestnet-1 --yes --gas=1000000`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			poolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			tokenIn, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			shareAmountOutMin, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return errors.New("invalid share-amount-out-min")
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgZapJoinPool(
+				clientCtx.GetFromAddress().String(),
+				poolId,
+				tokenIn,
+				shareAmountOutMin,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -19,6 +19,12 @@ func (m *Messenger) HandleMsg(ctx sdk.Context, contractAddr sdk.AccAddress, cont
 	switch {
 	case msg.MsgSwapExactAmountIn != nil:
 		return m.msgSwapExactAmountIn(ctx, contractAddr, msg.MsgSwapExactAmountIn)
+	case msg.MsgSwapExactAmountOut != nil:
+		return m.msgSwapExactAmountOut(ctx, contractAddr, msg.MsgSwapExactAmountOut)
+	case msg.MsgFlashSwap != nil:
+		return m.msgFlashSwap(ctx, contractAddr, msg.MsgFlashSwap)
+	case msg.MsgRepayFlashSwap != nil:
+		return m.msgRepayFlashSwap(ctx, contractAddr, msg.MsgRepayFlashSwap)
 	default:
 		// This handler cannot handle the message
 		return nil, nil, wasmbindingstypes.ErrCannotHandleMsg
@@ -0,0 +1,57 @@
+package wasm
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wasmbindingstypes "github.com/elys-network/elys/wasmbindings/types"
+	ammkeeper "github.com/elys-network/elys/x/amm/keeper"
+	ammtype "github.com/elys-network/elys/x/amm/types"
+)
+
+func (m *Messenger) msgFlashSwap(ctx sdk.Context, contractAddr sdk.AccAddress, msgFlashSwap *wasmbindingstypes.MsgFlashSwap) ([]sdk.Event, [][]byte, error) {
+	res, err := performMsgFlashSwap(m.keeper, ctx, contractAddr, msgFlashSwap)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform flash swap")
+	}
+
+	responseBytes, err := json.Marshal(*res)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to serialize flash swap response")
+	}
+
+	resp := [][]byte{responseBytes}
+
+	return nil, resp, nil
+}
+
+// performMsgFlashSwap lends to the contract itself, which must send MsgRepayFlashSwap later in the same tx
+func performMsgFlashSwap(f *ammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, msgFlashSwap *wasmbindingstypes.MsgFlashSwap) (*wasmbindingstypes.MsgFlashSwapResponse, error) {
+	if msgFlashSwap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "flash swap null msg"}
+	}
+
+	msgServer := ammkeeper.NewMsgServerImpl(*f)
+
+	msgMsgFlashSwap := ammtype.NewMsgFlashSwap(contractAddr.String(), msgFlashSwap.PoolId, msgFlashSwap.TokenOut, msgFlashSwap.TokenInDenom, msgFlashSwap.TokenInMaxAmount)
+
+	if err := msgMsgFlashSwap.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgFlashSwap")
+	}
+
+	flashSwapResp, err := msgServer.FlashSwap(
+		sdk.WrapSDKContext(ctx),
+		msgMsgFlashSwap,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "flash swap msg")
+	}
+
+	var resp = &wasmbindingstypes.MsgFlashSwapResponse{
+		TokenIn:  flashSwapResp.TokenIn,
+		MetaData: msgFlashSwap.MetaData,
+	}
+	return resp, nil
+}
@@ -0,0 +1,56 @@
+package wasm
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wasmbindingstypes "github.com/elys-network/elys/wasmbindings/types"
+	ammkeeper "github.com/elys-network/elys/x/amm/keeper"
+	ammtype "github.com/elys-network/elys/x/amm/types"
+)
+
+func (m *Messenger) msgRepayFlashSwap(ctx sdk.Context, contractAddr sdk.AccAddress, msgRepayFlashSwap *wasmbindingstypes.MsgRepayFlashSwap) ([]sdk.Event, [][]byte, error) {
+	res, err := performMsgRepayFlashSwap(m.keeper, ctx, contractAddr, msgRepayFlashSwap)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform flash swap repayment")
+	}
+
+	responseBytes, err := json.Marshal(*res)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to serialize flash swap repayment response")
+	}
+
+	resp := [][]byte{responseBytes}
+
+	return nil, resp, nil
+}
+
+func performMsgRepayFlashSwap(f *ammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, msgRepayFlashSwap *wasmbindingstypes.MsgRepayFlashSwap) (*wasmbindingstypes.MsgRepayFlashSwapResponse, error) {
+	if msgRepayFlashSwap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "repay flash swap null msg"}
+	}
+
+	msgServer := ammkeeper.NewMsgServerImpl(*f)
+
+	msgMsgRepayFlashSwap := ammtype.NewMsgRepayFlashSwap(contractAddr.String(), msgRepayFlashSwap.PoolId)
+
+	if err := msgMsgRepayFlashSwap.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgRepayFlashSwap")
+	}
+
+	repayResp, err := msgServer.RepayFlashSwap(
+		sdk.WrapSDKContext(ctx),
+		msgMsgRepayFlashSwap,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "repay flash swap msg")
+	}
+
+	var resp = &wasmbindingstypes.MsgRepayFlashSwapResponse{
+		TokenIn:  repayResp.TokenIn,
+		MetaData: msgRepayFlashSwap.MetaData,
+	}
+	return resp, nil
+}
@@ -32,6 +32,11 @@ func performMsgSwapExactAmountIn(f *ammkeeper.Keeper, ctx sdk.Context, contractA
 		return nil, wasmvmtypes.InvalidRequest{Err: "swap null swap"}
 	}
 
+	// the contract can only swap its own funds
+	if msgSwapExactAmountIn.Sender != contractAddr.String() {
+		return nil, wasmvmtypes.InvalidRequest{Err: "swap sender must be the contract"}
+	}
+
 	msgServer := ammkeeper.NewMsgServerImpl(*f)
 
 	var PoolIds []uint64
@@ -43,6 +48,7 @@ func performMsgSwapExactAmountIn(f *ammkeeper.Keeper, ctx sdk.Context, contractA
 	}
 
 	msgMsgSwapExactAmountIn := ammtype.NewMsgSwapExactAmountIn(msgSwapExactAmountIn.Sender, msgSwapExactAmountIn.TokenIn, msgSwapExactAmountIn.TokenOutMinAmount, PoolIds, TokenOutDenoms)
+	msgMsgSwapExactAmountIn.Deadline = msgSwapExactAmountIn.Deadline
 
 	if err := msgMsgSwapExactAmountIn.ValidateBasic(); err != nil {
 		return nil, errorsmod.Wrap(err, "failed validating MsgMsgSwapExactAmountIn")
@@ -0,0 +1,71 @@
+package wasm
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wasmbindingstypes "github.com/elys-network/elys/wasmbindings/types"
+	ammkeeper "github.com/elys-network/elys/x/amm/keeper"
+	ammtype "github.com/elys-network/elys/x/amm/types"
+)
+
+func (m *Messenger) msgSwapExactAmountOut(ctx sdk.Context, contractAddr sdk.AccAddress, msgSwapExactAmountOut *wasmbindingstypes.MsgSwapExactAmountOut) ([]sdk.Event, [][]byte, error) {
+	res, err := performMsgSwapExactAmountOut(m.keeper, ctx, contractAddr, msgSwapExactAmountOut)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform swap")
+	}
+
+	responseBytes, err := json.Marshal(*res)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to serialize swap response")
+	}
+
+	resp := [][]byte{responseBytes}
+
+	return nil, resp, nil
+}
+
+func performMsgSwapExactAmountOut(f *ammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, msgSwapExactAmountOut *wasmbindingstypes.MsgSwapExactAmountOut) (*wasmbindingstypes.MsgSwapExactAmountOutResponse, error) {
+	if msgSwapExactAmountOut == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "swap null swap"}
+	}
+
+	// the contract can only swap its own funds
+	if msgSwapExactAmountOut.Sender != contractAddr.String() {
+		return nil, wasmvmtypes.InvalidRequest{Err: "swap sender must be the contract"}
+	}
+
+	msgServer := ammkeeper.NewMsgServerImpl(*f)
+
+	var PoolIds []uint64
+	var TokenInDenoms []string
+
+	for _, route := range msgSwapExactAmountOut.Routes {
+		PoolIds = append(PoolIds, route.PoolId)
+		TokenInDenoms = append(TokenInDenoms, route.TokenInDenom)
+	}
+
+	msgMsgSwapExactAmountOut := ammtype.NewMsgSwapExactAmountOut(msgSwapExactAmountOut.Sender, msgSwapExactAmountOut.TokenOut, msgSwapExactAmountOut.TokenInMaxAmount, PoolIds, TokenInDenoms)
+	msgMsgSwapExactAmountOut.Deadline = msgSwapExactAmountOut.Deadline
+
+	if err := msgMsgSwapExactAmountOut.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgSwapExactAmountOut")
+	}
+
+	// Swap
+	swapResp, err := msgServer.SwapExactAmountOut(
+		sdk.WrapSDKContext(ctx),
+		msgMsgSwapExactAmountOut,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "swap msg")
+	}
+
+	var resp = &wasmbindingstypes.MsgSwapExactAmountOutResponse{
+		TokenInAmount: swapResp.TokenInAmount,
+		MetaData:      msgSwapExactAmountOut.MetaData,
+	}
+	return resp, nil
+}
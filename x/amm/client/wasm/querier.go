@@ -29,6 +29,8 @@ func (oq *Querier) HandleQuery(ctx sdk.Context, query wasmbindingstypes.ElysQuer
 		return oq.querySwapEstimation(ctx, query.QuerySwapEstimation)
 	case query.BalanceOfDenom != nil:
 		return oq.queryBalanceOfDenom(ctx, query.BalanceOfDenom)
+	case query.PoolTwap != nil:
+		return oq.queryPoolTwap(ctx, query.PoolTwap)
 	default:
 		// This handler cannot handle the query
 		return nil, wasmbindingstypes.ErrCannotHandleQuery
@@ -0,0 +1,22 @@
+package wasm
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+)
+
+func (oq *Querier) queryPoolTwap(ctx sdk.Context, query *ammtypes.QueryPoolTwapRequest) ([]byte, error) {
+	res, err := oq.keeper.PoolTwap(sdk.WrapSDKContext(ctx), query)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get pool twap")
+	}
+
+	responseBytes, err := json.Marshal(res)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to serialize pool twap response")
+	}
+	return responseBytes, nil
+}
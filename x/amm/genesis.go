@@ -19,6 +19,24 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, track := range genState.SlippageTracks {
 		k.SetSlippageTrack(ctx, track)
 	}
+	for _, bucket := range genState.PoolStatsBuckets {
+		k.SetPoolStatsBucket(ctx, bucket)
+	}
+	for _, pausedPool := range genState.PausedPools {
+		k.SetPausedPool(ctx, pausedPool)
+	}
+	for _, record := range genState.TwapRecords {
+		k.SetPoolTwapRecord(ctx, record)
+	}
+	for _, submission := range genState.ExternalLiquiditySubmissions {
+		k.SetExternalLiquiditySubmission(ctx, submission)
+	}
+	for _, bounds := range genState.ExternalLiquidityBounds {
+		k.SetExternalLiquidityBounds(ctx, bounds)
+	}
+	for _, submission := range genState.ExternalLiquidityLatestSubmissions {
+		k.SetLatestExternalLiquiditySubmission(ctx, submission)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -31,6 +49,12 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.PoolList = k.GetAllPool(ctx)
 	genesis.DenomLiquidityList = k.GetAllDenomLiquidity(ctx)
 	genesis.SlippageTracks = k.AllSlippageTracks(ctx)
+	genesis.PoolStatsBuckets = k.AllPoolStatsBuckets(ctx)
+	genesis.PausedPools = k.GetAllPausedPools(ctx)
+	genesis.TwapRecords = k.AllPoolTwapRecords(ctx)
+	genesis.ExternalLiquiditySubmissions = k.AllExternalLiquiditySubmissions(ctx)
+	genesis.ExternalLiquidityBounds = k.AllExternalLiquidityBounds(ctx)
+	genesis.ExternalLiquidityLatestSubmissions = k.AllLatestExternalLiquiditySubmissions(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -28,6 +28,9 @@ func (k Keeper) ApplySwapRequest(ctx sdk.Context, msg sdk.Msg) error {
 		if err != nil {
 			return err
 		}
+		if err := types.CheckSwapDeadline(ctx.BlockTime(), msg.Deadline); err != nil {
+			return err
+		}
 		_, err = k.RouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, math.Int(msg.TokenOutMinAmount))
 		if err != nil {
 			return err
@@ -38,6 +41,9 @@ func (k Keeper) ApplySwapRequest(ctx sdk.Context, msg sdk.Msg) error {
 		if err != nil {
 			return err
 		}
+		if err := types.CheckSwapDeadline(ctx.BlockTime(), msg.Deadline); err != nil {
+			return err
+		}
 		_, err = k.RouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut)
 		if err != nil {
 			return err
@@ -182,5 +188,9 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 		k.Logger(ctx).Info("Executed swap requests: " + string(bz))
 	}
 
+	k.ApplyCircuitBreakers(ctx)
+
 	k.ClearOutdatedSlippageTrack(ctx)
+	k.ClearOutdatedPoolStats(ctx)
+	k.ClearOutdatedPoolTwapRecords(ctx)
 }
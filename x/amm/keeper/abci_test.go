@@ -331,3 +331,50 @@ func (suite *KeeperTestSuite) TestClearOutdatedSlippageTrack() {
 	tracksStored := suite.app.AmmKeeper.AllSlippageTracks(suite.ctx)
 	suite.Require().Len(tracksStored, 2)
 }
+
+func (suite *KeeperTestSuite) TestSwapRequestDeadline() {
+	suite.SetupTest()
+
+	blockTime := time.Unix(1700000000, 0).UTC()
+	suite.ctx = suite.ctx.WithBlockTime(blockTime)
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	expired := blockTime.Add(-time.Second)
+
+	msgIn := &types.MsgSwapExactAmountIn{
+		Sender: sender.String(),
+		Routes: []types.SwapAmountInRoute{
+			{
+				PoolId:        1,
+				TokenOutDenom: ptypes.BaseCurrency,
+			},
+		},
+		TokenIn:           sdk.NewInt64Coin(ptypes.Elys, 10000),
+		TokenOutMinAmount: sdk.ZeroInt(),
+		Deadline:          &expired,
+	}
+	msgOut := &types.MsgSwapExactAmountOut{
+		Sender: sender.String(),
+		Routes: []types.SwapAmountOutRoute{
+			{
+				PoolId:       1,
+				TokenInDenom: ptypes.Elys,
+			},
+		},
+		TokenOut:         sdk.NewInt64Coin(ptypes.BaseCurrency, 10000),
+		TokenInMaxAmount: sdk.NewInt(1000000),
+		Deadline:         &expired,
+	}
+
+	// expired requests are rejected on submission
+	msgServer := keeper.NewMsgServerImpl(suite.app.AmmKeeper)
+	_, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(suite.ctx), msgIn)
+	suite.Require().ErrorIs(err, types.ErrSwapDeadlinePassed)
+	_, err = msgServer.SwapExactAmountOut(sdk.WrapSDKContext(suite.ctx), msgOut)
+	suite.Require().ErrorIs(err, types.ErrSwapDeadlinePassed)
+
+	// queued requests whose deadline passed before execution are rejected as well
+	err = suite.app.AmmKeeper.ApplySwapRequest(suite.ctx, msgIn)
+	suite.Require().ErrorIs(err, types.ErrSwapDeadlinePassed)
+	err = suite.app.AmmKeeper.ApplySwapRequest(suite.ctx, msgOut)
+	suite.Require().ErrorIs(err, types.ErrSwapDeadlinePassed)
+}
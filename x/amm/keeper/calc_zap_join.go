@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// CalcZapJoin finds the amount of tokenIn to swap into the other asset of a two asset pool so that the swap
+// proceeds and the remaining tokenIn join the pool at its post swap ratio with the most shares minted.
+// Shares backed by the remaining tokenIn decrease and shares backed by the swap proceeds increase with the
+// swap amount, so the optimum is found with an integer binary search running the actual swap and exact join math.
+// Every simulated swap consumes gas from ctx, so the search is paid for by the caller.
+func (k Keeper) CalcZapJoin(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool types.Pool,
+	tokenIn sdk.Coin,
+	swapFee sdk.Dec,
+) (swapAmount sdk.Int, numShares sdk.Int, tokensJoined sdk.Coins, err error) {
+	if pool.PoolParams.UseOracle || len(pool.PoolAssets) != 2 {
+		return sdk.ZeroInt(), sdk.ZeroInt(), nil, types.ErrZapJoinNotSupported
+	}
+	_, _, err = pool.GetPoolAssetAndIndex(tokenIn.Denom)
+	if err != nil {
+		return sdk.ZeroInt(), sdk.ZeroInt(), nil, err
+	}
+	if balance := k.bankKeeper.GetBalance(ctx, sender, tokenIn.Denom); balance.IsLT(tokenIn) {
+		return sdk.ZeroInt(), sdk.ZeroInt(), nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, tokenIn)
+	}
+	otherDenom := pool.PoolAssets[0].Token.Denom
+	if otherDenom == tokenIn.Denom {
+		otherDenom = pool.PoolAssets[1].Token.Denom
+	}
+
+	totalShares := pool.GetTotalShares().Amount
+
+	// smallest swap amount for which the swap proceeds back at least as many shares as the remaining tokenIn
+	low, high := sdk.ZeroInt(), tokenIn.Amount
+	for low.LT(high) {
+		mid := low.Add(high).QuoRaw(2)
+		postPool, holdings, err := k.simulateZapSwap(ctx, sender, pool, tokenIn, otherDenom, mid, swapFee)
+		if err != nil {
+			return sdk.ZeroInt(), sdk.ZeroInt(), nil, err
+		}
+		fromRemaining := holdings.AmountOf(tokenIn.Denom).Mul(totalShares).Quo(postPool.GetTotalPoolLiquidity().AmountOf(tokenIn.Denom))
+		fromProceeds := holdings.AmountOf(otherDenom).Mul(totalShares).Quo(postPool.GetTotalPoolLiquidity().AmountOf(otherDenom))
+		if fromProceeds.LT(fromRemaining) {
+			low = mid.AddRaw(1)
+		} else {
+			high = mid
+		}
+	}
+
+	// the optimum is at the crossing or right before it
+	numShares = sdk.ZeroInt()
+	for _, candidate := range []sdk.Int{low.SubRaw(1), low} {
+		if candidate.IsNegative() {
+			continue
+		}
+		postPool, holdings, err := k.simulateZapSwap(ctx, sender, pool, tokenIn, otherDenom, candidate, swapFee)
+		if err != nil {
+			return sdk.ZeroInt(), sdk.ZeroInt(), nil, err
+		}
+		shares, joined := postPool.CalcExactRatioJoin(holdings)
+		if shares.GT(numShares) {
+			swapAmount, numShares, tokensJoined = candidate, shares, joined
+		}
+	}
+	if !numShares.IsPositive() {
+		return sdk.ZeroInt(), sdk.ZeroInt(), nil, types.ErrInvalidMathApprox
+	}
+	return swapAmount, numShares, tokensJoined, nil
+}
+
+// simulateZapSwap runs the swap of swapAmount of tokenIn on a cached context, it returns the post swap pool,
+// including the fee swaps to the revenue token, and the sender holdings after the swap settles.
+// A swap amount too small to produce any output is wasted, any other swap failure is returned.
+func (k Keeper) simulateZapSwap(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool types.Pool,
+	tokenIn sdk.Coin,
+	otherDenom string,
+	swapAmount sdk.Int,
+	swapFee sdk.Dec,
+) (types.Pool, sdk.Coins, error) {
+	remaining := sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.Sub(swapAmount))
+	if !swapAmount.IsPositive() {
+		return pool, sdk.NewCoins(remaining), nil
+	}
+
+	// the swap updates pool assets in place, keep the caller's pool untouched
+	pool.PoolAssets = append([]types.PoolAsset{}, pool.PoolAssets...)
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	proceeds, err := k.SwapExactAmountIn(cacheCtx, sender, pool, sdk.NewCoin(tokenIn.Denom, swapAmount), otherDenom, sdk.ZeroInt(), swapFee)
+	if errors.Is(err, types.ErrInvalidMathApprox) {
+		return pool, sdk.NewCoins(remaining), nil
+	}
+	if err != nil {
+		return pool, nil, err
+	}
+	postPool, _ := k.GetPool(cacheCtx, pool.PoolId)
+	return postPool, sdk.NewCoins(remaining, sdk.NewCoin(otherDenom, proceeds)), nil
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	burnertypes "github.com/elys-network/elys/x/burner/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+// ChargePoolCreationFee sends the USDC pool creation fee to the fee receiver, or to the burner
+// when no receiver is set
+func (k Keeper) ChargePoolCreationFee(ctx sdk.Context, sender sdk.AccAddress) error {
+	fee := k.PoolCreationFee(ctx)
+	if fee == 0 {
+		return nil
+	}
+
+	receiver := burnertypes.GetZeroAddress()
+	if addr := k.PoolCreationFeeReceiver(ctx); addr != "" {
+		var err error
+		receiver, err = sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.bankKeeper.SendCoins(ctx, sender, receiver, sdk.Coins{sdk.NewCoin(ptypes.BaseCurrency, sdk.NewIntFromUint64(fee))})
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// CheckFlashSwaps is run at the end of every tx. It fails when a flash swap is left unpaid
+// or when a pool lent by a flash swap doesn't hold its recorded reserves anymore.
+func (k Keeper) CheckFlashSwaps(ctx sdk.Context) error {
+	for _, loan := range k.GetAllFlashSwapLoans(ctx) {
+		return sdkerrors.Wrapf(types.ErrFlashSwapNotRepaid, "%s owes %s to pool %d", loan.Sender, loan.TokenIn, loan.PoolId)
+	}
+
+	for _, poolId := range k.GetAllFlashSwapPools(ctx) {
+		pool, found := k.GetPool(ctx, poolId)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrInvalidPoolId, "pool %d", poolId)
+		}
+		err := k.CheckPoolInvariant(ctx, pool)
+		if err != nil {
+			return err
+		}
+		k.DeleteFlashSwapPool(ctx, poolId)
+	}
+	return nil
+}
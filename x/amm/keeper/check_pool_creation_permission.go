@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/amm/types"
+	assetprofiletypes "github.com/elys-network/elys/x/assetprofile/types"
+)
+
+// CheckPoolCreationPermission returns an error when an asset of the pool has no assetprofile
+// entry granting the pool creation permission
+func (k Keeper) CheckPoolCreationPermission(ctx sdk.Context, msg *types.MsgCreatePool) error {
+	for _, asset := range msg.PoolAssets {
+		entry, found := k.apKeeper.GetEntryByDenom(ctx, asset.Token.Denom)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrPoolCreationNotPermitted, "no asset profile entry for %s", asset.Token.Denom)
+		}
+		if !entry.HasPermission(assetprofiletypes.PermissionPoolCreation) {
+			return sdkerrors.Wrapf(types.ErrPoolCreationNotPermitted, "%s", asset.Token.Denom)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// CheckPoolInvariant ensures the pool account holds at least the reserves recorded on the pool
+func (k Keeper) CheckPoolInvariant(ctx sdk.Context, pool types.Pool) error {
+	poolAddr := sdk.MustAccAddressFromBech32(pool.GetAddress())
+	for _, asset := range pool.PoolAssets {
+		balance := k.bankKeeper.GetBalance(ctx, poolAddr, asset.Token.Denom)
+		if balance.Amount.LT(asset.Token.Amount) {
+			return sdkerrors.Wrapf(types.ErrPoolInvariant, "pool %d holds %s while %s is recorded", pool.PoolId, balance, asset.Token)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// CheckPoolCircuitBreaker pauses an oracle pool when its spot price diverges too far from the oracle
+// price or when its slippage in the current block exceeds the allowed ratio of TVL
+func (k Keeper) CheckPoolCircuitBreaker(ctx sdk.Context, pool types.Pool) {
+	// non-oracle pools can be moved away from the oracle price by anyone, never pause them automatically
+	if !pool.PoolParams.UseOracle || k.IsPoolPaused(ctx, pool.PoolId) {
+		return
+	}
+
+	maxDivergence := k.MaxOracleSpotDivergence(ctx)
+	if maxDivergence.IsPositive() {
+		divergence, err := pool.OracleSpotDivergence(ctx, k.oracleKeeper, k.accountedPoolKeeper)
+		if err == nil && divergence.GT(maxDivergence) {
+			k.pausePool(ctx, pool.PoolId, fmt.Sprintf("oracle spot price divergence %s exceeds %s", divergence, maxDivergence))
+			return
+		}
+	}
+
+	maxBlockSlippage := k.MaxBlockSlippage(ctx)
+	if maxBlockSlippage.IsPositive() {
+		blockSlippage := k.GetBlockSlippage(ctx, pool.PoolId)
+		if blockSlippage.IsZero() {
+			return
+		}
+		tvl, err := pool.TVL(ctx, k.oracleKeeper)
+		if err != nil || !tvl.IsPositive() {
+			return
+		}
+		slippageRatio := k.coinsUsdValue(ctx, blockSlippage).Quo(tvl)
+		if slippageRatio.GT(maxBlockSlippage) {
+			k.pausePool(ctx, pool.PoolId, fmt.Sprintf("block slippage %s exceeds %s", slippageRatio, maxBlockSlippage))
+		}
+	}
+}
+
+// SetTouchedPool marks a pool as updated in the current block
+func (k Keeper) SetTouchedPool(ctx sdk.Context, poolId uint64) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.KeyPrefix(types.TTouchedPoolKey))
+	store.Set(sdk.Uint64ToBigEndian(poolId), []byte{1})
+}
+
+// GetAllTouchedPools returns the ids of the pools updated in the current block
+func (k Keeper) GetAllTouchedPools(ctx sdk.Context) (list []uint64) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.KeyPrefix(types.TTouchedPoolKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, sdk.BigEndianToUint64(iterator.Key()))
+	}
+
+	return
+}
+
+// ApplyCircuitBreakers checks the circuit breaker conditions on the pools updated in the current block,
+// the balances and the slippage of the other pools didn't move since they were last checked
+func (k Keeper) ApplyCircuitBreakers(ctx sdk.Context) {
+	for _, poolId := range k.GetAllTouchedPools(ctx) {
+		pool, found := k.GetPool(ctx, poolId)
+		if !found {
+			continue
+		}
+		k.CheckPoolCircuitBreaker(ctx, pool)
+	}
+}
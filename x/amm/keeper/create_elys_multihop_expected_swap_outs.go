@@ -11,6 +11,7 @@ func (k Keeper) createElysMultihopExpectedSwapOuts(
 	routes []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
 	cumulativeRouteSwapFee, sumOfSwapFees sdk.Dec,
+	discount sdk.Dec,
 ) ([]sdk.Int, error) {
 	insExpected := make([]sdk.Int, len(routes))
 
@@ -21,11 +22,12 @@ func (k Keeper) createElysMultihopExpectedSwapOuts(
 			return nil, types.ErrInvalidPoolId
 		}
 
-		swapFee := pool.GetPoolParams().SwapFee
+		swapFee := k.GetEffectiveSwapFee(ctx, pool)
 		actualSwapFee := sdk.ZeroDec()
 		if sumOfSwapFees.IsPositive() {
 			actualSwapFee = cumulativeRouteSwapFee.Mul(swapFee.Quo(sumOfSwapFees))
 		}
+		actualSwapFee = ApplySwapFeeDiscount(actualSwapFee, discount)
 
 		snapshot := k.GetPoolSnapshotOrSet(ctx, pool)
 		tokenIn, err := pool.CalcInAmtGivenOut(ctx, k.oracleKeeper, &snapshot, sdk.NewCoins(tokenOut), route.TokenInDenom, actualSwapFee, k.accountedPoolKeeper)
@@ -16,6 +16,7 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 	ctx sdk.Context,
 	routes []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
+	discount sdk.Dec,
 ) ([]math.Int, error) {
 	insExpected := make([]math.Int, len(routes))
 
@@ -26,8 +27,9 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 			return nil, types.ErrInvalidPoolId
 		}
 
+		swapFee := ApplySwapFeeDiscount(k.GetEffectiveSwapFee(ctx, pool), discount)
 		snapshot := k.GetPoolSnapshotOrSet(ctx, pool)
-		tokenIn, err := pool.CalcInAmtGivenOut(ctx, k.oracleKeeper, &snapshot, sdk.NewCoins(tokenOut), route.TokenInDenom, pool.GetPoolParams().SwapFee, k.accountedPoolKeeper)
+		tokenIn, err := pool.CalcInAmtGivenOut(ctx, k.oracleKeeper, &snapshot, sdk.NewCoins(tokenOut), route.TokenInDenom, swapFee, k.accountedPoolKeeper)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// GetPriceVolatility returns the realized volatility of the oracle price of a denom over the
+// volatility window, computed as the square root of the sum of squared relative price changes
+// between the last MaxVolatilityPriceEntries prices
+func (k Keeper) GetPriceVolatility(ctx sdk.Context, denom string) sdk.Dec {
+	window := k.VolatilityWindow(ctx)
+	since := uint64(0)
+	if now := uint64(ctx.BlockTime().Unix()); now > window {
+		since = now - window
+	}
+
+	prices := k.oracleKeeper.GetAssetPriceHistoryFromDenom(ctx, denom, since, types.MaxVolatilityPriceEntries)
+	sumSquares := sdk.ZeroDec()
+	for i := 1; i < len(prices); i++ {
+		if !prices[i-1].IsPositive() {
+			continue
+		}
+		change := prices[i].Sub(prices[i-1]).Quo(prices[i-1])
+		sumSquares = sumSquares.Add(change.Mul(change))
+	}
+
+	volatility, err := sumSquares.ApproxSqrt()
+	if err != nil {
+		return sdk.ZeroDec()
+	}
+	return volatility
+}
+
+// GetEffectiveSwapFee returns the swap fee charged by a pool. Oracle pools scale their static
+// fee with the oracle price volatility of their assets and their stacked slippage, bounded by
+// the dynamic swap fee floor and ceiling params.
+func (k Keeper) GetEffectiveSwapFee(ctx sdk.Context, pool types.Pool) sdk.Dec {
+	swapFee := pool.GetPoolParams().SwapFee
+	ceiling := k.DynamicSwapFeeCeiling(ctx)
+	if !pool.PoolParams.UseOracle || !ceiling.IsPositive() {
+		return swapFee
+	}
+
+	volatility := sdk.ZeroDec()
+	for _, asset := range pool.PoolAssets {
+		volatility = sdk.MaxDec(volatility, k.GetPriceVolatility(ctx, asset.Token.Denom))
+	}
+	stackedSlippage := k.GetStackedSlippage(ctx, pool.PoolId)
+
+	fee := swapFee.
+		Add(volatility.Mul(k.VolatilityFeeMultiplier(ctx))).
+		Add(stackedSlippage.Mul(k.SlippageFeeMultiplier(ctx)))
+
+	floor := k.DynamicSwapFeeFloor(ctx)
+	if fee.LT(floor) {
+		return floor
+	}
+	if fee.GT(ceiling) {
+		return ceiling
+	}
+	return fee
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+	oracletypes "github.com/elys-network/elys/x/oracle/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+func (suite *KeeperTestSuite) TestGetEffectiveSwapFee() {
+	suite.SetupTest()
+	suite.ctx = suite.ctx.WithBlockTime(time.Unix(10000, 0))
+	suite.setupSimulationPool()
+	suite.SetupStableCoinPrices()
+
+	pool, found := suite.app.AmmKeeper.GetPool(suite.ctx, 1)
+	suite.Require().True(found)
+
+	// oracle prices of elys move by +10% and then -10%
+	suite.app.OracleKeeper.SetAssetInfo(suite.ctx, oracletypes.AssetInfo{
+		Denom:   ptypes.Elys,
+		Display: "ELYS",
+		Decimal: 6,
+	})
+	provider := sdk.AccAddress([]byte("provider")).String()
+	for i, price := range []sdk.Dec{sdk.NewDec(1), sdk.NewDecWithPrec(11, 1), sdk.NewDecWithPrec(99, 2)} {
+		suite.app.OracleKeeper.SetPrice(suite.ctx, oracletypes.Price{
+			Asset:     "ELYS",
+			Price:     price,
+			Source:    "elys",
+			Provider:  provider,
+			Timestamp: uint64(9000 + i*100),
+		})
+	}
+	volatility := suite.app.AmmKeeper.GetPriceVolatility(suite.ctx, ptypes.Elys)
+	suite.Require().Equal("0.141421356237309505", volatility.String())
+
+	// dynamic fees are disabled by default
+	suite.Require().Equal(sdk.NewDecWithPrec(1, 2), suite.app.AmmKeeper.GetEffectiveSwapFee(suite.ctx, pool))
+
+	params := suite.app.AmmKeeper.GetParams(suite.ctx)
+	params.DynamicSwapFeeFloor = sdk.NewDecWithPrec(5, 3)
+	params.DynamicSwapFeeCeiling = sdk.NewDecWithPrec(5, 2)
+	params.VolatilityFeeMultiplier = sdk.NewDecWithPrec(1, 1)
+	suite.app.AmmKeeper.SetParams(suite.ctx, params)
+
+	// non oracle pools keep their static fee
+	suite.Require().Equal(sdk.NewDecWithPrec(1, 2), suite.app.AmmKeeper.GetEffectiveSwapFee(suite.ctx, pool))
+
+	pool.PoolParams.UseOracle = true
+	suite.Require().NoError(suite.app.AmmKeeper.SetPool(suite.ctx, pool))
+	fee := suite.app.AmmKeeper.GetEffectiveSwapFee(suite.ctx, pool)
+	suite.Require().Equal(sdk.NewDecWithPrec(1, 2).Add(volatility.Mul(sdk.NewDecWithPrec(1, 1))), fee)
+
+	// prices older than the window are ignored
+	params.VolatilityWindow = 500
+	suite.app.AmmKeeper.SetParams(suite.ctx, params)
+	suite.Require().Equal(sdk.NewDecWithPrec(1, 2), suite.app.AmmKeeper.GetEffectiveSwapFee(suite.ctx, pool))
+
+	// the fee is bounded by the ceiling
+	params.VolatilityWindow = 3600
+	params.VolatilityFeeMultiplier = sdk.OneDec()
+	suite.app.AmmKeeper.SetParams(suite.ctx, params)
+	suite.Require().Equal(params.DynamicSwapFeeCeiling, suite.app.AmmKeeper.GetEffectiveSwapFee(suite.ctx, pool))
+
+	// and by the floor
+	params.VolatilityFeeMultiplier = sdk.ZeroDec()
+	params.DynamicSwapFeeFloor = sdk.NewDecWithPrec(2, 2)
+	suite.app.AmmKeeper.SetParams(suite.ctx, params)
+	suite.Require().Equal(params.DynamicSwapFeeFloor, suite.app.AmmKeeper.GetEffectiveSwapFee(suite.ctx, pool))
+
+	// only the latest prices within the window are read
+	for i := 1; i <= types.MaxVolatilityPriceEntries; i++ {
+		suite.app.OracleKeeper.SetPrice(suite.ctx, oracletypes.Price{
+			Asset:     "ELYS",
+			Price:     sdk.NewDecWithPrec(99, 2),
+			Source:    "elys",
+			Provider:  provider,
+			Timestamp: uint64(9200 + i),
+		})
+	}
+	suite.Require().True(suite.app.AmmKeeper.GetPriceVolatility(suite.ctx, ptypes.Elys).IsZero())
+}
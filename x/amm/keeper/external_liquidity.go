@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+func (k Keeper) SetExternalLiquiditySubmission(ctx sdk.Context, submission types.ExternalLiquiditySubmission) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ExternalLiquiditySubmissionPrefix))
+	bz := k.cdc.MustMarshal(&submission)
+	store.Set(types.ExternalLiquiditySubmissionKey(submission.PoolId, submission.Timestamp, sdk.MustAccAddressFromBech32(submission.Feeder)), bz)
+}
+
+func (k Keeper) DeleteExternalLiquiditySubmission(ctx sdk.Context, submission types.ExternalLiquiditySubmission) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ExternalLiquiditySubmissionPrefix))
+	store.Delete(types.ExternalLiquiditySubmissionKey(submission.PoolId, submission.Timestamp, sdk.MustAccAddressFromBech32(submission.Feeder)))
+}
+
+func (k Keeper) AllExternalLiquiditySubmissions(ctx sdk.Context) []types.ExternalLiquiditySubmission {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ExternalLiquiditySubmissionPrefix))
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	submissions := []types.ExternalLiquiditySubmission{}
+	for ; iterator.Valid(); iterator.Next() {
+		submission := types.ExternalLiquiditySubmission{}
+		k.cdc.MustUnmarshal(iterator.Value(), &submission)
+
+		submissions = append(submissions, submission)
+	}
+	return submissions
+}
+
+// GetExternalLiquiditySubmissions returns the submissions of a pool, oldest first
+func (k Keeper) GetExternalLiquiditySubmissions(ctx sdk.Context, poolId uint64) []types.ExternalLiquiditySubmission {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ExternalLiquiditySubmissionPrefix))
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ExternalLiquiditySubmissionPoolPrefix(poolId))
+	defer iterator.Close()
+
+	submissions := []types.ExternalLiquiditySubmission{}
+	for ; iterator.Valid(); iterator.Next() {
+		submission := types.ExternalLiquiditySubmission{}
+		k.cdc.MustUnmarshal(iterator.Value(), &submission)
+
+		submissions = append(submissions, submission)
+	}
+	return submissions
+}
+
+// SetLatestExternalLiquiditySubmission stores the submission as the latest one of its feeder for the pool
+func (k Keeper) SetLatestExternalLiquiditySubmission(ctx sdk.Context, submission types.ExternalLiquiditySubmission) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ExternalLiquidityLatestSubmissionPrefix))
+	bz := k.cdc.MustMarshal(&submission)
+	store.Set(types.ExternalLiquidityLatestSubmissionKey(submission.PoolId, sdk.MustAccAddressFromBech32(submission.Feeder)), bz)
+}
+
+func (k Keeper) AllLatestExternalLiquiditySubmissions(ctx sdk.Context) []types.ExternalLiquiditySubmission {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ExternalLiquidityLatestSubmissionPrefix))
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	submissions := []types.ExternalLiquiditySubmission{}
+	for ; iterator.Valid(); iterator.Next() {
+		submission := types.ExternalLiquiditySubmission{}
+		k.cdc.MustUnmarshal(iterator.Value(), &submission)
+
+		submissions = append(submissions, submission)
+	}
+	return submissions
+}
+
+// GetLatestExternalLiquiditySubmissions returns the latest submission of each feeder of a pool
+func (k Keeper) GetLatestExternalLiquiditySubmissions(ctx sdk.Context, poolId uint64) []types.ExternalLiquiditySubmission {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ExternalLiquidityLatestSubmissionPrefix))
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ExternalLiquiditySubmissionPoolPrefix(poolId))
+	defer iterator.Close()
+
+	submissions := []types.ExternalLiquiditySubmission{}
+	for ; iterator.Valid(); iterator.Next() {
+		submission := types.ExternalLiquiditySubmission{}
+		k.cdc.MustUnmarshal(iterator.Value(), &submission)
+
+		submissions = append(submissions, submission)
+	}
+	return submissions
+}
+
+// AggregateExternalLiquidityRatio returns the median ratio of the latest submission of each feeder of a pool,
+// submissions older than ExternalLiquidityFeedMaxAge are left out. No ratio is returned when fewer than
+// ExternalLiquidityMinFeeders feeders have a recent submission.
+func (k Keeper) AggregateExternalLiquidityRatio(ctx sdk.Context, poolId uint64) (sdk.Dec, bool) {
+	now := uint64(ctx.BlockTime().Unix())
+
+	ratios := []sdk.Dec{}
+	for _, submission := range k.GetLatestExternalLiquiditySubmissions(ctx, poolId) {
+		if submission.Timestamp+types.ExternalLiquidityFeedMaxAge < now {
+			continue
+		}
+		ratios = append(ratios, submission.Ratio)
+	}
+
+	if len(ratios) == 0 || len(ratios) < types.ExternalLiquidityMinFeeders {
+		return sdk.ZeroDec(), false
+	}
+
+	sort.Slice(ratios, func(i, j int) bool {
+		return ratios[i].LT(ratios[j])
+	})
+	middle := len(ratios) / 2
+	if len(ratios)%2 == 0 {
+		return ratios[middle-1].Add(ratios[middle]).QuoInt64(2), true
+	}
+	return ratios[middle], true
+}
+
+// PruneExternalLiquiditySubmissions keeps the last ExternalLiquidityHistorySize submissions of a pool in the history,
+// the latest submission of each feeder is kept apart for the aggregation
+func (k Keeper) PruneExternalLiquiditySubmissions(ctx sdk.Context, poolId uint64) {
+	submissions := k.GetExternalLiquiditySubmissions(ctx, poolId)
+	for i := 0; i+types.ExternalLiquidityHistorySize < len(submissions); i++ {
+		k.DeleteExternalLiquiditySubmission(ctx, submissions[i])
+	}
+}
+
+func (k Keeper) SetExternalLiquidityBounds(ctx sdk.Context, bounds types.ExternalLiquidityBounds) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ExternalLiquidityBoundsPrefix))
+	bz := k.cdc.MustMarshal(&bounds)
+	store.Set(sdk.Uint64ToBigEndian(bounds.PoolId), bz)
+}
+
+// GetExternalLiquidityBounds returns the bounds of a pool, a pool without bounds accepts any ratio
+func (k Keeper) GetExternalLiquidityBounds(ctx sdk.Context, poolId uint64) types.ExternalLiquidityBounds {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ExternalLiquidityBoundsPrefix))
+	bz := store.Get(sdk.Uint64ToBigEndian(poolId))
+	if bz == nil {
+		return types.ExternalLiquidityBounds{
+			PoolId:   poolId,
+			MinRatio: sdk.ZeroDec(),
+			MaxRatio: sdk.ZeroDec(),
+		}
+	}
+
+	bounds := types.ExternalLiquidityBounds{}
+	k.cdc.MustUnmarshal(bz, &bounds)
+	return bounds
+}
+
+func (k Keeper) AllExternalLiquidityBounds(ctx sdk.Context) []types.ExternalLiquidityBounds {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ExternalLiquidityBoundsPrefix))
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	list := []types.ExternalLiquidityBounds{}
+	for ; iterator.Valid(); iterator.Next() {
+		bounds := types.ExternalLiquidityBounds{}
+		k.cdc.MustUnmarshal(iterator.Value(), &bounds)
+
+		list = append(list, bounds)
+	}
+	return list
+}
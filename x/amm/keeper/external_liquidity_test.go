@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/elys-network/elys/x/amm/keeper"
+	"github.com/elys-network/elys/x/amm/types"
+	oracletypes "github.com/elys-network/elys/x/oracle/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+func (suite *KeeperTestSuite) TestFeedExternalLiquidityAggregation() {
+	suite.SetupTest()
+	suite.SetupStableCoinPrices()
+	suite.setupSimulationPool()
+	suite.app.OracleKeeper.SetAssetInfo(suite.ctx, oracletypes.AssetInfo{
+		Denom:   ptypes.Elys,
+		Display: "ELYS",
+		Decimal: 6,
+	})
+	suite.app.OracleKeeper.SetPrice(suite.ctx, oracletypes.Price{
+		Asset:     "ELYS",
+		Price:     sdk.NewDec(1),
+		Source:    "elys",
+		Provider:  sdk.AccAddress([]byte("provider")).String(),
+		Timestamp: uint64(suite.ctx.BlockTime().Unix()),
+	})
+	msgServer := keeper.NewMsgServerImpl(suite.app.AmmKeeper)
+
+	feed := func(feeder sdk.AccAddress, amount int64) error {
+		_, err := msgServer.FeedMultipleExternalLiquidity(sdk.WrapSDKContext(suite.ctx), &types.MsgFeedMultipleExternalLiquidity{
+			Sender: feeder.String(),
+			Liquidity: []types.ExternalLiquidity{
+				{
+					PoolId: 1,
+					AmountDepthInfo: []types.AssetAmountDepth{
+						{Asset: "USDC", Amount: sdk.NewDec(amount), Depth: sdk.NewDecWithPrec(5, 1)},
+					},
+				},
+			},
+		})
+		return err
+	}
+
+	feeders := []sdk.AccAddress{}
+	for i := 0; i < 3; i++ {
+		feeder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		suite.app.OracleKeeper.SetPriceFeeder(suite.ctx, oracletypes.PriceFeeder{Feeder: feeder.String(), IsActive: true})
+		feeders = append(feeders, feeder)
+	}
+
+	// the pool ratio is left unchanged until enough feeders submitted
+	initialPool, found := suite.app.AmmKeeper.GetPool(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().NoError(feed(feeders[0], 10000000))
+	suite.Require().NoError(feed(feeders[1], 40000000))
+	pool, found := suite.app.AmmKeeper.GetPool(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(initialPool.PoolParams.ExternalLiquidityRatio, pool.PoolParams.ExternalLiquidityRatio)
+	suite.Require().NoError(feed(feeders[2], 20000000))
+
+	// pool ratio is the median of the three feeders
+	submissions := suite.app.AmmKeeper.GetExternalLiquiditySubmissions(suite.ctx, 1)
+	suite.Require().Len(submissions, 3)
+	ratios := make(map[string]sdk.Dec)
+	for _, submission := range submissions {
+		ratios[submission.Feeder] = submission.Ratio
+	}
+	pool, found = suite.app.AmmKeeper.GetPool(suite.ctx, 1)
+	suite.Require().True(found)
+	median := ratios[feeders[2].String()]
+	suite.Require().True(median.GT(ratios[feeders[0].String()]))
+	suite.Require().True(median.LT(ratios[feeders[1].String()]))
+	suite.Require().Equal(median, pool.PoolParams.ExternalLiquidityRatio)
+
+	// submissions out of the pool bounds are rejected
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err := msgServer.SetExternalLiquidityBounds(sdk.WrapSDKContext(suite.ctx), types.NewMsgSetExternalLiquidityBounds(authority, 1, sdk.OneDec(), median))
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(feed(feeders[0], 100000000), types.ErrExternalLiquidityOutOfBounds)
+
+	res, err := suite.app.AmmKeeper.ExternalLiquidityHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryExternalLiquidityHistoryRequest{PoolId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Submissions, 3)
+	suite.Require().Equal(median, res.Bounds.MaxRatio)
+
+	// a feeder filling the history doesn't evict the latest submission of the others
+	for i := 0; i < types.ExternalLiquidityHistorySize; i++ {
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(1e9))
+		suite.Require().NoError(feed(feeders[0], 10000000))
+	}
+	submissions = suite.app.AmmKeeper.GetExternalLiquiditySubmissions(suite.ctx, 1)
+	suite.Require().Len(submissions, types.ExternalLiquidityHistorySize)
+	for _, submission := range submissions {
+		suite.Require().Equal(feeders[0].String(), submission.Feeder)
+	}
+	suite.Require().Len(suite.app.AmmKeeper.GetLatestExternalLiquiditySubmissions(suite.ctx, 1), 3)
+	pool, found = suite.app.AmmKeeper.GetPool(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(median, pool.PoolParams.ExternalLiquidityRatio)
+
+	// stale feeders are left out of the aggregation, below the quorum the pool ratio is kept
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.ExternalLiquidityFeedMaxAge*1e9 + 1e9))
+	suite.Require().NoError(feed(feeders[0], 10000000))
+	pool, found = suite.app.AmmKeeper.GetPool(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(median, pool.PoolParams.ExternalLiquidityRatio)
+	_, found = suite.app.AmmKeeper.AggregateExternalLiquidityRatio(suite.ctx, 1)
+	suite.Require().False(found)
+}
@@ -27,6 +27,7 @@ func (k Keeper) OnCollectFee(ctx sdk.Context, pool types.Pool, fee sdk.Coins) er
 	if err != nil {
 		return nil
 	}
+	k.TrackPoolFee(ctx, pool.PoolId, fee, revenueAmount)
 	return k.SwapFeesToRevenueToken(ctx, pool, revenueAmount)
 }
 
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// SetFlashSwapLoan stores an outstanding flash swap for the current tx
+func (k Keeper) SetFlashSwapLoan(ctx sdk.Context, loan types.FlashSwapLoan) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.KeyPrefix(types.TFlashSwapLoanKey))
+	bz := k.cdc.MustMarshal(&loan)
+	store.Set(types.TFlashSwapLoanKeyFor(loan.PoolId, sdk.MustAccAddressFromBech32(loan.Sender)), bz)
+}
+
+func (k Keeper) GetFlashSwapLoan(ctx sdk.Context, poolId uint64, sender sdk.AccAddress) (val types.FlashSwapLoan, found bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.KeyPrefix(types.TFlashSwapLoanKey))
+	bz := store.Get(types.TFlashSwapLoanKeyFor(poolId, sender))
+	if bz == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+func (k Keeper) DeleteFlashSwapLoan(ctx sdk.Context, poolId uint64, sender sdk.AccAddress) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.KeyPrefix(types.TFlashSwapLoanKey))
+	store.Delete(types.TFlashSwapLoanKeyFor(poolId, sender))
+}
+
+// HasFlashSwapLoan returns true when a flash swap borrowed from the pool in the current tx is not repaid yet
+func (k Keeper) HasFlashSwapLoan(ctx sdk.Context, poolId uint64) bool {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.KeyPrefix(types.TFlashSwapLoanKey))
+	iterator := sdk.KVStorePrefixIterator(store, sdk.Uint64ToBigEndian(poolId))
+
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// GetAllFlashSwapLoans returns all outstanding flash swaps
+func (k Keeper) GetAllFlashSwapLoans(ctx sdk.Context) (list []types.FlashSwapLoan) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.KeyPrefix(types.TFlashSwapLoanKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FlashSwapLoan
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetFlashSwapPool marks a pool as lent by a flash swap in the current tx
+func (k Keeper) SetFlashSwapPool(ctx sdk.Context, poolId uint64) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.KeyPrefix(types.TFlashSwapPoolKey))
+	store.Set(sdk.Uint64ToBigEndian(poolId), []byte{1})
+}
+
+func (k Keeper) DeleteFlashSwapPool(ctx sdk.Context, poolId uint64) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.KeyPrefix(types.TFlashSwapPoolKey))
+	store.Delete(sdk.Uint64ToBigEndian(poolId))
+}
+
+// GetAllFlashSwapPools returns the ids of the pools lent by a flash swap in the current tx
+func (k Keeper) GetAllFlashSwapPools(ctx sdk.Context) (list []uint64) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.KeyPrefix(types.TFlashSwapPoolKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, sdk.BigEndianToUint64(iterator.Key()))
+	}
+
+	return
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/keeper"
+	"github.com/elys-network/elys/x/amm/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+func (suite *KeeperTestSuite) TestFlashSwap() {
+	suite.SetupTest()
+	suite.SetupStableCoinPrices()
+	sender := suite.setupSimulationPool()
+	msgServer := keeper.NewMsgServerImpl(suite.app.AmmKeeper)
+	tokenOut := sdk.NewInt64Coin(ptypes.BaseCurrency, 10000)
+
+	// no post handler enforces the repayment outside of a tx
+	_, err := msgServer.FlashSwap(sdk.WrapSDKContext(suite.ctx), types.NewMsgFlashSwap(sender.String(), 1, tokenOut, ptypes.Elys, sdk.NewInt(20000)))
+	suite.Require().ErrorIs(err, types.ErrInvalidFlashSwap)
+	suite.Require().Empty(suite.app.AmmKeeper.GetAllFlashSwapLoans(suite.ctx))
+
+	suite.ctx = suite.ctx.WithTxBytes([]byte("tx"))
+	res, err := msgServer.FlashSwap(sdk.WrapSDKContext(suite.ctx), types.NewMsgFlashSwap(sender.String(), 1, tokenOut, ptypes.Elys, sdk.NewInt(20000)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(ptypes.Elys, res.TokenIn.Denom)
+	suite.Require().True(res.TokenIn.Amount.IsPositive())
+
+	// tokenOut is sent before payment and the loan is outstanding until repaid
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, ptypes.BaseCurrency)
+	suite.Require().Equal(sdk.NewInt(1010000), balance.Amount)
+	suite.Require().ErrorIs(suite.app.AmmKeeper.CheckFlashSwaps(suite.ctx), types.ErrFlashSwapNotRepaid)
+
+	// a second flash swap on the same pool is rejected while the first is outstanding
+	_, err = msgServer.FlashSwap(sdk.WrapSDKContext(suite.ctx), types.NewMsgFlashSwap(sender.String(), 1, tokenOut, ptypes.Elys, sdk.NewInt(20000)))
+	suite.Require().ErrorIs(err, types.ErrInvalidFlashSwap)
+
+	// the pool can't be joined or exited while its reserves count the unpaid token in
+	_, _, err = suite.app.AmmKeeper.JoinPoolNoSwap(suite.ctx, sender, 1, sdk.NewInt(1000), sdk.Coins{tokenOut}, false)
+	suite.Require().ErrorIs(err, types.ErrFlashSwapOutstanding)
+	_, _, _, err = suite.app.AmmKeeper.ZapJoinPool(suite.ctx, sender, 1, tokenOut, sdk.ZeroInt())
+	suite.Require().ErrorIs(err, types.ErrFlashSwapOutstanding)
+	_, err = suite.app.AmmKeeper.ExitPool(suite.ctx, sender, 1, sdk.NewInt(1000), sdk.Coins{}, "")
+	suite.Require().ErrorIs(err, types.ErrFlashSwapOutstanding)
+
+	repayRes, err := msgServer.RepayFlashSwap(sdk.WrapSDKContext(suite.ctx), types.NewMsgRepayFlashSwap(sender.String(), 1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.TokenIn, repayRes.TokenIn)
+	balance = suite.app.BankKeeper.GetBalance(suite.ctx, sender, ptypes.Elys)
+	suite.Require().Equal(sdk.NewInt(1000000).Sub(res.TokenIn.Amount), balance.Amount)
+	suite.Require().NoError(suite.app.AmmKeeper.CheckFlashSwaps(suite.ctx))
+	suite.Require().Empty(suite.app.AmmKeeper.GetAllFlashSwapPools(suite.ctx))
+
+	// nothing left to repay
+	_, err = msgServer.RepayFlashSwap(sdk.WrapSDKContext(suite.ctx), types.NewMsgRepayFlashSwap(sender.String(), 1))
+	suite.Require().ErrorIs(err, types.ErrInvalidFlashSwap)
+
+	// max amount in is enforced
+	_, err = msgServer.FlashSwap(sdk.WrapSDKContext(suite.ctx), types.NewMsgFlashSwap(sender.String(), 1, tokenOut, ptypes.Elys, sdk.NewInt(100)))
+	suite.Require().ErrorIs(err, types.ErrLimitMaxAmount)
+}
+
+func (suite *KeeperTestSuite) TestExecuteFlashSwap() {
+	suite.SetupTest()
+	suite.SetupStableCoinPrices()
+	sender := suite.setupSimulationPool()
+	tokenOut := sdk.NewInt64Coin(ptypes.BaseCurrency, 10000)
+
+	// failing callback reverts the loan
+	_, err := suite.app.AmmKeeper.ExecuteFlashSwap(suite.ctx, sender, 1, tokenOut, ptypes.Elys, sdk.NewInt(20000), func(ctx sdk.Context, tokenIn sdk.Coin) error {
+		return errors.New("arbitrage failed")
+	})
+	suite.Require().Error(err)
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, ptypes.BaseCurrency)
+	suite.Require().Equal(sdk.NewInt(1000000), balance.Amount)
+	suite.Require().Empty(suite.app.AmmKeeper.GetAllFlashSwapLoans(suite.ctx))
+
+	// callback spending the borrowed tokens fails the repayment when the sender can't pay
+	_, err = suite.app.AmmKeeper.ExecuteFlashSwap(suite.ctx, sender, 1, tokenOut, ptypes.Elys, sdk.NewInt(20000), func(ctx sdk.Context, tokenIn sdk.Coin) error {
+		pool, _ := suite.app.AmmKeeper.GetPool(ctx, 1)
+		return suite.app.BankKeeper.SendCoins(ctx, sender, sdk.MustAccAddressFromBech32(pool.RebalanceTreasury), suite.app.BankKeeper.GetAllBalances(ctx, sender))
+	})
+	suite.Require().Error(err)
+
+	tokenIn, err := suite.app.AmmKeeper.ExecuteFlashSwap(suite.ctx, sender, 1, tokenOut, ptypes.Elys, sdk.NewInt(20000), func(ctx sdk.Context, tokenIn sdk.Coin) error {
+		return nil
+	})
+	suite.Require().NoError(err)
+	balance = suite.app.BankKeeper.GetBalance(suite.ctx, sender, ptypes.Elys)
+	suite.Require().Equal(sdk.NewInt(1000000).Sub(tokenIn.Amount), balance.Amount)
+
+	pool, found := suite.app.AmmKeeper.GetPool(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().NoError(suite.app.AmmKeeper.CheckPoolInvariant(suite.ctx, pool))
+}
@@ -16,7 +16,7 @@ func (k Keeper) getElysRoutedMultihopTotalSwapFee(ctx sdk.Context, route types.M
 		if !poolExists {
 			return sdk.Dec{}, sdk.Dec{}, types.ErrInvalidPoolId
 		}
-		swapFee := pool.GetPoolParams().SwapFee
+		swapFee := k.GetEffectiveSwapFee(ctx, pool)
 		additiveSwapFee = additiveSwapFee.Add(swapFee)
 		maxSwapFee = sdk.MaxDec(maxSwapFee, swapFee)
 	}
@@ -13,6 +13,7 @@ import (
 // - Mints LP shares to the pool creator
 // - Sets bank metadata for the LP denom
 // - Records total liquidity increase
+// - Starts the pool twap accumulators
 // - Calls the AfterPoolCreated hook
 func (k Keeper) InitializePool(ctx sdk.Context, pool *types.Pool, sender sdk.AccAddress) (err error) {
 	tvl, err := pool.TVL(ctx, k.oracleKeeper)
@@ -56,6 +57,7 @@ func (k Keeper) InitializePool(ctx sdk.Context, pool *types.Pool, sender sdk.Acc
 	if err := k.SetPool(ctx, *pool); err != nil {
 		return err
 	}
+	k.UpdatePoolTwap(ctx, *pool)
 
 	if k.hooks != nil {
 		k.hooks.AfterPoolCreated(ctx, sender, *pool)
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+	ctypes "github.com/elys-network/elys/x/commitment/types"
+)
+
+// RegisterInvariants registers all amm invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "pool-balances", PoolBalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "denom-liquidity", DenomLiquidityInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-shares", TotalSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-weight", TotalWeightInvariant(k))
+}
+
+// AllInvariants runs all invariants of the amm module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			PoolBalancesInvariant(k),
+			DenomLiquidityInvariant(k),
+			TotalSharesInvariant(k),
+			TotalWeightInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// PoolBalancesInvariant checks that every pool account holds the reserves recorded in its pool
+// assets. Margin collateral is held on pool accounts too, so balances can exceed the reserves.
+func PoolBalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, pool := range k.GetAllPool(ctx) {
+			if err := k.CheckPoolInvariant(ctx, pool); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\t%s\n", err)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "pool-balances",
+			fmt.Sprintf("pool balances not covering pool assets\n%s", msg)), broken
+	}
+}
+
+// DenomLiquidityInvariant checks that the liquidity recorded for every denom equals the sum of
+// the pool assets of that denom
+func DenomLiquidityInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		expected := sdk.Coins{}
+		for _, pool := range k.GetAllPool(ctx) {
+			for _, asset := range pool.PoolAssets {
+				expected = expected.Add(asset.Token)
+			}
+		}
+
+		recorded := sdk.Coins{}
+		for _, liquidity := range k.GetAllDenomLiquidity(ctx) {
+			if liquidity.Liquidity.IsPositive() {
+				recorded = recorded.Add(sdk.NewCoin(liquidity.Denom, liquidity.Liquidity))
+			}
+			if !liquidity.Liquidity.Equal(expected.AmountOf(liquidity.Denom)) {
+				broken = true
+				msg += fmt.Sprintf("\tdenom %s liquidity %s, pools hold %s\n", liquidity.Denom, liquidity.Liquidity, expected.AmountOf(liquidity.Denom))
+			}
+		}
+		for _, coin := range expected {
+			if recorded.AmountOf(coin.Denom).IsZero() {
+				broken = true
+				msg += fmt.Sprintf("\tdenom %s has no recorded liquidity, pools hold %s\n", coin.Denom, coin.Amount)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "denom-liquidity",
+			fmt.Sprintf("denom liquidity not matching pool assets\n%s", msg)), broken
+	}
+}
+
+// TotalSharesInvariant checks that the total shares of every pool equal the supply of its share
+// denom. Committed shares are burnt by the commitment module, so they are added to the bank supply.
+func TotalSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		offBank := sdk.Coins{}
+		if k.commitmentKeeper != nil {
+			k.commitmentKeeper.IterateCommitments(ctx, func(commitments ctypes.Commitments) bool {
+				for _, token := range commitments.CommittedTokens {
+					offBank = offBank.Add(sdk.NewCoin(token.Denom, token.Amount))
+				}
+				for _, token := range commitments.UncommittedTokens {
+					offBank = offBank.Add(sdk.NewCoin(token.Denom, token.Amount))
+				}
+				return false
+			})
+		}
+
+		for _, pool := range k.GetAllPool(ctx) {
+			shareDenom := types.GetPoolShareDenom(pool.PoolId)
+			supply := k.bankKeeper.GetSupply(ctx, shareDenom).Amount.Add(offBank.AmountOf(shareDenom))
+			if !pool.TotalShares.Amount.Equal(supply) {
+				broken = true
+				msg += fmt.Sprintf("\tpool %d total shares %s, supply %s\n", pool.PoolId, pool.TotalShares.Amount, supply)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "total-shares",
+			fmt.Sprintf("pool total shares not matching share supply\n%s", msg)), broken
+	}
+}
+
+// TotalWeightInvariant checks that the total weight of every pool equals the sum of its asset weights
+func TotalWeightInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, pool := range k.GetAllPool(ctx) {
+			totalWeight := sdk.ZeroInt()
+			for _, asset := range pool.PoolAssets {
+				totalWeight = totalWeight.Add(asset.Weight)
+			}
+			if !pool.TotalWeight.Equal(totalWeight) {
+				broken = true
+				msg += fmt.Sprintf("\tpool %d total weight %s, sum of asset weights %s\n", pool.PoolId, pool.TotalWeight, totalWeight)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "total-weight",
+			fmt.Sprintf("pool total weight not matching asset weights\n%s", msg)), broken
+	}
+}
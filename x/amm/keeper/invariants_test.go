@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	simapp "github.com/elys-network/elys/app"
+	"github.com/elys-network/elys/x/amm/keeper"
+	"github.com/elys-network/elys/x/amm/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	suite.SetupTest()
+	suite.SetupStableCoinPrices()
+
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	senderInitBalance := sdk.Coins{sdk.NewInt64Coin(ptypes.Elys, 2000000), sdk.NewInt64Coin(ptypes.BaseCurrency, 2000000)}
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, senderInitBalance)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sender, senderInitBalance)
+	suite.Require().NoError(err)
+	simapp.AddTestPoolCreationPermissions(suite.app, suite.ctx, ptypes.Elys, ptypes.BaseCurrency)
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.AmmKeeper)
+	_, err = msgServer.CreatePool(sdk.WrapSDKContext(suite.ctx), &types.MsgCreatePool{
+		Sender: sender.String(),
+		PoolParams: &types.PoolParams{
+			SwapFee:                     sdk.ZeroDec(),
+			ExitFee:                     sdk.ZeroDec(),
+			WeightBreakingFeeMultiplier: sdk.ZeroDec(),
+			ExternalLiquidityRatio:      sdk.NewDec(1),
+			LpFeePortion:                sdk.ZeroDec(),
+			StakingFeePortion:           sdk.ZeroDec(),
+			WeightRecoveryFeePortion:    sdk.ZeroDec(),
+			ThresholdWeightDifference:   sdk.ZeroDec(),
+			FeeDenom:                    ptypes.BaseCurrency,
+		},
+		PoolAssets: []types.PoolAsset{
+			{Token: sdk.NewInt64Coin(ptypes.Elys, 1000000), Weight: sdk.OneInt()},
+			{Token: sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000), Weight: sdk.OneInt()},
+		},
+	})
+	suite.Require().NoError(err)
+
+	routes := []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: ptypes.BaseCurrency}}
+	_, err = suite.app.AmmKeeper.RouteExactAmountIn(suite.ctx, sender, routes, sdk.NewInt64Coin(ptypes.Elys, 10000), sdk.ZeroInt())
+	suite.Require().NoError(err)
+
+	msg, broken := keeper.AllInvariants(suite.app.AmmKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+
+	// drifted denom liquidity
+	liquidity, found := suite.app.AmmKeeper.GetDenomLiquidity(suite.ctx, ptypes.Elys)
+	suite.Require().True(found)
+	liquidity.Liquidity = liquidity.Liquidity.AddRaw(1)
+	suite.app.AmmKeeper.SetDenomLiquidity(suite.ctx, liquidity)
+	_, broken = keeper.DenomLiquidityInvariant(suite.app.AmmKeeper)(suite.ctx)
+	suite.Require().True(broken)
+
+	// pool records more reserves, shares and weight than it has
+	pool, found := suite.app.AmmKeeper.GetPool(suite.ctx, 1)
+	suite.Require().True(found)
+	pool.PoolAssets[0].Token.Amount = pool.PoolAssets[0].Token.Amount.AddRaw(1)
+	pool.TotalShares.Amount = pool.TotalShares.Amount.AddRaw(1)
+	pool.TotalWeight = pool.TotalWeight.AddRaw(1)
+	suite.Require().NoError(suite.app.AmmKeeper.SetPool(suite.ctx, pool))
+	_, broken = keeper.PoolBalancesInvariant(suite.app.AmmKeeper)(suite.ctx)
+	suite.Require().True(broken)
+	_, broken = keeper.TotalSharesInvariant(suite.app.AmmKeeper)(suite.ctx)
+	suite.Require().True(broken)
+	_, broken = keeper.TotalWeightInvariant(suite.app.AmmKeeper)(suite.ctx)
+	suite.Require().True(broken)
+}
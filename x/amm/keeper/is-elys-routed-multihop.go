@@ -4,14 +4,20 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	appparams "github.com/elys-network/elys/app/params"
 	"github.com/elys-network/elys/x/amm/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
 )
 
+// isElysRoutedMultihop returns true for two hop routes between distinct pools going through
+// ELYS or USDC, these routes get a discounted swap fee
 func (k Keeper) isElysRoutedMultihop(ctx sdk.Context, route types.MultihopRoute, inDenom, outDenom string) (isRouted bool) {
 	if route.Length() != 2 {
 		return false
 	}
 	intemediateDenoms := route.IntermediateDenoms()
-	if len(intemediateDenoms) != 1 || intemediateDenoms[0] != appparams.BaseCoinUnit {
+	if len(intemediateDenoms) != 1 {
+		return false
+	}
+	if intemediateDenoms[0] != appparams.BaseCoinUnit && intemediateDenoms[0] != ptypes.BaseCurrency {
 		return false
 	}
 	if inDenom == outDenom {
@@ -27,6 +27,7 @@ type (
 		commitmentKeeper    *commitmentkeeper.Keeper
 		apKeeper            types.AssetProfileKeeper
 		accountedPoolKeeper types.AccountedPoolKeeper
+		authority           string
 	}
 )
 
@@ -42,6 +43,7 @@ func NewKeeper(
 	commitmentKeeper *commitmentkeeper.Keeper,
 	apKeeper types.AssetProfileKeeper,
 	accountedPoolKeeper types.AccountedPoolKeeper,
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -60,6 +62,7 @@ func NewKeeper(
 		commitmentKeeper:    commitmentKeeper,
 		apKeeper:            apKeeper,
 		accountedPoolKeeper: accountedPoolKeeper,
+		authority:           authority,
 	}
 }
 
@@ -67,6 +70,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetAuthority returns the x/amm module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Set the amm hooks.
 func (k *Keeper) SetHooks(gh types.AmmHooks) *Keeper {
 	if k.hooks != nil {
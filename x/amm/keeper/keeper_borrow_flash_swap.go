@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// BorrowFlashSwap prices an exact amount out swap and sends tokenOut to the sender before tokenIn is paid.
+// The returned tokenIn must be paid back with SettleFlashSwap before the end of the tx. The repayment is
+// enforced by the tx post handler, so borrowing is rejected outside of a tx, e.g. from a contract called
+// by a begin or end blocker. Those callers can use ExecuteFlashSwap instead.
+func (k Keeper) BorrowFlashSwap(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	tokenInMaxAmount math.Int,
+) (sdk.Coin, error) {
+	if len(ctx.TxBytes()) == 0 {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidFlashSwap, "flash swaps can only be borrowed within a tx")
+	}
+
+	return k.borrowFlashSwap(ctx, sender, poolId, tokenOut, tokenInDenom, tokenInMaxAmount)
+}
+
+func (k Keeper) borrowFlashSwap(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	tokenInMaxAmount math.Int,
+) (tokenIn sdk.Coin, err error) {
+	if tokenInDenom == tokenOut.Denom {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidFlashSwap, "cannot trade the same denomination in and out")
+	}
+	if _, found := k.GetFlashSwapLoan(ctx, poolId, sender); found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidFlashSwap, "%s already has an outstanding flash swap on pool %d", sender, poolId)
+	}
+
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidPoolId, "pool %d", poolId)
+	}
+	if k.IsPoolPaused(ctx, poolId) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrPoolPaused, "pool %d", poolId)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tokenIn = sdk.Coin{}
+			err = fmt.Errorf("function BorrowFlashSwap failed due to an internal reason: %v", r)
+		}
+	}()
+
+	poolOutBal := pool.GetTotalPoolLiquidity().AmountOf(tokenOut.Denom)
+	if tokenOut.Amount.GTE(poolOutBal) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrTooManyTokensOut, "cannot get more tokens out than there are tokens in the pool")
+	}
+
+	swapFee := k.GetEffectiveSwapFee(ctx, pool)
+	snapshot := k.GetPoolSnapshotOrSet(ctx, pool)
+	tokenIn, slippageAmount, weightBalanceBonus, err := pool.SwapInAmtGivenOut(ctx, k.oracleKeeper, &snapshot, sdk.Coins{tokenOut}, tokenInDenom, swapFee, k.accountedPoolKeeper)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if tokenIn.Amount.LTE(sdk.ZeroInt()) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
+	}
+
+	if tokenIn.Amount.GT(tokenInMaxAmount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "swap requires %s, which is greater than the amount %s", tokenIn, tokenInMaxAmount)
+	}
+
+	// pool reserves already account for tokenIn, the pool balance catches up on repayment
+	err = k.SetPool(ctx, pool)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	poolAddr := sdk.MustAccAddressFromBech32(pool.GetAddress())
+	err = k.bankKeeper.SendCoins(ctx, poolAddr, sender, sdk.Coins{tokenOut})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.SetFlashSwapLoan(ctx, types.FlashSwapLoan{
+		Sender:             sender.String(),
+		PoolId:             poolId,
+		TokenIn:            tokenIn,
+		TokenOut:           tokenOut,
+		SwapFee:            swapFee,
+		WeightBalanceBonus: weightBalanceBonus,
+		Slippage:           slippageAmount,
+	})
+	k.SetFlashSwapPool(ctx, poolId)
+
+	return tokenIn, nil
+}
@@ -9,8 +9,8 @@ import (
 )
 
 // CreatePool attempts to create a pool returning the newly created pool ID or
-// an error upon failure. The pool creation fee is used to fund the community
-// pool. It will create a dedicated module account for the pool and sends the
+// an error upon failure. The pool creation fee is charged in USDC and sent to
+// the fee receiver or burnt. It will create a dedicated module account for the pool and sends the
 // initial liquidity to the created module account.
 //
 // After the initial liquidity is sent to the pool's account, this function calls an
@@ -19,12 +19,10 @@ import (
 // - Minting LP shares to pool creator
 // - Setting metadata for the shares
 func (k Keeper) CreatePool(ctx sdk.Context, msg *types.MsgCreatePool) (uint64, error) {
-	// Send pool creation fee to community pool
-	// params := k.GetParams(ctx)
 	sender := msg.GetSigners()[0]
-	// if err := k.communityPoolKeeper.FundCommunityPool(ctx, params.PoolCreationFee, sender); err != nil {
-	// 	return 0, err
-	// }
+	if err := k.ChargePoolCreationFee(ctx, sender); err != nil {
+		return 0, err
+	}
 
 	// Get the next pool ID and increment the pool ID counter
 	// Create the pool with the given pool ID
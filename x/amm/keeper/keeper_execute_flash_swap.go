@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// ExecuteFlashSwap lends tokenOut to the sender, runs the callback and collects tokenIn back from the sender.
+// It is intended for module callers, no state change is kept when the callback or the repayment fails.
+// As the repayment is enforced here, it can be used outside of a tx.
+func (k Keeper) ExecuteFlashSwap(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	tokenInMaxAmount math.Int,
+	callback func(ctx sdk.Context, tokenIn sdk.Coin) error,
+) (sdk.Coin, error) {
+	cacheCtx, write := ctx.CacheContext()
+	tokenIn, err := k.borrowFlashSwap(cacheCtx, sender, poolId, tokenOut, tokenInDenom, tokenInMaxAmount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	err = callback(cacheCtx, tokenIn)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	_, err = k.SettleFlashSwap(cacheCtx, sender, poolId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	pool, found := k.GetPool(cacheCtx, poolId)
+	if !found {
+		return sdk.Coin{}, types.ErrInvalidPoolId
+	}
+	err = k.CheckPoolInvariant(cacheCtx, pool)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	write()
+	return tokenIn, nil
+}
@@ -19,6 +19,11 @@ func (k Keeper) ExitPool(
 		return sdk.Coins{}, types.ErrInvalidPoolId
 	}
 
+	// the pool reserves already account for the unpaid token in of a flash swap
+	if k.HasFlashSwapLoan(ctx, poolId) {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrFlashSwapOutstanding, "pool %d", poolId)
+	}
+
 	totalSharesAmount := pool.GetTotalShares()
 	if shareInAmount.GTE(totalSharesAmount.Amount) {
 		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "Trying to exit >= the number of shares contained in the pool.")
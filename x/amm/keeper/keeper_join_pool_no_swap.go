@@ -37,6 +37,15 @@ func (k Keeper) JoinPoolNoSwap(
 		return nil, sdk.ZeroInt(), types.ErrInvalidPoolId
 	}
 
+	if k.IsPoolPaused(ctx, poolId) {
+		return nil, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrPoolPaused, "pool %d", poolId)
+	}
+
+	// the pool reserves already account for the unpaid token in of a flash swap
+	if k.HasFlashSwapLoan(ctx, poolId) {
+		return nil, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrFlashSwapOutstanding, "pool %d", poolId)
+	}
+
 	if !pool.PoolParams.UseOracle {
 		tokensIn := tokenInMaxs
 		if !noRemaining {
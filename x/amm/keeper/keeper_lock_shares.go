@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// LockShares locks amount of the sender committed shares of pool #{poolId} for duration seconds.
+// Locked shares can't be uncommitted, they earn boosted Eden LP rewards and are released by the
+// commitment module unlock queue once the lock ends.
+func (k Keeper) LockShares(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	amount sdk.Int,
+	duration uint64,
+) (unlockTimestamp uint64, boost sdk.Dec, err error) {
+	_, found := k.GetPool(ctx, poolId)
+	if !found {
+		return 0, sdk.ZeroDec(), types.ErrInvalidPoolId
+	}
+
+	maxDuration := k.MaxShareLockDuration(ctx)
+	if duration == 0 || duration > maxDuration {
+		return 0, sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrInvalidShareLockDuration, "duration %d must be in (0, %d]", duration, maxDuration)
+	}
+
+	unlockTimestamp = uint64(ctx.BlockTime().Unix()) + duration
+	boost = k.GetShareLockBoost(ctx, duration)
+	err = k.commitmentKeeper.LockCommittedTokens(ctx, sender.String(), types.GetPoolShareDenom(poolId), amount, unlockTimestamp, boost)
+	if err != nil {
+		return 0, sdk.ZeroDec(), err
+	}
+
+	return unlockTimestamp, boost, nil
+}
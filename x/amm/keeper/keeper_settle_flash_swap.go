@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// SettleFlashSwap collects tokenIn of an outstanding flash swap from the sender and settles
+// the swap fee, weight recovery bonus and liquidity records the same way as UpdatePoolForSwap.
+func (k Keeper) SettleFlashSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) (sdk.Coin, error) {
+	loan, found := k.GetFlashSwapLoan(ctx, poolId, sender)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidFlashSwap, "no outstanding flash swap on pool %d for %s", poolId, sender)
+	}
+
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidPoolId, "pool %d", poolId)
+	}
+
+	tokensIn := sdk.Coins{loan.TokenIn}
+	tokensOut := sdk.Coins{loan.TokenOut}
+
+	poolAddr := sdk.MustAccAddressFromBech32(pool.GetAddress())
+	err := k.bankKeeper.SendCoins(ctx, sender, poolAddr, tokensIn)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// apply swap fee when weight balance bonus is not available
+	swapFeeInCoins := sdk.Coins{}
+	if loan.WeightBalanceBonus.IsZero() {
+		swapFeeInCoins = PortionCoins(tokensIn, loan.SwapFee)
+	}
+
+	rebalanceTreasuryAddr := sdk.MustAccAddressFromBech32(pool.GetRebalanceTreasury())
+	if swapFeeInCoins.IsAllPositive() {
+		err = k.bankKeeper.SendCoins(ctx, poolAddr, rebalanceTreasuryAddr, swapFeeInCoins)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		err = k.OnCollectFee(ctx, pool, swapFeeInCoins)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	// calculate treasury amount to send as bonus
+	treasuryTokenAmount := k.bankKeeper.GetBalance(ctx, rebalanceTreasuryAddr, loan.TokenOut.Denom).Amount
+	bonusTokenAmount := sdk.NewDecFromInt(loan.TokenOut.Amount).Mul(loan.WeightBalanceBonus).RoundInt()
+	if treasuryTokenAmount.LT(bonusTokenAmount) {
+		bonusTokenAmount = treasuryTokenAmount
+	}
+
+	// send bonus tokens to sender if positive
+	weightRecoveryPaid := sdk.Coins{}
+	if loan.WeightBalanceBonus.IsPositive() && bonusTokenAmount.IsPositive() {
+		bonusToken := sdk.NewCoin(loan.TokenOut.Denom, bonusTokenAmount)
+		err = k.bankKeeper.SendCoins(ctx, rebalanceTreasuryAddr, sender, sdk.Coins{bonusToken})
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		weightRecoveryPaid = sdk.Coins{bonusToken}
+	}
+
+	types.EmitSwapEvent(ctx, sender, pool.GetPoolId(), tokensIn, tokensOut)
+	if k.hooks != nil {
+		k.hooks.AfterSwap(ctx, sender, pool, tokensIn, tokensOut)
+	}
+	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
+	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
+	k.RecordTotalLiquidityDecrease(ctx, swapFeeInCoins)
+	k.TrackPoolSwap(ctx, pool.PoolId, tokensIn, weightRecoveryPaid)
+	k.UpdatePoolTwap(ctx, pool)
+
+	// track slippage
+	k.TrackSlippage(ctx, pool.PoolId, sdk.NewCoin(loan.TokenIn.Denom, loan.Slippage.RoundInt()))
+
+	k.DeleteFlashSwapLoan(ctx, poolId, sender)
+	return loan.TokenIn, nil
+}
@@ -28,7 +28,10 @@ func (k Keeper) SwapExactAmountIn(
 	if tokenIn.Denom == tokenOutDenom {
 		return math.Int{}, errors.New("cannot trade the same denomination in and out")
 	}
-	poolSwapFee := pool.GetPoolParams().SwapFee
+	if k.IsPoolPaused(ctx, pool.PoolId) {
+		return math.Int{}, sdkerrors.Wrapf(types.ErrPoolPaused, "pool %d", pool.PoolId)
+	}
+	poolSwapFee := ApplySwapFeeDiscount(k.GetEffectiveSwapFee(ctx, pool), k.GetSwapFeeDiscount(ctx, sender))
 	if swapFee.LT(poolSwapFee.QuoInt64(2)) {
 		return math.Int{}, fmt.Errorf("given swap fee (%s) must be greater than or equal to half of the pool's swap fee (%s)", swapFee, poolSwapFee)
 	}
@@ -26,6 +26,9 @@ func (k Keeper) SwapExactAmountOut(
 	if tokenInDenom == tokenOut.Denom {
 		return math.Int{}, errors.New("cannot trade the same denomination in and out")
 	}
+	if k.IsPoolPaused(ctx, pool.PoolId) {
+		return math.Int{}, sdkerrors.Wrapf(types.ErrPoolPaused, "pool %d", pool.PoolId)
+	}
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// ZapJoinPool joins a two asset non-oracle pool from tokenIn alone. The swap amount computed by CalcZapJoin is
+// swapped into the other pool asset through the regular swap path, then the remaining tokenIn and the swap
+// proceeds join the pool at its exact ratio. Rounding dust that can't be joined stays in the sender account.
+func (k Keeper) ZapJoinPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	shareOutMinAmount sdk.Int,
+) (tokenSwapped sdk.Coin, tokensJoined sdk.Coins, sharesOut sdk.Int, err error) {
+	// defer to catch panics, in case something internal overflows.
+	defer func() {
+		if r := recover(); r != nil {
+			tokenSwapped = sdk.Coin{}
+			tokensJoined = sdk.Coins{}
+			sharesOut = sdk.Int{}
+			err = fmt.Errorf("function ZapJoinPool failed due to internal reason: %v", r)
+		}
+	}()
+
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		return sdk.Coin{}, nil, sdk.ZeroInt(), types.ErrInvalidPoolId
+	}
+
+	if k.IsPoolPaused(ctx, poolId) {
+		return sdk.Coin{}, nil, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrPoolPaused, "pool %d", poolId)
+	}
+
+	// the pool reserves already account for the unpaid token in of a flash swap
+	if k.HasFlashSwapLoan(ctx, poolId) {
+		return sdk.Coin{}, nil, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrFlashSwapOutstanding, "pool %d", poolId)
+	}
+
+	swapFee := ApplySwapFeeDiscount(k.GetEffectiveSwapFee(ctx, pool), k.GetSwapFeeDiscount(ctx, sender))
+	swapAmount, expectedShares, _, err := k.CalcZapJoin(ctx, sender, pool, tokenIn, swapFee)
+	if err != nil {
+		return sdk.Coin{}, nil, sdk.ZeroInt(), err
+	}
+	if expectedShares.LT(shareOutMinAmount) {
+		return sdk.Coin{}, nil, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrLimitMinAmount, "zap join shares %s lower than minimum %s", expectedShares, shareOutMinAmount)
+	}
+
+	otherDenom := pool.PoolAssets[0].Token.Denom
+	if otherDenom == tokenIn.Denom {
+		otherDenom = pool.PoolAssets[1].Token.Denom
+	}
+
+	holdings := sdk.NewCoins(sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.Sub(swapAmount)))
+	tokenSwapped = sdk.NewCoin(tokenIn.Denom, swapAmount)
+	if swapAmount.IsPositive() {
+		proceeds, err := k.SwapExactAmountIn(ctx, sender, pool, tokenSwapped, otherDenom, sdk.ZeroInt(), swapFee)
+		if err != nil {
+			return sdk.Coin{}, nil, sdk.ZeroInt(), err
+		}
+		holdings = holdings.Add(sdk.NewCoin(otherDenom, proceeds))
+
+		pool, _ = k.GetPool(ctx, poolId)
+	}
+
+	sharesOut, tokensJoined = pool.CalcExactRatioJoin(holdings)
+	if sharesOut.LT(shareOutMinAmount) || !sharesOut.IsPositive() {
+		return sdk.Coin{}, nil, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrLimitMinAmount, "zap join shares %s lower than minimum %s", sharesOut, shareOutMinAmount)
+	}
+
+	pool.IncreaseLiquidity(sharesOut, tokensJoined)
+	err = k.applyJoinPoolStateChange(ctx, pool, sender, sharesOut, tokensJoined)
+	if err != nil {
+		return sdk.Coin{}, nil, sdk.ZeroInt(), err
+	}
+
+	return tokenSwapped, tokensJoined, sharesOut, nil
+}
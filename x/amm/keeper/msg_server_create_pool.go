@@ -9,11 +9,15 @@ import (
 )
 
 // CreatePool attempts to create a pool returning the newly created pool ID or an error upon failure.
-// The pool creation fee is used to fund the community pool.
+// Every pool asset must have an asset profile entry with the pool creation permission.
 // It will create a dedicated module account for the pool and sends the initial liquidity to the created module account.
 func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (*types.MsgCreatePoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.CheckPoolCreationPermission(ctx, msg); err != nil {
+		return &types.MsgCreatePoolResponse{}, err
+	}
+
 	poolId, err := k.Keeper.CreatePool(ctx, msg)
 	if err != nil {
 		return &types.MsgCreatePoolResponse{}, err
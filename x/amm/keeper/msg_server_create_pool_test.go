@@ -4,6 +4,7 @@ import (
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	simapp "github.com/elys-network/elys/app"
 	"github.com/elys-network/elys/x/amm/keeper"
 	"github.com/elys-network/elys/x/amm/types"
 	ptypes "github.com/elys-network/elys/x/parameter/types"
@@ -121,6 +122,11 @@ func (suite *KeeperTestSuite) TestMsgServerCreatePool() {
 			err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sender, tc.senderInitBalance)
 			suite.Require().NoError(err)
 
+			// allow pool creation with the pool assets
+			for _, asset := range tc.poolAssets {
+				simapp.AddTestPoolCreationPermissions(suite.app, suite.ctx, asset.Token.Denom)
+			}
+
 			// execute function
 			msgServer := keeper.NewMsgServerImpl(suite.app.AmmKeeper)
 			resp, err := msgServer.CreatePool(
@@ -162,3 +168,53 @@ func (suite *KeeperTestSuite) TestMsgServerCreatePool() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgServerCreatePoolPermissionAndFee() {
+	suite.SetupTest()
+	suite.SetupStableCoinPrices()
+
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	receiver := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	senderInitBalance := sdk.Coins{sdk.NewInt64Coin(ptypes.Elys, 1000000), sdk.NewInt64Coin(ptypes.BaseCurrency, 2000000)}
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, senderInitBalance)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sender, senderInitBalance)
+	suite.Require().NoError(err)
+
+	params := suite.app.AmmKeeper.GetParams(suite.ctx)
+	params.PoolCreationFee = 1000
+	params.PoolCreationFeeReceiver = receiver.String()
+	suite.app.AmmKeeper.SetParams(suite.ctx, params)
+
+	msg := &types.MsgCreatePool{
+		Sender: sender.String(),
+		PoolParams: &types.PoolParams{
+			SwapFee:                     sdk.ZeroDec(),
+			ExitFee:                     sdk.ZeroDec(),
+			WeightBreakingFeeMultiplier: sdk.ZeroDec(),
+			ExternalLiquidityRatio:      sdk.NewDec(1),
+			LpFeePortion:                sdk.ZeroDec(),
+			StakingFeePortion:           sdk.ZeroDec(),
+			WeightRecoveryFeePortion:    sdk.ZeroDec(),
+			ThresholdWeightDifference:   sdk.ZeroDec(),
+			FeeDenom:                    ptypes.BaseCurrency,
+		},
+		PoolAssets: []types.PoolAsset{
+			{Token: sdk.NewInt64Coin(ptypes.Elys, 1000000), Weight: sdk.OneInt()},
+			{Token: sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000), Weight: sdk.OneInt()},
+		},
+	}
+	msgServer := keeper.NewMsgServerImpl(suite.app.AmmKeeper)
+
+	// assets without pool creation permission are rejected
+	simapp.AddTestPoolCreationPermissions(suite.app, suite.ctx, ptypes.BaseCurrency)
+	_, err = msgServer.CreatePool(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrPoolCreationNotPermitted)
+
+	// the usdc creation fee is sent to the fee receiver
+	simapp.AddTestPoolCreationPermissions(suite.app, suite.ctx, ptypes.Elys)
+	_, err = msgServer.CreatePool(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(ptypes.BaseCurrency, 1000), suite.app.BankKeeper.GetBalance(suite.ctx, receiver, ptypes.BaseCurrency))
+	suite.Require().Equal(sdk.NewInt64Coin(ptypes.BaseCurrency, 999000), suite.app.BankKeeper.GetBalance(suite.ctx, sender, ptypes.BaseCurrency))
+}
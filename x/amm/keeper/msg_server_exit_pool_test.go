@@ -4,6 +4,7 @@ import (
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	simapp "github.com/elys-network/elys/app"
 	"github.com/elys-network/elys/x/amm/keeper"
 	"github.com/elys-network/elys/x/amm/types"
 	ptypes "github.com/elys-network/elys/x/parameter/types"
@@ -119,6 +120,11 @@ func (suite *KeeperTestSuite) TestMsgServerExitPool() {
 			err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sender, tc.poolInitBalance)
 			suite.Require().NoError(err)
 
+			// allow pool creation with the pool assets
+			for _, coin := range tc.poolInitBalance {
+				simapp.AddTestPoolCreationPermissions(suite.app, suite.ctx, coin.Denom)
+			}
+
 			// execute function
 			msgServer := keeper.NewMsgServerImpl(suite.app.AmmKeeper)
 			poolAssets := []types.PoolAsset{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/elys-network/elys/x/amm/types"
 	oracletypes "github.com/elys-network/elys/x/oracle/types"
@@ -62,6 +63,9 @@ func (k msgServer) FeedMultipleExternalLiquidity(goCtx context.Context, msg *typ
 		if err != nil {
 			return nil, err
 		}
+		if !tvl.IsPositive() {
+			return nil, errorsmod.Wrapf(types.ErrInvalidExternalLiquidity, "pool %d has no tvl", el.PoolId)
+		}
 
 		elValue, elDepth, err := AssetsValue(ctx, k.oracleKeeper, el.AmountDepthInfo)
 		if err != nil {
@@ -74,8 +78,33 @@ func (k msgServer) FeedMultipleExternalLiquidity(goCtx context.Context, msg *typ
 			elRatio = sdk.OneDec()
 		}
 
-		pool.PoolParams.ExternalLiquidityRatio = elRatio
-		k.SetPool(ctx, pool)
+		bounds := k.GetExternalLiquidityBounds(ctx, el.PoolId)
+		if !bounds.Contains(elRatio) {
+			return nil, errorsmod.Wrapf(types.ErrExternalLiquidityOutOfBounds, "ratio %s for pool %d is out of [%s, %s]", elRatio, el.PoolId, bounds.MinRatio, bounds.MaxRatio)
+		}
+
+		submission := types.ExternalLiquiditySubmission{
+			PoolId:          el.PoolId,
+			Feeder:          msg.Sender,
+			Timestamp:       uint64(ctx.BlockTime().Unix()),
+			Height:          ctx.BlockHeight(),
+			Value:           elValue,
+			Depth:           elDepth,
+			Ratio:           elRatio,
+			AggregatedRatio: pool.PoolParams.ExternalLiquidityRatio,
+		}
+		k.SetLatestExternalLiquiditySubmission(ctx, submission)
+
+		// the pool ratio is the median of the recent submissions of all feeders, once enough feeders submitted
+		aggregatedRatio, found := k.AggregateExternalLiquidityRatio(ctx, el.PoolId)
+		if found {
+			submission.AggregatedRatio = aggregatedRatio
+			k.SetLatestExternalLiquiditySubmission(ctx, submission)
+			pool.PoolParams.ExternalLiquidityRatio = aggregatedRatio
+			k.SetPool(ctx, pool)
+		}
+		k.SetExternalLiquiditySubmission(ctx, submission)
+		k.PruneExternalLiquiditySubmissions(ctx, el.PoolId)
 	}
 
 	return &types.MsgFeedMultipleExternalLiquidityResponse{}, nil
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+func (k msgServer) FlashSwap(goCtx context.Context, msg *types.MsgFlashSwap) (*types.MsgFlashSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIn, err := k.BorrowFlashSwap(ctx, sender, msg.PoolId, msg.TokenOut, msg.TokenInDenom, msg.TokenInMaxAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgFlashSwapResponse{TokenIn: tokenIn}, nil
+}
+
+func (k msgServer) RepayFlashSwap(goCtx context.Context, msg *types.MsgRepayFlashSwap) (*types.MsgRepayFlashSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIn, err := k.SettleFlashSwap(ctx, sender, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgRepayFlashSwapResponse{TokenIn: tokenIn}, nil
+}
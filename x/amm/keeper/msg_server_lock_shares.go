@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// LockShares locks committed pool shares to earn boosted Eden LP rewards.
+func (k msgServer) LockShares(goCtx context.Context, msg *types.MsgLockShares) (*types.MsgLockSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	unlockTimestamp, boost, err := k.Keeper.LockShares(ctx, sender, msg.PoolId, msg.Amount, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgLockSharesResponse{
+		UnlockTimestamp: unlockTimestamp,
+		Boost:           boost,
+	}, nil
+}
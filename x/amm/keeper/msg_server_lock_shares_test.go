@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	simapp "github.com/elys-network/elys/app"
+	"github.com/elys-network/elys/x/amm/keeper"
+	"github.com/elys-network/elys/x/amm/types"
+	commitmentkeeper "github.com/elys-network/elys/x/commitment/keeper"
+	ctypes "github.com/elys-network/elys/x/commitment/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgServerLockShares() {
+	suite.SetupTest()
+	suite.SetupStableCoinPrices()
+
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	senderInitBalance := sdk.Coins{sdk.NewInt64Coin(ptypes.Elys, 1000000), sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000)}
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, senderInitBalance)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sender, senderInitBalance)
+	suite.Require().NoError(err)
+
+	// create a pool, minted shares are committed
+	simapp.AddTestPoolCreationPermissions(suite.app, suite.ctx, ptypes.Elys, ptypes.BaseCurrency)
+	msgServer := keeper.NewMsgServerImpl(suite.app.AmmKeeper)
+	resp, err := msgServer.CreatePool(sdk.WrapSDKContext(suite.ctx), &types.MsgCreatePool{
+		Sender: sender.String(),
+		PoolParams: &types.PoolParams{
+			SwapFee:                     sdk.ZeroDec(),
+			ExitFee:                     sdk.ZeroDec(),
+			WeightBreakingFeeMultiplier: sdk.ZeroDec(),
+			ExternalLiquidityRatio:      sdk.NewDec(1),
+			LpFeePortion:                sdk.ZeroDec(),
+			StakingFeePortion:           sdk.ZeroDec(),
+			WeightRecoveryFeePortion:    sdk.ZeroDec(),
+			ThresholdWeightDifference:   sdk.ZeroDec(),
+			FeeDenom:                    ptypes.BaseCurrency,
+		},
+		PoolAssets: []types.PoolAsset{
+			{Token: sdk.NewInt64Coin(ptypes.Elys, 1000000), Weight: sdk.OneInt()},
+			{Token: sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000), Weight: sdk.OneInt()},
+		},
+	})
+	suite.Require().NoError(err)
+	shareDenom := types.GetPoolShareDenom(resp.PoolID)
+	commitments, found := suite.app.CommitmentKeeper.GetCommitments(suite.ctx, sender.String())
+	suite.Require().True(found)
+	shares := commitments.GetCommittedAmountForDenom(shareDenom)
+	suite.Require().True(shares.IsPositive())
+
+	// lock half of the shares for half of the max duration
+	params := suite.app.AmmKeeper.GetParams(suite.ctx)
+	duration := params.MaxShareLockDuration / 2
+	locked := shares.QuoRaw(2)
+	lockResp, err := msgServer.LockShares(sdk.WrapSDKContext(suite.ctx), &types.MsgLockShares{
+		Sender:   sender.String(),
+		PoolId:   resp.PoolID,
+		Amount:   locked,
+		Duration: duration,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(suite.ctx.BlockTime().Unix())+duration, lockResp.UnlockTimestamp)
+	suite.Require().Equal(sdk.NewDecWithPrec(15, 1), lockResp.Boost)
+
+	// locks longer than the max duration or above the unlocked shares are rejected
+	_, err = msgServer.LockShares(sdk.WrapSDKContext(suite.ctx), &types.MsgLockShares{
+		Sender: sender.String(), PoolId: resp.PoolID, Amount: locked, Duration: params.MaxShareLockDuration + 1,
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidShareLockDuration)
+	_, err = msgServer.LockShares(sdk.WrapSDKContext(suite.ctx), &types.MsgLockShares{
+		Sender: sender.String(), PoolId: resp.PoolID, Amount: shares, Duration: duration,
+	})
+	suite.Require().ErrorIs(err, ctypes.ErrInsufficientCommittedTokens)
+
+	// locked shares can't be uncommitted
+	commitmentServer := commitmentkeeper.NewMsgServerImpl(suite.app.CommitmentKeeper)
+	_, err = commitmentServer.UncommitTokens(sdk.WrapSDKContext(suite.ctx), &ctypes.MsgUncommitTokens{
+		Creator: sender.String(), Denom: shareDenom, Amount: shares,
+	})
+	suite.Require().ErrorIs(err, ctypes.ErrCommittedTokensLocked)
+
+	// lockups are kept until they end
+	suite.app.CommitmentKeeper.ProcessUnlockQueue(suite.ctx)
+	commitments, _ = suite.app.CommitmentKeeper.GetCommitments(suite.ctx, sender.String())
+	suite.Require().Equal(locked, commitments.GetLockedAmountForDenom(shareDenom))
+
+	// the unlock queue releases ended lockups
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Duration(duration) * time.Second))
+	suite.app.CommitmentKeeper.ProcessUnlockQueue(suite.ctx)
+	commitments, _ = suite.app.CommitmentKeeper.GetCommitments(suite.ctx, sender.String())
+	suite.Require().True(commitments.GetLockedAmountForDenom(shareDenom).IsZero())
+	_, err = commitmentServer.UncommitTokens(sdk.WrapSDKContext(suite.ctx), &ctypes.MsgUncommitTokens{
+		Creator: sender.String(), Denom: shareDenom, Amount: shares,
+	})
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+func (k msgServer) PausePool(goCtx context.Context, msg *types.MsgPausePool) (*types.MsgPausePoolResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetPool(ctx, msg.PoolId); !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPoolId, "pool %d does not exist", msg.PoolId)
+	}
+
+	if k.IsPoolPaused(ctx, msg.PoolId) {
+		return nil, errorsmod.Wrapf(types.ErrPoolPaused, "pool %d", msg.PoolId)
+	}
+
+	k.pausePool(ctx, msg.PoolId, msg.Reason)
+
+	return &types.MsgPausePoolResponse{}, nil
+}
+
+func (k msgServer) UnpausePool(goCtx context.Context, msg *types.MsgUnpausePool) (*types.MsgUnpausePoolResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsPoolPaused(ctx, msg.PoolId) {
+		return nil, errorsmod.Wrapf(types.ErrPoolNotPaused, "pool %d", msg.PoolId)
+	}
+
+	k.RemovePausedPool(ctx, msg.PoolId)
+	types.EmitPoolUnpausedEvent(ctx, msg.PoolId)
+
+	return &types.MsgUnpausePoolResponse{}, nil
+}
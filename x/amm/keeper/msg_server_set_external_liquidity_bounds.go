@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+func (k msgServer) SetExternalLiquidityBounds(goCtx context.Context, msg *types.MsgSetExternalLiquidityBounds) (*types.MsgSetExternalLiquidityBoundsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetPool(ctx, msg.PoolId); !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPoolId, "pool %d does not exist", msg.PoolId)
+	}
+
+	bounds := types.ExternalLiquidityBounds{
+		PoolId:   msg.PoolId,
+		MinRatio: msg.MinRatio,
+		MaxRatio: msg.MaxRatio,
+	}
+	if err := bounds.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetExternalLiquidityBounds(ctx, bounds)
+
+	return &types.MsgSetExternalLiquidityBoundsResponse{}, nil
+}
@@ -15,6 +15,10 @@ func (k msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.MsgSwapE
 		return nil, err
 	}
 
+	if err := types.CheckSwapDeadline(ctx.BlockTime(), msg.Deadline); err != nil {
+		return nil, err
+	}
+
 	// Try executing the tx on cached context environment, to filter invalid transactions out
 	cacheCtx, _ := ctx.CacheContext()
 	tokenInAmount, err := k.RouteExactAmountOut(cacheCtx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut)
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// ZapJoinPool joins a weighted pool from a single asset, swapping the optimal portion of it internally.
+func (k msgServer) ZapJoinPool(goCtx context.Context, msg *types.MsgZapJoinPool) (*types.MsgZapJoinPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSwapped, tokensJoined, sharesOut, err := k.Keeper.ZapJoinPool(ctx, sender, msg.PoolId, msg.TokenIn, msg.ShareAmountOutMin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgZapJoinPoolResponse{
+		ShareAmountOut: sharesOut,
+		TokenSwapped:   tokenSwapped,
+		TokenIn:        tokensJoined,
+	}, nil
+}
@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/elys-network/elys/x/amm/keeper"
+	"github.com/elys-network/elys/x/amm/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgServerZapJoinPool() {
+	for _, tc := range []struct {
+		desc              string
+		tokenIn           sdk.Coin
+		poolInitBalance   sdk.Coins
+		useOracle         bool
+		senderBalance     sdk.Coin
+		swapFee           sdk.Dec
+		shareAmountOutMin sdk.Int
+		expPass           bool
+	}{
+		{
+			desc:              "zap join from usdc without fee",
+			tokenIn:           sdk.NewInt64Coin(ptypes.BaseCurrency, 100000),
+			poolInitBalance:   sdk.Coins{sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000), sdk.NewInt64Coin("uusdt", 1000000)},
+			swapFee:           sdk.ZeroDec(),
+			shareAmountOutMin: sdk.ZeroInt(),
+			expPass:           true,
+		},
+		{
+			desc:              "zap join from usdc with fee",
+			tokenIn:           sdk.NewInt64Coin(ptypes.BaseCurrency, 100000),
+			poolInitBalance:   sdk.Coins{sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000), sdk.NewInt64Coin("uusdt", 2000000)},
+			swapFee:           sdk.NewDecWithPrec(1, 2),
+			shareAmountOutMin: sdk.ZeroInt(),
+			expPass:           true,
+		},
+		{
+			desc:              "zap join below share out minimum",
+			tokenIn:           sdk.NewInt64Coin(ptypes.BaseCurrency, 100000),
+			poolInitBalance:   sdk.Coins{sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000), sdk.NewInt64Coin("uusdt", 1000000)},
+			swapFee:           sdk.ZeroDec(),
+			shareAmountOutMin: types.OneShare,
+			expPass:           false,
+		},
+		{
+			desc:              "zap join not supported on oracle pool",
+			tokenIn:           sdk.NewInt64Coin(ptypes.BaseCurrency, 100000),
+			poolInitBalance:   sdk.Coins{sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000), sdk.NewInt64Coin("uusdt", 1000000)},
+			useOracle:         true,
+			swapFee:           sdk.ZeroDec(),
+			shareAmountOutMin: sdk.ZeroInt(),
+			expPass:           false,
+		},
+		{
+			desc:              "zap join with insufficient funds",
+			tokenIn:           sdk.NewInt64Coin(ptypes.BaseCurrency, 100000),
+			senderBalance:     sdk.NewInt64Coin(ptypes.BaseCurrency, 99999),
+			poolInitBalance:   sdk.Coins{sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000), sdk.NewInt64Coin("uusdt", 1000000)},
+			swapFee:           sdk.ZeroDec(),
+			shareAmountOutMin: sdk.ZeroInt(),
+			expPass:           false,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+			suite.SetupStableCoinPrices()
+
+			// bootstrap accounts
+			sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+			poolAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+			treasuryAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+			// bootstrap balances
+			senderBalance := tc.tokenIn
+			if tc.senderBalance.IsValid() {
+				senderBalance = tc.senderBalance
+			}
+			err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, sdk.Coins{senderBalance})
+			suite.Require().NoError(err)
+			err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sender, sdk.Coins{senderBalance})
+			suite.Require().NoError(err)
+			err = suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, tc.poolInitBalance)
+			suite.Require().NoError(err)
+			err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, poolAddr, tc.poolInitBalance)
+			suite.Require().NoError(err)
+
+			for _, coin := range tc.poolInitBalance {
+				suite.app.AmmKeeper.SetDenomLiquidity(suite.ctx, types.DenomLiquidity{
+					Denom:     coin.Denom,
+					Liquidity: coin.Amount,
+				})
+			}
+
+			// setup pool to join
+			poolParams := types.PoolParams{
+				SwapFee:                     tc.swapFee,
+				ExitFee:                     sdk.ZeroDec(),
+				UseOracle:                   tc.useOracle,
+				WeightBreakingFeeMultiplier: sdk.ZeroDec(),
+				ExternalLiquidityRatio:      sdk.NewDec(1),
+				LpFeePortion:                sdk.ZeroDec(),
+				StakingFeePortion:           sdk.ZeroDec(),
+				WeightRecoveryFeePortion:    sdk.ZeroDec(),
+				ThresholdWeightDifference:   sdk.ZeroDec(),
+				FeeDenom:                    ptypes.BaseCurrency,
+			}
+			pool := types.Pool{
+				PoolId:            1,
+				Address:           poolAddr.String(),
+				RebalanceTreasury: treasuryAddr.String(),
+				PoolParams:        poolParams,
+				TotalShares:       sdk.NewCoin("amm/pool/1", sdk.NewInt(2).Mul(types.OneShare)),
+				PoolAssets: []types.PoolAsset{
+					{
+						Token:  tc.poolInitBalance[0],
+						Weight: sdk.NewInt(10),
+					},
+					{
+						Token:  tc.poolInitBalance[1],
+						Weight: sdk.NewInt(10),
+					},
+				},
+				TotalWeight: sdk.NewInt(20),
+			}
+			suite.app.AmmKeeper.SetPool(suite.ctx, pool)
+
+			// quote the zap join before executing it, the search is charged to the gas meter
+			var quoteSwapAmount, quoteShares sdk.Int
+			if tc.expPass {
+				gasBefore := suite.ctx.GasMeter().GasConsumed()
+				quoteSwapAmount, quoteShares, _, err = suite.app.AmmKeeper.CalcZapJoin(suite.ctx, sender, pool, tc.tokenIn, tc.swapFee)
+				suite.Require().NoError(err)
+				suite.Require().Greater(suite.ctx.GasMeter().GasConsumed(), gasBefore)
+			}
+
+			// execute function
+			msgServer := keeper.NewMsgServerImpl(suite.app.AmmKeeper)
+			resp, err := msgServer.ZapJoinPool(
+				sdk.WrapSDKContext(suite.ctx),
+				&types.MsgZapJoinPool{
+					Sender:            sender.String(),
+					PoolId:            1,
+					TokenIn:           tc.tokenIn,
+					ShareAmountOutMin: tc.shareAmountOutMin,
+				})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(resp.ShareAmountOut.IsPositive())
+			suite.Require().Equal(tc.tokenIn.Denom, resp.TokenSwapped.Denom)
+			suite.Require().True(resp.TokenSwapped.Amount.IsPositive())
+
+			// the pool grew by exactly the joined tokens at its post swap ratio
+			pools := suite.app.AmmKeeper.GetAllPool(suite.ctx)
+			suite.Require().Len(pools, 1)
+			suite.Require().Equal(pool.TotalShares.Amount.Add(resp.ShareAmountOut).String(), pools[0].TotalShares.Amount.String())
+
+			// only rounding dust is left with the sender
+			for _, coin := range suite.app.BankKeeper.GetAllBalances(suite.ctx, sender) {
+				if coin.Denom == pools[0].TotalShares.Denom {
+					continue
+				}
+				suite.Require().True(coin.Amount.LTE(sdk.NewInt(2)), coin.String())
+			}
+
+			// executed join matches the quote taken on the initial state
+			suite.Require().Equal(quoteSwapAmount, resp.TokenSwapped.Amount)
+			suite.Require().Equal(quoteShares, resp.ShareAmountOut)
+		})
+	}
+}
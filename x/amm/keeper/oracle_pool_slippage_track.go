@@ -99,3 +99,28 @@ func (k Keeper) TrackSlippage(ctx sdk.Context, poolId uint64, amount sdk.Coin) {
 	lastTrack.Timestamp = uint64(ctx.BlockTime().Unix())
 	k.SetSlippageTrack(ctx, lastTrack)
 }
+
+// GetBlockSlippage returns the slippage tracked on the pool within the current block
+func (k Keeper) GetBlockSlippage(ctx sdk.Context, poolId uint64) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.OraclePoolSlippageTrackPrefix))
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, sdk.Uint64ToBigEndian(poolId))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return sdk.Coins{}
+	}
+	lastTrack := types.OraclePoolSlippageTrack{}
+	k.cdc.MustUnmarshal(iterator.Value(), &lastTrack)
+	if lastTrack.Timestamp != uint64(ctx.BlockTime().Unix()) {
+		return sdk.Coins{}
+	}
+
+	iterator.Next()
+	if !iterator.Valid() {
+		return lastTrack.Tracked
+	}
+	prevTrack := types.OraclePoolSlippageTrack{}
+	k.cdc.MustUnmarshal(iterator.Value(), &prevTrack)
+	return lastTrack.Tracked.Sub(prevTrack.Tracked...)
+}
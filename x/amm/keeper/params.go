@@ -9,6 +9,17 @@ import (
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.PoolCreationFee(ctx),
+		k.MaxOracleSpotDivergence(ctx),
+		k.MaxBlockSlippage(ctx),
+		k.DynamicSwapFeeFloor(ctx),
+		k.DynamicSwapFeeCeiling(ctx),
+		k.VolatilityFeeMultiplier(ctx),
+		k.SlippageFeeMultiplier(ctx),
+		k.VolatilityWindow(ctx),
+		k.SwapFeeDiscountTiers(ctx),
+		k.PoolCreationFeeReceiver(ctx),
+		k.MaxShareLockDuration(ctx),
+		k.MaxShareLockBoost(ctx),
 	)
 }
 
@@ -22,3 +33,77 @@ func (k Keeper) PoolCreationFee(ctx sdk.Context) (res uint64) {
 	k.paramstore.Get(ctx, types.KeyPoolCreationFee, &res)
 	return
 }
+
+// MaxOracleSpotDivergence returns the MaxOracleSpotDivergence param
+func (k Keeper) MaxOracleSpotDivergence(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxOracleSpotDivergence, &res)
+	return
+}
+
+// MaxBlockSlippage returns the MaxBlockSlippage param
+func (k Keeper) MaxBlockSlippage(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxBlockSlippage, &res)
+	return
+}
+
+// DynamicSwapFeeFloor returns the DynamicSwapFeeFloor param
+func (k Keeper) DynamicSwapFeeFloor(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyDynamicSwapFeeFloor, &res)
+	return
+}
+
+// DynamicSwapFeeCeiling returns the DynamicSwapFeeCeiling param
+func (k Keeper) DynamicSwapFeeCeiling(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyDynamicSwapFeeCeiling, &res)
+	return
+}
+
+// VolatilityFeeMultiplier returns the VolatilityFeeMultiplier param
+func (k Keeper) VolatilityFeeMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyVolatilityFeeMultiplier, &res)
+	return
+}
+
+// SlippageFeeMultiplier returns the SlippageFeeMultiplier param
+func (k Keeper) SlippageFeeMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeySlippageFeeMultiplier, &res)
+	return
+}
+
+// VolatilityWindow returns the VolatilityWindow param
+func (k Keeper) VolatilityWindow(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyVolatilityWindow, &res)
+	return
+}
+
+// SwapFeeDiscountTiers returns the SwapFeeDiscountTiers param
+func (k Keeper) SwapFeeDiscountTiers(ctx sdk.Context) (res []types.SwapFeeDiscountTier) {
+	k.paramstore.Get(ctx, types.KeySwapFeeDiscountTiers, &res)
+	return
+}
+
+// PoolCreationFeeReceiver returns the PoolCreationFeeReceiver param
+func (k Keeper) PoolCreationFeeReceiver(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyPoolCreationFeeReceiver, &res)
+	return
+}
+
+// MaxShareLockDuration returns the MaxShareLockDuration param
+func (k Keeper) MaxShareLockDuration(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxShareLockDuration, &res)
+	return
+}
+
+// MaxShareLockBoost returns the MaxShareLockBoost param
+func (k Keeper) MaxShareLockBoost(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxShareLockBoost, &res)
+	return
+}
+
+// SetParamIfMissing sets the param stored under key to value when it has not been set yet
+func (k Keeper) SetParamIfMissing(ctx sdk.Context, key []byte, value interface{}) {
+	if k.paramstore.Has(ctx, key) {
+		return
+	}
+	k.paramstore.Set(ctx, key, value)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+func (k Keeper) SetPausedPool(ctx sdk.Context, pausedPool types.PausedPool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PausedPoolPrefix))
+	bz := k.cdc.MustMarshal(&pausedPool)
+	store.Set(sdk.Uint64ToBigEndian(pausedPool.PoolId), bz)
+}
+
+func (k Keeper) GetPausedPool(ctx sdk.Context, poolId uint64) (val types.PausedPool, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PausedPoolPrefix))
+	bz := store.Get(sdk.Uint64ToBigEndian(poolId))
+	if bz == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+func (k Keeper) RemovePausedPool(ctx sdk.Context, poolId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PausedPoolPrefix))
+	store.Delete(sdk.Uint64ToBigEndian(poolId))
+}
+
+func (k Keeper) GetAllPausedPools(ctx sdk.Context) []types.PausedPool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PausedPoolPrefix))
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	pausedPools := []types.PausedPool{}
+	for ; iterator.Valid(); iterator.Next() {
+		pausedPool := types.PausedPool{}
+		k.cdc.MustUnmarshal(iterator.Value(), &pausedPool)
+
+		pausedPools = append(pausedPools, pausedPool)
+	}
+	return pausedPools
+}
+
+// IsPoolPaused returns true when swaps and joins are disabled on the pool
+func (k Keeper) IsPoolPaused(ctx sdk.Context, poolId uint64) bool {
+	_, found := k.GetPausedPool(ctx, poolId)
+	return found
+}
+
+// pausePool disables swaps and joins on the pool until it is unpaused by authority
+func (k Keeper) pausePool(ctx sdk.Context, poolId uint64, reason string) {
+	k.SetPausedPool(ctx, types.PausedPool{
+		PoolId:    poolId,
+		Reason:    reason,
+		Timestamp: uint64(ctx.BlockTime().Unix()),
+	})
+	types.EmitPoolPausedEvent(ctx, poolId, reason)
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/elys-network/elys/x/amm/keeper"
+	"github.com/elys-network/elys/x/amm/types"
+	oracletypes "github.com/elys-network/elys/x/oracle/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+func (suite *KeeperTestSuite) TestPausePool() {
+	suite.SetupTest()
+	sender := suite.setupSimulationPool()
+	msgServer := keeper.NewMsgServerImpl(suite.app.AmmKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// only authority can pause a pool
+	_, err := msgServer.PausePool(sdk.WrapSDKContext(suite.ctx), types.NewMsgPausePool(sender.String(), 1, "maintenance"))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.PausePool(sdk.WrapSDKContext(suite.ctx), types.NewMsgPausePool(authority, 1, "maintenance"))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.AmmKeeper.IsPoolPaused(suite.ctx, 1))
+
+	// swaps and joins revert while paused
+	routes := []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: ptypes.BaseCurrency}}
+	_, err = suite.app.AmmKeeper.RouteExactAmountIn(suite.ctx, sender, routes, sdk.NewInt64Coin(ptypes.Elys, 10000), sdk.ZeroInt())
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+	_, _, err = suite.app.AmmKeeper.JoinPoolNoSwap(suite.ctx, sender, 1, sdk.NewInt(1000), sdk.Coins{sdk.NewInt64Coin(ptypes.Elys, 1000)}, false)
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+
+	_, err = msgServer.UnpausePool(sdk.WrapSDKContext(suite.ctx), types.NewMsgUnpausePool(authority, 1))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.AmmKeeper.IsPoolPaused(suite.ctx, 1))
+	_, err = msgServer.UnpausePool(sdk.WrapSDKContext(suite.ctx), types.NewMsgUnpausePool(authority, 1))
+	suite.Require().ErrorIs(err, types.ErrPoolNotPaused)
+
+	_, err = suite.app.AmmKeeper.RouteExactAmountIn(suite.ctx, sender, routes, sdk.NewInt64Coin(ptypes.Elys, 10000), sdk.ZeroInt())
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestPoolCircuitBreaker() {
+	suite.SetupTest()
+	suite.setupSimulationPool()
+	suite.SetupStableCoinPrices()
+
+	// no oracle price for elys, the divergence can't be evaluated
+	pool, found := suite.app.AmmKeeper.GetPool(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.app.AmmKeeper.CheckPoolCircuitBreaker(suite.ctx, pool)
+	suite.Require().False(suite.app.AmmKeeper.IsPoolPaused(suite.ctx, 1))
+
+	// pool prices elys at 1 usdc while the oracle reports 2 usdc
+	suite.app.OracleKeeper.SetAssetInfo(suite.ctx, oracletypes.AssetInfo{
+		Denom:   ptypes.Elys,
+		Display: "ELYS",
+		Decimal: 6,
+	})
+	suite.app.OracleKeeper.SetPrice(suite.ctx, oracletypes.Price{
+		Asset:     "ELYS",
+		Price:     sdk.NewDec(2),
+		Source:    "elys",
+		Provider:  sdk.AccAddress([]byte("provider")).String(),
+		Timestamp: uint64(suite.ctx.BlockTime().Unix()),
+	})
+	divergence, err := pool.OracleSpotDivergence(suite.ctx, suite.app.OracleKeeper, suite.app.AccountedPoolKeeper)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), divergence)
+
+	// disabled by default
+	suite.app.AmmKeeper.CheckPoolCircuitBreaker(suite.ctx, pool)
+	suite.Require().False(suite.app.AmmKeeper.IsPoolPaused(suite.ctx, 1))
+
+	// non-oracle pools are never paused automatically
+	params := suite.app.AmmKeeper.GetParams(suite.ctx)
+	params.MaxOracleSpotDivergence = sdk.NewDecWithPrec(20, 2)
+	suite.app.AmmKeeper.SetParams(suite.ctx, params)
+	suite.app.AmmKeeper.CheckPoolCircuitBreaker(suite.ctx, pool)
+	suite.Require().False(suite.app.AmmKeeper.IsPoolPaused(suite.ctx, 1))
+
+	pool.PoolParams.UseOracle = true
+	suite.app.AmmKeeper.CheckPoolCircuitBreaker(suite.ctx, pool)
+	pausedPool, found := suite.app.AmmKeeper.GetPausedPool(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Contains(pausedPool.Reason, "divergence")
+
+	// the end blocker only checks the pools updated in the block
+	suite.app.AmmKeeper.RemovePausedPool(suite.ctx, 1)
+	suite.Require().Equal([]uint64{1}, suite.app.AmmKeeper.GetAllTouchedPools(suite.ctx))
+	suite.app.AmmKeeper.ApplyCircuitBreakers(suite.ctx)
+	suite.Require().False(suite.app.AmmKeeper.IsPoolPaused(suite.ctx, 1))
+	suite.Require().NoError(suite.app.AmmKeeper.SetPool(suite.ctx, pool))
+	suite.app.AmmKeeper.ApplyCircuitBreakers(suite.ctx)
+	suite.Require().True(suite.app.AmmKeeper.IsPoolPaused(suite.ctx, 1))
+}
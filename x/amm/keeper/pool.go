@@ -11,6 +11,7 @@ func (k Keeper) SetPool(ctx sdk.Context, pool types.Pool) error {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PoolKeyPrefix))
 	b := k.cdc.MustMarshal(&pool)
 	store.Set(types.PoolKey(pool.PoolId), b)
+	k.SetTouchedPool(ctx, pool.PoolId)
 	return nil
 }
 
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+func (k Keeper) GetPoolStatsBucket(ctx sdk.Context, poolId uint64, timestamp uint64) types.PoolStatsBucket {
+	bucket := types.PoolStatsBucket{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolStatsBucketPrefix))
+	bz := store.Get(types.PoolStatsBucketKey(poolId, timestamp))
+	if len(bz) == 0 {
+		return types.PoolStatsBucket{
+			PoolId:             poolId,
+			Timestamp:          timestamp,
+			Volume:             sdk.Coins{},
+			Fees:               sdk.Coins{},
+			WeightRecoveryPaid: sdk.Coins{},
+			LpRevenue:          sdk.Coins{},
+		}
+	}
+
+	k.cdc.MustUnmarshal(bz, &bucket)
+	return bucket
+}
+
+func (k Keeper) SetPoolStatsBucket(ctx sdk.Context, bucket types.PoolStatsBucket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolStatsBucketPrefix))
+	bz := k.cdc.MustMarshal(&bucket)
+	store.Set(types.PoolStatsBucketKey(bucket.PoolId, bucket.Timestamp), bz)
+
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolStatsBucketTimePrefix))
+	timeStore.Set(types.PoolStatsBucketTimeKey(bucket.Timestamp, bucket.PoolId), []byte{1})
+}
+
+func (k Keeper) DeletePoolStatsBucket(ctx sdk.Context, bucket types.PoolStatsBucket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolStatsBucketPrefix))
+	store.Delete(types.PoolStatsBucketKey(bucket.PoolId, bucket.Timestamp))
+
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolStatsBucketTimePrefix))
+	timeStore.Delete(types.PoolStatsBucketTimeKey(bucket.Timestamp, bucket.PoolId))
+}
+
+func (k Keeper) AllPoolStatsBuckets(ctx sdk.Context) []types.PoolStatsBucket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolStatsBucketPrefix))
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	buckets := []types.PoolStatsBucket{}
+	for ; iterator.Valid(); iterator.Next() {
+		bucket := types.PoolStatsBucket{}
+		k.cdc.MustUnmarshal(iterator.Value(), &bucket)
+
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+// GetPoolStatsBuckets returns the buckets of a pool starting at or after the given timestamp
+func (k Keeper) GetPoolStatsBuckets(ctx sdk.Context, poolId uint64, since uint64) []types.PoolStatsBucket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolStatsBucketPrefix))
+
+	iterator := store.Iterator(types.PoolStatsBucketKey(poolId, since), sdk.Uint64ToBigEndian(poolId+1))
+	defer iterator.Close()
+
+	buckets := []types.PoolStatsBucket{}
+	for ; iterator.Valid(); iterator.Next() {
+		bucket := types.PoolStatsBucket{}
+		k.cdc.MustUnmarshal(iterator.Value(), &bucket)
+
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+func (k Keeper) currentPoolStatsBucket(ctx sdk.Context, poolId uint64) types.PoolStatsBucket {
+	now := uint64(ctx.BlockTime().Unix())
+	return k.GetPoolStatsBucket(ctx, poolId, now-now%types.PoolStatsBucketDuration)
+}
+
+// TrackPoolSwap records swap volume and weight recovery bonus paid by the pool
+func (k Keeper) TrackPoolSwap(ctx sdk.Context, poolId uint64, tokensIn sdk.Coins, weightRecoveryPaid sdk.Coins) {
+	bucket := k.currentPoolStatsBucket(ctx, poolId)
+	bucket.Volume = bucket.Volume.Add(tokensIn...)
+	bucket.WeightRecoveryPaid = bucket.WeightRecoveryPaid.Add(weightRecoveryPaid...)
+	k.SetPoolStatsBucket(ctx, bucket)
+}
+
+// TrackPoolFee records swap fees collected by the pool and the part of it sent to pool revenue
+func (k Keeper) TrackPoolFee(ctx sdk.Context, poolId uint64, fee sdk.Coins, lpRevenue sdk.Coins) {
+	bucket := k.currentPoolStatsBucket(ctx, poolId)
+	bucket.Fees = bucket.Fees.Add(fee...)
+	bucket.LpRevenue = bucket.LpRevenue.Add(lpRevenue...)
+	k.SetPoolStatsBucket(ctx, bucket)
+}
+
+// ClearOutdatedPoolStats removes the oldest buckets that left the week window, walking the
+// timestamp index up to MaxPrunedRecordsPerBlock entries
+func (k Keeper) ClearOutdatedPoolStats(ctx sdk.Context) {
+	now := uint64(ctx.BlockTime().Unix())
+	if now <= types.PoolStatsWeekWindow {
+		return
+	}
+	// the bucket holding the start of the window is still part of it
+	cutoff := now - types.PoolStatsWeekWindow
+	cutoff -= cutoff % types.PoolStatsBucketDuration
+
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolStatsBucketTimePrefix))
+	iterator := timeStore.Iterator(nil, sdk.Uint64ToBigEndian(cutoff))
+	keys := [][]byte{}
+	for ; iterator.Valid() && len(keys) < types.MaxPrunedRecordsPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		k.DeletePoolStatsBucket(ctx, types.PoolStatsBucket{
+			Timestamp: sdk.BigEndianToUint64(key[:8]),
+			PoolId:    sdk.BigEndianToUint64(key[8:]),
+		})
+	}
+}
+
+func (k Keeper) coinsUsdValue(ctx sdk.Context, coins sdk.Coins) sdk.Dec {
+	value := sdk.ZeroDec()
+	for _, coin := range coins {
+		price := k.oracleKeeper.GetAssetPriceFromDenom(ctx, coin.Denom)
+		value = value.Add(price.Mul(sdk.NewDecFromInt(coin.Amount)))
+	}
+	return value
+}
+
+// GetPoolStats aggregates the pool stats buckets of the last window seconds
+func (k Keeper) GetPoolStats(ctx sdk.Context, poolId uint64, window uint64) types.PoolStats {
+	now := uint64(ctx.BlockTime().Unix())
+	since := uint64(0)
+	if now > window {
+		since = now - window
+	}
+	// buckets are keyed by their start, the one holding since is part of the window
+	since -= since % types.PoolStatsBucketDuration
+
+	stats := types.PoolStats{
+		Window:             window,
+		Volume:             sdk.Coins{},
+		Fees:               sdk.Coins{},
+		WeightRecoveryPaid: sdk.Coins{},
+		LpRevenue:          sdk.Coins{},
+	}
+	for _, bucket := range k.GetPoolStatsBuckets(ctx, poolId, since) {
+		stats.Volume = stats.Volume.Add(bucket.Volume...)
+		stats.Fees = stats.Fees.Add(bucket.Fees...)
+		stats.WeightRecoveryPaid = stats.WeightRecoveryPaid.Add(bucket.WeightRecoveryPaid...)
+		stats.LpRevenue = stats.LpRevenue.Add(bucket.LpRevenue...)
+	}
+	stats.VolumeUsd = k.coinsUsdValue(ctx, stats.Volume)
+	stats.FeesUsd = k.coinsUsdValue(ctx, stats.Fees)
+	stats.WeightRecoveryPaidUsd = k.coinsUsdValue(ctx, stats.WeightRecoveryPaid)
+	stats.LpRevenueUsd = k.coinsUsdValue(ctx, stats.LpRevenue)
+	return stats
+}
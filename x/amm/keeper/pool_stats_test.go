@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+func (suite *KeeperTestSuite) TestPoolStats() {
+	suite.SetupTest()
+	suite.ctx = suite.ctx.WithBlockTime(time.Unix(1700000000, 0))
+	sender := suite.setupSimulationPool()
+
+	tokenIn := sdk.NewInt64Coin(ptypes.Elys, 10000)
+	routes := []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: ptypes.BaseCurrency}}
+	_, err := suite.app.AmmKeeper.RouteExactAmountIn(suite.ctx, sender, routes, tokenIn, sdk.ZeroInt())
+	suite.Require().NoError(err)
+
+	res, err := suite.app.AmmKeeper.PoolStats(sdk.WrapSDKContext(suite.ctx), &types.QueryPoolStatsRequest{PoolId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{tokenIn}, res.Stats24H.Volume)
+	suite.Require().Equal("98"+ptypes.BaseCurrency, res.Stats24H.Fees.String())
+	suite.Require().Equal("88"+ptypes.BaseCurrency, res.Stats24H.LpRevenue.String())
+	suite.Require().True(res.Stats24H.WeightRecoveryPaid.IsZero())
+	suite.Require().Equal(res.Stats24H.Volume, res.Stats7D.Volume)
+
+	// the bucket holding the start of the window is counted, a window start within it doesn't skip it
+	stats := suite.app.AmmKeeper.GetPoolStats(suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(24*time.Hour+30*time.Minute)), 1, types.PoolStatsDayWindow)
+	suite.Require().Equal(sdk.Coins{tokenIn}, stats.Volume)
+
+	// a swap two days later is only part of the 24h window
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(48 * time.Hour))
+	_, err = suite.app.AmmKeeper.RouteExactAmountIn(suite.ctx, sender, routes, tokenIn, sdk.ZeroInt())
+	suite.Require().NoError(err)
+
+	stats = suite.app.AmmKeeper.GetPoolStats(suite.ctx, 1, types.PoolStatsDayWindow)
+	suite.Require().Equal(sdk.Coins{tokenIn}, stats.Volume)
+	stats = suite.app.AmmKeeper.GetPoolStats(suite.ctx, 1, types.PoolStatsWeekWindow)
+	suite.Require().Equal(sdk.Coins{tokenIn.Add(tokenIn)}, stats.Volume)
+	suite.Require().Len(suite.app.AmmKeeper.AllPoolStatsBuckets(suite.ctx), 2)
+
+	// buckets older than a week are pruned
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(6 * 24 * time.Hour))
+	suite.app.AmmKeeper.ClearOutdatedPoolStats(suite.ctx)
+	suite.Require().Len(suite.app.AmmKeeper.AllPoolStatsBuckets(suite.ctx), 1)
+
+	// pruning is capped per block
+	for i := uint64(0); i < types.MaxPrunedRecordsPerBlock+10; i++ {
+		suite.app.AmmKeeper.SetPoolStatsBucket(suite.ctx, types.PoolStatsBucket{PoolId: 2 + i, Timestamp: 1700000000})
+	}
+	suite.app.AmmKeeper.ClearOutdatedPoolStats(suite.ctx)
+	suite.Require().Len(suite.app.AmmKeeper.AllPoolStatsBuckets(suite.ctx), 11)
+	suite.app.AmmKeeper.ClearOutdatedPoolStats(suite.ctx)
+	suite.Require().Len(suite.app.AmmKeeper.AllPoolStatsBuckets(suite.ctx), 1)
+}
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+func (k Keeper) SetPoolTwapRecord(ctx sdk.Context, record types.PoolTwapRecord) {
+	// the previous record of the pool is only needed until this one leaves the history window
+	if record.Timestamp > 0 {
+		previous, found := k.GetPoolTwapRecordAtOrBefore(ctx, record.PoolId, record.Timestamp-1)
+		if found {
+			pruneStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolTwapRecordPrunePrefix))
+			pruneStore.Set(types.PoolTwapRecordPruneKey(record.Timestamp, previous.PoolId, previous.Timestamp), []byte{1})
+		}
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolTwapRecordPrefix))
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.PoolTwapRecordKey(record.PoolId, record.Timestamp), bz)
+}
+
+func (k Keeper) DeletePoolTwapRecord(ctx sdk.Context, record types.PoolTwapRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolTwapRecordPrefix))
+	store.Delete(types.PoolTwapRecordKey(record.PoolId, record.Timestamp))
+}
+
+func (k Keeper) AllPoolTwapRecords(ctx sdk.Context) []types.PoolTwapRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolTwapRecordPrefix))
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	records := []types.PoolTwapRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.PoolTwapRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetPoolTwapRecordAtOrBefore returns the latest record of a pool with a timestamp not after the given one
+func (k Keeper) GetPoolTwapRecordAtOrBefore(ctx sdk.Context, poolId uint64, timestamp uint64) (record types.PoolTwapRecord, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolTwapRecordPrefix))
+
+	iterator := store.ReverseIterator(types.PoolTwapRecordKey(poolId, 0), types.PoolTwapRecordKey(poolId, timestamp+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &record)
+	return record, true
+}
+
+// UpdatePoolTwap closes the accumulation period of the previous spot prices at the block time
+// and starts a new one with the current spot prices of the pool
+func (k Keeper) UpdatePoolTwap(ctx sdk.Context, pool types.Pool) {
+	now := uint64(ctx.BlockTime().Unix())
+	last, _ := k.GetPoolTwapRecordAtOrBefore(ctx, pool.PoolId, now)
+
+	lastAccumulators := make(map[string]types.PoolTwapAccumulator)
+	for _, accumulator := range last.Accumulators {
+		lastAccumulators[accumulator.Base+"/"+accumulator.Quote] = accumulator
+	}
+
+	snapshot := k.GetPoolSnapshotOrSet(ctx, pool)
+	record := types.PoolTwapRecord{
+		PoolId:       pool.PoolId,
+		Timestamp:    now,
+		Height:       ctx.BlockHeight(),
+		Accumulators: []types.PoolTwapAccumulator{},
+	}
+	for _, base := range pool.PoolAssets {
+		for _, quote := range pool.PoolAssets {
+			if base.Token.Denom == quote.Token.Denom {
+				continue
+			}
+
+			accumulator, accumulated := lastAccumulators[base.Token.Denom+"/"+quote.Token.Denom]
+			if accumulated {
+				elapsed := sdk.NewDecFromInt(sdk.NewIntFromUint64(now - last.Timestamp))
+				accumulator.CumulativePrice = accumulator.CumulativePrice.Add(accumulator.LastSpotPrice.Mul(elapsed))
+			} else {
+				accumulator = types.PoolTwapAccumulator{
+					Base:            base.Token.Denom,
+					Quote:           quote.Token.Denom,
+					LastSpotPrice:   sdk.ZeroDec(),
+					CumulativePrice: sdk.ZeroDec(),
+				}
+			}
+
+			// keep the previous spot price when it can't be computed
+			spotPrice, err := pool.SpotPrice(ctx, k.oracleKeeper, &snapshot, base.Token.Denom, quote.Token.Denom, k.accountedPoolKeeper)
+			if err == nil {
+				accumulator.LastSpotPrice = spotPrice
+			} else if !accumulated {
+				continue
+			}
+			record.Accumulators = append(record.Accumulators, accumulator)
+		}
+	}
+
+	// a record of the same block is overwritten, only the closing spot price of a block is accumulated
+	k.SetPoolTwapRecord(ctx, record)
+}
+
+// accumulatedPrice returns the cumulative price of base in quote at the given timestamp
+func (k Keeper) accumulatedPrice(ctx sdk.Context, poolId uint64, base, quote string, timestamp uint64) (sdk.Dec, error) {
+	record, found := k.GetPoolTwapRecordAtOrBefore(ctx, poolId, timestamp)
+	if !found {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrTwapUnavailable, "no record for pool %d at or before %d", poolId, timestamp)
+	}
+
+	for _, accumulator := range record.Accumulators {
+		if accumulator.Base == base && accumulator.Quote == quote {
+			elapsed := sdk.NewDecFromInt(sdk.NewIntFromUint64(timestamp - record.Timestamp))
+			return accumulator.CumulativePrice.Add(accumulator.LastSpotPrice.Mul(elapsed)), nil
+		}
+	}
+	return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrTwapUnavailable, "no %s/%s price for pool %d at %d", base, quote, poolId, timestamp)
+}
+
+// GetPoolTwap returns the time weighted average spot price of base in quote between start and end
+func (k Keeper) GetPoolTwap(ctx sdk.Context, poolId uint64, base, quote string, start, end uint64) (sdk.Dec, error) {
+	if start >= end {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrTwapUnavailable, "start %d must be before end %d", start, end)
+	}
+	if end > uint64(ctx.BlockTime().Unix()) {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrTwapUnavailable, "end %d is after the block time", end)
+	}
+
+	startPrice, err := k.accumulatedPrice(ctx, poolId, base, quote, start)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	endPrice, err := k.accumulatedPrice(ctx, poolId, base, quote, end)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return endPrice.Sub(startPrice).QuoInt64(int64(end - start)), nil
+}
+
+// ClearOutdatedPoolTwapRecords removes records whose next record is older than the history window, the
+// last record before the window is kept as it holds the accumulators at the start of the window.
+// At most MaxPrunedRecordsPerBlock records are removed per block, oldest first.
+func (k Keeper) ClearOutdatedPoolTwapRecords(ctx sdk.Context) {
+	now := uint64(ctx.BlockTime().Unix())
+	if now <= types.PoolTwapHistoryWindow {
+		return
+	}
+	cutoff := now - types.PoolTwapHistoryWindow
+
+	pruneStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolTwapRecordPrunePrefix))
+	iterator := pruneStore.Iterator(nil, sdk.Uint64ToBigEndian(cutoff+1))
+	keys := [][]byte{}
+	for ; iterator.Valid() && len(keys) < types.MaxPrunedRecordsPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PoolTwapRecordPrefix))
+	for _, key := range keys {
+		store.Delete(key[8:])
+		pruneStore.Delete(key)
+	}
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+func (suite *KeeperTestSuite) TestPoolTwap() {
+	suite.SetupTest()
+	suite.SetupStableCoinPrices()
+	sender := suite.setupSimulationPool()
+	start := time.Unix(1700000000, 0)
+	suite.ctx = suite.ctx.WithBlockTime(start)
+
+	pool, found := suite.app.AmmKeeper.GetPool(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.app.AmmKeeper.UpdatePoolTwap(suite.ctx, pool)
+
+	// price stays at 1 for 100 seconds
+	suite.ctx = suite.ctx.WithBlockTime(start.Add(100 * time.Second))
+	routes := []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: ptypes.BaseCurrency}}
+	_, err := suite.app.AmmKeeper.RouteExactAmountIn(suite.ctx, sender, routes, sdk.NewInt64Coin(ptypes.Elys, 100000), sdk.ZeroInt())
+	suite.Require().NoError(err)
+
+	pool, found = suite.app.AmmKeeper.GetPool(suite.ctx, 1)
+	suite.Require().True(found)
+	snapshot := suite.app.AmmKeeper.GetPoolSnapshotOrSet(suite.ctx, pool)
+	spotPrice, err := pool.SpotPrice(suite.ctx, suite.app.OracleKeeper, &snapshot, ptypes.Elys, ptypes.BaseCurrency, suite.app.AccountedPoolKeeper)
+	suite.Require().NoError(err)
+	suite.Require().True(spotPrice.LT(sdk.OneDec()))
+
+	// new price holds for another 100 seconds
+	suite.ctx = suite.ctx.WithBlockTime(start.Add(200 * time.Second))
+	twap, err := suite.app.AmmKeeper.GetPoolTwap(suite.ctx, 1, ptypes.Elys, ptypes.BaseCurrency, 1700000000, 1700000200)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.OneDec().Add(spotPrice).QuoInt64(2), twap)
+
+	res, err := suite.app.AmmKeeper.PoolTwap(sdk.WrapSDKContext(suite.ctx), &types.QueryPoolTwapRequest{
+		PoolId: 1,
+		Base:   ptypes.Elys,
+		Quote:  ptypes.BaseCurrency,
+		Start:  1700000100,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(spotPrice, res.Twap)
+	suite.Require().Equal(uint64(1700000200), res.End)
+
+	// no record before the first update
+	_, err = suite.app.AmmKeeper.GetPoolTwap(suite.ctx, 1, ptypes.Elys, ptypes.BaseCurrency, 1699999999, 1700000200)
+	suite.Require().ErrorIs(err, types.ErrTwapUnavailable)
+	// end can't be in the future
+	_, err = suite.app.AmmKeeper.GetPoolTwap(suite.ctx, 1, ptypes.Elys, ptypes.BaseCurrency, 1700000000, 1700000300)
+	suite.Require().ErrorIs(err, types.ErrTwapUnavailable)
+
+	// outdated records are pruned except the one opening the history window
+	suite.ctx = suite.ctx.WithBlockTime(start.Add(types.PoolTwapHistoryWindow*time.Second + 150*time.Second))
+	suite.app.AmmKeeper.ClearOutdatedPoolTwapRecords(suite.ctx)
+	records := suite.app.AmmKeeper.AllPoolTwapRecords(suite.ctx)
+	suite.Require().Len(records, 1)
+	suite.Require().Equal(uint64(1700000100), records[0].Timestamp)
+
+	// pruning is capped per block
+	for i := uint64(0); i <= types.MaxPrunedRecordsPerBlock+10; i++ {
+		suite.app.AmmKeeper.SetPoolTwapRecord(suite.ctx, types.PoolTwapRecord{PoolId: 2, Timestamp: 1700000000 + i})
+	}
+	suite.app.AmmKeeper.ClearOutdatedPoolTwapRecords(suite.ctx)
+	suite.Require().Len(suite.app.AmmKeeper.AllPoolTwapRecords(suite.ctx), 12)
+	suite.app.AmmKeeper.ClearOutdatedPoolTwapRecords(suite.ctx)
+	suite.Require().Len(suite.app.AmmKeeper.AllPoolTwapRecords(suite.ctx), 2)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/elys-network/elys/x/amm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ExternalLiquidityHistory(goCtx context.Context, req *types.QueryExternalLiquidityHistoryRequest) (*types.QueryExternalLiquidityHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetPool(ctx, req.PoolId); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	var submissions []types.ExternalLiquiditySubmission
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ExternalLiquiditySubmissionPrefix))
+	submissionStore := prefix.NewStore(store, types.ExternalLiquiditySubmissionPoolPrefix(req.PoolId))
+
+	pageRes, err := query.Paginate(submissionStore, req.Pagination, func(key []byte, value []byte) error {
+		var submission types.ExternalLiquiditySubmission
+		if err := k.cdc.Unmarshal(value, &submission); err != nil {
+			return err
+		}
+
+		submissions = append(submissions, submission)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExternalLiquidityHistoryResponse{
+		Submissions: submissions,
+		Bounds:      k.GetExternalLiquidityBounds(ctx, req.PoolId),
+		Pagination:  pageRes,
+	}, nil
+}
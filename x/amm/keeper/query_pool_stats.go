@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PoolStats(goCtx context.Context, req *types.QueryPoolStatsRequest) (*types.QueryPoolStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	tvl, err := pool.TVL(ctx, k.oracleKeeper)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	stats7d := k.GetPoolStats(ctx, req.PoolId, types.PoolStatsWeekWindow)
+	apr := sdk.ZeroDec()
+	if tvl.IsPositive() {
+		apr = stats7d.LpRevenueUsd.MulInt64(365).QuoInt64(7).Quo(tvl)
+	}
+
+	return &types.QueryPoolStatsResponse{
+		PoolId:   req.PoolId,
+		Stats24H: k.GetPoolStats(ctx, req.PoolId, types.PoolStatsDayWindow),
+		Stats7D:  stats7d,
+		Tvl:      tvl,
+		Apr:      apr,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PoolTwap(goCtx context.Context, req *types.QueryPoolTwapRequest) (*types.QueryPoolTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetPool(ctx, req.PoolId); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	end := req.End
+	if end == 0 {
+		end = uint64(ctx.BlockTime().Unix())
+	}
+
+	twap, err := k.GetPoolTwap(ctx, req.PoolId, req.Base, req.Quote, req.Start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPoolTwapResponse{
+		Twap:  twap,
+		Start: req.Start,
+		End:   end,
+	}, nil
+}
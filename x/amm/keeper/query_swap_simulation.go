@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SwapSimulation(goCtx context.Context, req *types.QuerySwapSimulationRequest) (*types.QuerySwapSimulationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !req.TokenIn.Amount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "amount too low")
+	}
+
+	discount, err := k.swapSimulationDiscount(ctx, req.Sender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := k.SimulateRouteExactAmountIn(ctx, req.Routes, req.TokenIn, discount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return res, nil
+}
+
+func (k Keeper) SwapSimulationExactAmountOut(goCtx context.Context, req *types.QuerySwapSimulationExactAmountOutRequest) (*types.QuerySwapSimulationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !req.TokenOut.Amount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "amount too low")
+	}
+
+	discount, err := k.swapSimulationDiscount(ctx, req.Sender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := k.SimulateRouteExactAmountOut(ctx, req.Routes, req.TokenOut, discount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return res, nil
+}
+
+// swapSimulationDiscount returns the swap fee discount of the optional simulation sender
+func (k Keeper) swapSimulationDiscount(ctx sdk.Context, sender string) (sdk.Dec, error) {
+	if sender == "" {
+		return sdk.ZeroDec(), nil
+	}
+	addr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return k.GetSwapFeeDiscount(ctx, addr), nil
+}
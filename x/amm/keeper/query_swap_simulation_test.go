@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/elys-network/elys/x/amm/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+func (suite *KeeperTestSuite) setupSimulationPool() sdk.AccAddress {
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	poolAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	treasuryAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	senderInitBalance := sdk.Coins{sdk.NewInt64Coin(ptypes.Elys, 1000000), sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000)}
+	poolCoins := sdk.Coins{sdk.NewInt64Coin(ptypes.Elys, 1000000), sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000)}
+
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, senderInitBalance.Add(poolCoins...))
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sender, senderInitBalance)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, poolAddr, poolCoins)
+	suite.Require().NoError(err)
+
+	suite.app.AmmKeeper.SetDenomLiquidity(suite.ctx, types.DenomLiquidity{
+		Denom:     ptypes.Elys,
+		Liquidity: sdk.NewInt(1000000),
+	})
+	suite.app.AmmKeeper.SetDenomLiquidity(suite.ctx, types.DenomLiquidity{
+		Denom:     ptypes.BaseCurrency,
+		Liquidity: sdk.NewInt(1000000),
+	})
+
+	err = suite.app.AmmKeeper.SetPool(suite.ctx, types.Pool{
+		PoolId:            1,
+		Address:           poolAddr.String(),
+		RebalanceTreasury: treasuryAddr.String(),
+		PoolParams: types.PoolParams{
+			SwapFee:  sdk.NewDecWithPrec(1, 2), // 1%
+			FeeDenom: ptypes.BaseCurrency,
+		},
+		TotalShares: sdk.Coin{},
+		PoolAssets: []types.PoolAsset{
+			{
+				Token:  poolCoins[0],
+				Weight: sdk.NewInt(10),
+			},
+			{
+				Token:  poolCoins[1],
+				Weight: sdk.NewInt(10),
+			},
+		},
+		TotalWeight: sdk.ZeroInt(),
+	})
+	suite.Require().NoError(err)
+	return sender
+}
+
+func (suite *KeeperTestSuite) TestSwapSimulation() {
+	suite.SetupTest()
+	sender := suite.setupSimulationPool()
+
+	tokenIn := sdk.NewInt64Coin(ptypes.Elys, 10000)
+	routes := []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: ptypes.BaseCurrency}}
+	res, err := suite.app.AmmKeeper.SwapSimulation(sdk.WrapSDKContext(suite.ctx), &types.QuerySwapSimulationRequest{
+		Routes:  routes,
+		TokenIn: tokenIn,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(ptypes.BaseCurrency, 9704), res.TokenOut)
+	suite.Require().Len(res.Hops, 1)
+	hop := res.Hops[0]
+	suite.Require().Equal(sdk.NewDecWithPrec(1, 2), hop.SwapFee)
+	suite.Require().Equal(sdk.NewInt64Coin(ptypes.BaseCurrency, 98), hop.SwapFeeAmount)
+	suite.Require().Equal(sdk.OneDec(), hop.SpotPrice)
+	suite.Require().True(hop.Slippage.IsPositive())
+	suite.Require().True(hop.WeightRecoveryReward.IsZero())
+
+	// simulation does not change the pool
+	pool, found := suite.app.AmmKeeper.GetPool(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1000000), pool.PoolAssets[0].Token.Amount)
+
+	// simulated output matches the executed swap
+	tokenOutAmount, err := suite.app.AmmKeeper.RouteExactAmountIn(suite.ctx, sender, routes, tokenIn, sdk.ZeroInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.TokenOut.Amount, tokenOutAmount)
+}
+
+func (suite *KeeperTestSuite) TestSwapSimulationExactAmountOut() {
+	suite.SetupTest()
+	sender := suite.setupSimulationPool()
+
+	tokenOut := sdk.NewInt64Coin(ptypes.BaseCurrency, 10000)
+	routes := []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: ptypes.Elys}}
+	res, err := suite.app.AmmKeeper.SwapSimulationExactAmountOut(sdk.WrapSDKContext(suite.ctx), &types.QuerySwapSimulationExactAmountOutRequest{
+		Routes:   routes,
+		TokenOut: tokenOut,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(tokenOut, res.TokenOut)
+	suite.Require().Len(res.Hops, 1)
+	suite.Require().Equal(ptypes.Elys, res.Hops[0].SwapFeeAmount.Denom)
+
+	// simulated input matches the executed swap
+	tokenInAmount, err := suite.app.AmmKeeper.RouteExactAmountOut(suite.ctx, sender, routes, sdk.NewInt(1000000), tokenOut)
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.TokenIn.Amount, tokenInAmount)
+
+	// invalid pool
+	_, err = suite.app.AmmKeeper.SwapSimulationExactAmountOut(sdk.WrapSDKContext(suite.ctx), &types.QuerySwapSimulationExactAmountOutRequest{
+		Routes:   []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: ptypes.Elys}},
+		TokenOut: tokenOut,
+	})
+	suite.Require().Error(err)
+}
@@ -30,14 +30,14 @@ func (k Keeper) RouteExactAmountIn(
 	// In this loop, we check if:
 	// - the route is of length 2
 	// - route 1 and route 2 don't trade via the same pool
-	// - route 1 contains uelys
+	// - the intermediate denom is uelys or uusdc
 	// - both route 1 and route 2 are incentivized pools
 	//
 	// If all of the above is true, then we collect the additive and max fee between the
 	// two pools to later calculate the following:
 	// total_swap_fee = total_swap_fee = max(swapfee1, swapfee2)
 	// fee_per_pool = total_swap_fee * ((pool_fee) / (swapfee1 + swapfee2))
-	if k.isElysRoutedMultihop(ctx, route, routes[0].TokenOutDenom, tokenIn.Denom) {
+	if k.isElysRoutedMultihop(ctx, route, tokenIn.Denom, routes[len(routes)-1].TokenOutDenom) {
 		isMultiHopRouted = true
 		routeSwapFee, sumOfSwapFees, err = k.getElysRoutedMultihopTotalSwapFee(ctx, route)
 		if err != nil {
@@ -45,6 +45,7 @@ func (k Keeper) RouteExactAmountIn(
 		}
 	}
 
+	discount := k.GetSwapFeeDiscount(ctx, sender)
 	for i, route := range routes {
 		// To prevent the multihop swap from being interrupted prematurely, we keep
 		// the minimum expected output at a very low number until the last pool
@@ -64,13 +65,14 @@ func (k Keeper) RouteExactAmountIn(
 		// 	return math.Int{}, fmt.Errorf("pool %d is not active", pool.GetId())
 		// }
 
-		swapFee := pool.GetPoolParams().SwapFee
+		swapFee := k.GetEffectiveSwapFee(ctx, pool)
 
 		// If we determined the route is an osmo multi-hop and both routes are incentivized,
 		// we modify the swap fee accordingly.
 		if isMultiHopRouted && sumOfSwapFees.IsPositive() {
 			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
 		}
+		swapFee = ApplySwapFeeDiscount(swapFee, discount)
 
 		tokenOutAmount, err = k.SwapExactAmountIn(ctx, sender, pool, tokenIn, route.TokenOutDenom, _outMinAmount, swapFee)
 		if err != nil {
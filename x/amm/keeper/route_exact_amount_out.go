@@ -34,7 +34,7 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	// in this loop, we check if:
 	// - the route is of length 2
 	// - route 1 and route 2 don't trade via the same pool
-	// - route 1 contains uosmo
+	// - the intermediate denom is uelys or uusdc
 	// - both route 1 and route 2 are incentivized pools
 	// if all of the above is true, then we collect the additive and max fee between the two pools to later calculate the following:
 	// total_swap_fee = total_swap_fee = max(swapfee1, swapfee2)
@@ -50,11 +50,12 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	// Determine what the estimated input would be for each pool along the multi-hop route
 	// if we determined the route is an osmo multi-hop and both routes are incentivized,
 	// we utilize a separate function that calculates the discounted swap fees
+	discount := k.GetSwapFeeDiscount(ctx, sender)
 	var insExpected []math.Int
 	if isMultiHopRouted {
-		insExpected, err = k.createElysMultihopExpectedSwapOuts(ctx, routes, tokenOut, routeSwapFee, sumOfSwapFees)
+		insExpected, err = k.createElysMultihopExpectedSwapOuts(ctx, routes, tokenOut, routeSwapFee, sumOfSwapFees, discount)
 	} else {
-		insExpected, err = k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut)
+		insExpected, err = k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut, discount)
 	}
 	if err != nil {
 		return math.Int{}, err
@@ -88,10 +89,11 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		// 	return math.Int{}, fmt.Errorf("pool %d is not active", pool.GetId())
 		// }
 
-		swapFee := pool.GetPoolParams().SwapFee
+		swapFee := k.GetEffectiveSwapFee(ctx, pool)
 		if isMultiHopRouted && sumOfSwapFees.IsPositive() {
 			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
 		}
+		swapFee = ApplySwapFeeDiscount(swapFee, discount)
 
 		_tokenInAmount, swapErr := k.SwapExactAmountOut(ctx, sender, pool, route.TokenInDenom, insExpected[i], _tokenOut, swapFee)
 		if swapErr != nil {
@@ -217,3 +217,84 @@ func (suite *KeeperTestSuite) TestRouteExactAmountOut() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) setupRoutedPool(poolId uint64, swapFee sdk.Dec, poolCoins sdk.Coins) {
+	poolAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	treasuryAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, poolCoins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, poolAddr, poolCoins)
+	suite.Require().NoError(err)
+
+	poolAssets := []types.PoolAsset{}
+	for _, coin := range poolCoins {
+		poolAssets = append(poolAssets, types.PoolAsset{Token: coin, Weight: sdk.NewInt(10)})
+		liquidity, found := suite.app.AmmKeeper.GetDenomLiquidity(suite.ctx, coin.Denom)
+		if !found {
+			liquidity = types.DenomLiquidity{Denom: coin.Denom, Liquidity: sdk.ZeroInt()}
+		}
+		liquidity.Liquidity = liquidity.Liquidity.Add(coin.Amount)
+		suite.app.AmmKeeper.SetDenomLiquidity(suite.ctx, liquidity)
+	}
+	err = suite.app.AmmKeeper.SetPool(suite.ctx, types.Pool{
+		PoolId:            poolId,
+		Address:           poolAddr.String(),
+		RebalanceTreasury: treasuryAddr.String(),
+		PoolParams: types.PoolParams{
+			SwapFee:  swapFee,
+			FeeDenom: ptypes.BaseCurrency,
+		},
+		PoolAssets:  poolAssets,
+		TotalWeight: sdk.NewInt(20),
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestRouteExactAmountOutUsdcRoutedMultihop() {
+	suite.SetupTest()
+	suite.setupRoutedPool(1, sdk.NewDecWithPrec(1, 2), sdk.NewCoins(sdk.NewInt64Coin(ptypes.ATOM, 1000000), sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000)))
+	suite.setupRoutedPool(2, sdk.NewDecWithPrec(3, 2), sdk.NewCoins(sdk.NewInt64Coin(ptypes.BaseCurrency, 1000000), sdk.NewInt64Coin(ptypes.Elys, 1000000)))
+
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	senderInitBalance := sdk.Coins{sdk.NewInt64Coin(ptypes.ATOM, 1000000)}
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, senderInitBalance)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sender, senderInitBalance)
+	suite.Require().NoError(err)
+
+	// atom -> usdc -> elys pays max(1%, 3%) split in proportion of the pool fees
+	routes := []types.SwapAmountOutRoute{
+		{PoolId: 1, TokenInDenom: ptypes.ATOM},
+		{PoolId: 2, TokenInDenom: ptypes.BaseCurrency},
+	}
+	tokenOut := sdk.NewInt64Coin(ptypes.Elys, 10000)
+	res, err := suite.app.AmmKeeper.SwapSimulationExactAmountOut(sdk.WrapSDKContext(suite.ctx), &types.QuerySwapSimulationExactAmountOutRequest{
+		Routes:   routes,
+		TokenOut: tokenOut,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Hops, 2)
+	suite.Require().Equal(sdk.NewDecWithPrec(75, 4), res.Hops[0].SwapFee)
+	suite.Require().Equal(sdk.NewDecWithPrec(225, 4), res.Hops[1].SwapFee)
+	suite.Require().Equal(tokenOut, res.TokenOut)
+
+	// the executed route matches the quote
+	tokenInAmount, err := suite.app.AmmKeeper.RouteExactAmountOut(suite.ctx, sender, routes, sdk.NewInt(1000000), tokenOut)
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.TokenIn.Amount, tokenInAmount)
+	suite.Require().Equal(tokenOut.Amount, suite.app.BankKeeper.GetBalance(suite.ctx, sender, ptypes.Elys).Amount)
+	suite.Require().Equal(senderInitBalance.AmountOf(ptypes.ATOM).Sub(tokenInAmount), suite.app.BankKeeper.GetBalance(suite.ctx, sender, ptypes.ATOM).Amount)
+
+	// exact amount in routes through usdc are discounted the same way
+	inRoutes := []types.SwapAmountInRoute{
+		{PoolId: 1, TokenOutDenom: ptypes.BaseCurrency},
+		{PoolId: 2, TokenOutDenom: ptypes.Elys},
+	}
+	inRes, err := suite.app.AmmKeeper.SwapSimulation(sdk.WrapSDKContext(suite.ctx), &types.QuerySwapSimulationRequest{
+		Routes:  inRoutes,
+		TokenIn: sdk.NewInt64Coin(ptypes.ATOM, 10000),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(75, 4), inRes.Hops[0].SwapFee)
+	suite.Require().Equal(sdk.NewDecWithPrec(225, 4), inRes.Hops[1].SwapFee)
+}
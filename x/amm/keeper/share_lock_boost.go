@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetShareLockBoost returns the Eden LP rewards multiplier of shares locked for duration seconds,
+// it grows linearly from 1 to MaxShareLockBoost at MaxShareLockDuration.
+func (k Keeper) GetShareLockBoost(ctx sdk.Context, duration uint64) sdk.Dec {
+	maxDuration := k.MaxShareLockDuration(ctx)
+	if maxDuration == 0 {
+		return sdk.OneDec()
+	}
+	if duration > maxDuration {
+		duration = maxDuration
+	}
+
+	maxBoost := k.MaxShareLockBoost(ctx)
+	return sdk.OneDec().Add(maxBoost.Sub(sdk.OneDec()).MulInt64(int64(duration)).QuoInt64(int64(maxDuration)))
+}
@@ -0,0 +1,380 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// copyPool returns a copy of the pool that can be mutated without affecting the original pool assets
+func copyPool(pool types.Pool) types.Pool {
+	copied := pool
+	copied.PoolAssets = append([]types.PoolAsset{}, pool.PoolAssets...)
+	return copied
+}
+
+// oraclePrice returns the oracle price of tokenIn denominated in tokenOut, zero when any price is missing
+func (k Keeper) oraclePrice(ctx sdk.Context, tokenInDenom, tokenOutDenom string) sdk.Dec {
+	inTokenPrice := k.oracleKeeper.GetAssetPriceFromDenom(ctx, tokenInDenom)
+	outTokenPrice := k.oracleKeeper.GetAssetPriceFromDenom(ctx, tokenOutDenom)
+	if inTokenPrice.IsZero() || outTokenPrice.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return inTokenPrice.Quo(outTokenPrice)
+}
+
+// weightRecoveryReward returns the bonus the rebalance treasury pays on the swap output,
+// capped by the treasury balance as done in UpdatePoolForSwap
+func (k Keeper) weightRecoveryReward(ctx sdk.Context, pool types.Pool, tokenOut sdk.Coin, weightBalanceBonus sdk.Dec) sdk.Coin {
+	if !weightBalanceBonus.IsPositive() {
+		return sdk.NewCoin(tokenOut.Denom, sdk.ZeroInt())
+	}
+	rebalanceTreasuryAddr := sdk.MustAccAddressFromBech32(pool.GetRebalanceTreasury())
+	treasuryTokenAmount := k.bankKeeper.GetBalance(ctx, rebalanceTreasuryAddr, tokenOut.Denom).Amount
+	bonusTokenAmount := sdk.NewDecFromInt(tokenOut.Amount).Mul(weightBalanceBonus).RoundInt()
+	if treasuryTokenAmount.LT(bonusTokenAmount) {
+		bonusTokenAmount = treasuryTokenAmount
+	}
+	return sdk.NewCoin(tokenOut.Denom, bonusTokenAmount)
+}
+
+// SimulateSwapExactAmountIn runs the computations of SwapExactAmountIn without moving any funds
+// and returns the breakdown of the swap. The updated pool is stored on the given context, so it
+// should be called on a cached context.
+func (k Keeper) SimulateSwapExactAmountIn(
+	ctx sdk.Context,
+	pool types.Pool,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+	discount sdk.Dec,
+) (hop types.SwapSimulationHop, err error) {
+	if tokenIn.Denom == tokenOutDenom {
+		return hop, errors.New("cannot trade the same denomination in and out")
+	}
+	poolSwapFee := ApplySwapFeeDiscount(k.GetEffectiveSwapFee(ctx, pool), discount)
+	if swapFee.LT(poolSwapFee.QuoInt64(2)) {
+		return hop, fmt.Errorf("given swap fee (%s) must be greater than or equal to half of the pool's swap fee (%s)", swapFee, poolSwapFee)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			hop = types.SwapSimulationHop{}
+			err = fmt.Errorf("function SimulateSwapExactAmountIn failed due to an internal reason: %v", r)
+		}
+	}()
+
+	snapshot := k.GetPoolSnapshotOrSet(ctx, pool)
+	spotPrice, err := pool.SpotPrice(ctx, k.oracleKeeper, &snapshot, tokenIn.Denom, tokenOutDenom, k.accountedPoolKeeper)
+	if err != nil {
+		return hop, err
+	}
+	oraclePrice := k.oraclePrice(ctx, tokenIn.Denom, tokenOutDenom)
+
+	swapped := copyPool(pool)
+	tokenOutCoin, slippageAmount, weightBalanceBonus, err := swapped.SwapOutAmtGivenIn(ctx, k.oracleKeeper, &snapshot, sdk.Coins{tokenIn}, tokenOutDenom, swapFee, k.accountedPoolKeeper)
+	if err != nil {
+		return hop, err
+	}
+	if !tokenOutCoin.Amount.IsPositive() {
+		return hop, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+
+	weightBreakingFee := sdk.ZeroDec()
+	slippage := sdk.ZeroDec()
+	if pool.PoolParams.UseOracle {
+		oracleOutAmount := sdk.NewDecFromInt(tokenIn.Amount).Mul(oraclePrice)
+		outAmountAfterSlippage := oracleOutAmount.Sub(slippageAmount)
+		weightBreakingFee, _, err = pool.WeightBreakingFeeAndBonus(
+			ctx,
+			k.oracleKeeper,
+			sdk.Coins{tokenIn},
+			sdk.Coins{sdk.NewCoin(tokenOutDenom, outAmountAfterSlippage.TruncateInt())},
+		)
+		if err != nil {
+			return hop, err
+		}
+		if oracleOutAmount.IsPositive() {
+			slippage = slippageAmount.Quo(oracleOutAmount)
+		}
+	} else {
+		// price impact of balancer pools against the pre-swap spot price
+		idealOutAmount := sdk.NewDecFromInt(tokenIn.Amount).Mul(sdk.OneDec().Sub(swapFee)).Mul(spotPrice)
+		slippageAmount = idealOutAmount.Sub(sdk.NewDecFromInt(tokenOutCoin.Amount))
+		if slippageAmount.IsNegative() {
+			slippageAmount = sdk.ZeroDec()
+		}
+		if idealOutAmount.IsPositive() {
+			slippage = slippageAmount.Quo(idealOutAmount)
+		}
+	}
+
+	// swap fee is taken on the output when weight balance bonus is not available
+	swapFeeAmount := sdk.ZeroInt()
+	if weightBalanceBonus.IsZero() {
+		swapFeeAmount = PortionCoins(sdk.Coins{tokenOutCoin}, swapFee).AmountOf(tokenOutDenom)
+	}
+	reward := k.weightRecoveryReward(ctx, pool, tokenOutCoin, weightBalanceBonus)
+
+	err = k.SetPool(ctx, swapped)
+	if err != nil {
+		return hop, err
+	}
+
+	return types.SwapSimulationHop{
+		PoolId:               pool.PoolId,
+		UseOracle:            pool.PoolParams.UseOracle,
+		TokenIn:              tokenIn,
+		TokenOut:             sdk.NewCoin(tokenOutDenom, tokenOutCoin.Amount.Sub(swapFeeAmount)),
+		SwapFee:              swapFee,
+		SwapFeeAmount:        sdk.NewCoin(tokenOutDenom, swapFeeAmount),
+		WeightBreakingFee:    weightBreakingFee,
+		WeightRecoveryReward: reward,
+		Slippage:             slippage,
+		SlippageAmount:       sdk.NewCoin(tokenOutDenom, slippageAmount.RoundInt()),
+		SpotPrice:            spotPrice,
+		OraclePrice:          oraclePrice,
+	}, nil
+}
+
+// SimulateSwapExactAmountOut runs the computations of SwapExactAmountOut without moving any funds
+// and returns the breakdown of the swap. The updated pool is stored on the given context, so it
+// should be called on a cached context.
+func (k Keeper) SimulateSwapExactAmountOut(
+	ctx sdk.Context,
+	pool types.Pool,
+	tokenInDenom string,
+	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
+) (hop types.SwapSimulationHop, err error) {
+	if tokenInDenom == tokenOut.Denom {
+		return hop, errors.New("cannot trade the same denomination in and out")
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			hop = types.SwapSimulationHop{}
+			err = fmt.Errorf("function SimulateSwapExactAmountOut failed due to an internal reason: %v", r)
+		}
+	}()
+
+	poolOutBal := pool.GetTotalPoolLiquidity().AmountOf(tokenOut.Denom)
+	if tokenOut.Amount.GTE(poolOutBal) {
+		return hop, sdkerrors.Wrapf(types.ErrTooManyTokensOut, "cannot get more tokens out than there are tokens in the pool")
+	}
+
+	snapshot := k.GetPoolSnapshotOrSet(ctx, pool)
+	spotPrice, err := pool.SpotPrice(ctx, k.oracleKeeper, &snapshot, tokenInDenom, tokenOut.Denom, k.accountedPoolKeeper)
+	if err != nil {
+		return hop, err
+	}
+	oraclePrice := k.oraclePrice(ctx, tokenInDenom, tokenOut.Denom)
+
+	swapped := copyPool(pool)
+	tokenInCoin, slippageAmount, weightBalanceBonus, err := swapped.SwapInAmtGivenOut(ctx, k.oracleKeeper, &snapshot, sdk.Coins{tokenOut}, tokenInDenom, swapFee, k.accountedPoolKeeper)
+	if err != nil {
+		return hop, err
+	}
+	if !tokenInCoin.Amount.IsPositive() {
+		return hop, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
+	}
+
+	weightBreakingFee := sdk.ZeroDec()
+	slippage := sdk.ZeroDec()
+	if pool.PoolParams.UseOracle {
+		oracleInAmount := sdk.ZeroDec()
+		if oraclePrice.IsPositive() {
+			oracleInAmount = sdk.NewDecFromInt(tokenOut.Amount).Quo(oraclePrice)
+		}
+		inAmountAfterSlippage := oracleInAmount.Add(slippageAmount)
+		weightBreakingFee, _, err = pool.WeightBreakingFeeAndBonus(
+			ctx,
+			k.oracleKeeper,
+			sdk.Coins{sdk.NewCoin(tokenInDenom, inAmountAfterSlippage.TruncateInt())},
+			sdk.Coins{tokenOut},
+		)
+		if err != nil {
+			return hop, err
+		}
+		if oracleInAmount.IsPositive() {
+			slippage = slippageAmount.Quo(oracleInAmount)
+		}
+	} else {
+		// price impact of balancer pools against the pre-swap spot price
+		idealInAmount := sdk.NewDecFromInt(tokenOut.Amount).Quo(spotPrice).Quo(sdk.OneDec().Sub(swapFee))
+		slippageAmount = sdk.NewDecFromInt(tokenInCoin.Amount).Sub(idealInAmount)
+		if slippageAmount.IsNegative() {
+			slippageAmount = sdk.ZeroDec()
+		}
+		if idealInAmount.IsPositive() {
+			slippage = slippageAmount.Quo(idealInAmount)
+		}
+	}
+
+	// swap fee is taken on the input when weight balance bonus is not available
+	swapFeeAmount := sdk.ZeroInt()
+	if weightBalanceBonus.IsZero() {
+		swapFeeAmount = PortionCoins(sdk.Coins{tokenInCoin}, swapFee).AmountOf(tokenInDenom)
+	}
+	reward := k.weightRecoveryReward(ctx, pool, tokenOut, weightBalanceBonus)
+
+	err = k.SetPool(ctx, swapped)
+	if err != nil {
+		return hop, err
+	}
+
+	return types.SwapSimulationHop{
+		PoolId:               pool.PoolId,
+		UseOracle:            pool.PoolParams.UseOracle,
+		TokenIn:              tokenInCoin,
+		TokenOut:             tokenOut,
+		SwapFee:              swapFee,
+		SwapFeeAmount:        sdk.NewCoin(tokenInDenom, swapFeeAmount),
+		WeightBreakingFee:    weightBreakingFee,
+		WeightRecoveryReward: reward,
+		Slippage:             slippage,
+		SlippageAmount:       sdk.NewCoin(tokenInDenom, slippageAmount.RoundInt()),
+		SpotPrice:            spotPrice,
+		OraclePrice:          oraclePrice,
+	}, nil
+}
+
+// SimulateRouteExactAmountIn follows RouteExactAmountIn hop by hop, including the multihop
+// swap fee discount, and returns the breakdown of every hop.
+func (k Keeper) SimulateRouteExactAmountIn(
+	ctx sdk.Context,
+	routes []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	discount sdk.Dec,
+) (*types.QuerySwapSimulationResponse, error) {
+	var (
+		isMultiHopRouted bool
+		routeSwapFee     sdk.Dec
+		sumOfSwapFees    sdk.Dec
+		err              error
+	)
+
+	route := types.SwapAmountInRoutes(routes)
+	if err := route.Validate(); err != nil {
+		return nil, err
+	}
+
+	if k.isElysRoutedMultihop(ctx, route, tokenIn.Denom, routes[len(routes)-1].TokenOutDenom) {
+		isMultiHopRouted = true
+		routeSwapFee, sumOfSwapFees, err = k.getElysRoutedMultihopTotalSwapFee(ctx, route)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// pools are updated hop by hop, keep the changes away from the query context
+	cacheCtx, _ := ctx.CacheContext()
+	initialTokenIn := tokenIn
+	hops := []types.SwapSimulationHop{}
+	for _, route := range routes {
+		pool, poolExists := k.GetPool(cacheCtx, route.PoolId)
+		if !poolExists {
+			return nil, types.ErrInvalidPoolId
+		}
+
+		swapFee := k.GetEffectiveSwapFee(ctx, pool)
+		if isMultiHopRouted && sumOfSwapFees.IsPositive() {
+			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
+		}
+		swapFee = ApplySwapFeeDiscount(swapFee, discount)
+
+		hop, err := k.SimulateSwapExactAmountIn(cacheCtx, pool, tokenIn, route.TokenOutDenom, swapFee, discount)
+		if err != nil {
+			return nil, err
+		}
+		hops = append(hops, hop)
+
+		// Chain output of current pool as the input for the next routed pool
+		tokenIn = hop.TokenOut
+	}
+
+	return &types.QuerySwapSimulationResponse{
+		TokenIn:        initialTokenIn,
+		TokenOut:       tokenIn,
+		ExecutionPrice: sdk.NewDecFromInt(tokenIn.Amount).Quo(sdk.NewDecFromInt(initialTokenIn.Amount)),
+		Hops:           hops,
+	}, nil
+}
+
+// SimulateRouteExactAmountOut follows RouteExactAmountOut hop by hop, including the multihop
+// swap fee discount, and returns the breakdown of every hop.
+func (k Keeper) SimulateRouteExactAmountOut(
+	ctx sdk.Context,
+	routes []types.SwapAmountOutRoute,
+	tokenOut sdk.Coin,
+	discount sdk.Dec,
+) (*types.QuerySwapSimulationResponse, error) {
+	isMultiHopRouted, routeSwapFee, sumOfSwapFees := false, sdk.Dec{}, sdk.Dec{}
+	route := types.SwapAmountOutRoutes(routes)
+	if err := route.Validate(); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if k.isElysRoutedMultihop(ctx, route, routes[0].TokenInDenom, tokenOut.Denom) {
+		isMultiHopRouted = true
+		routeSwapFee, sumOfSwapFees, err = k.getElysRoutedMultihopTotalSwapFee(ctx, route)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var insExpected []math.Int
+	if isMultiHopRouted {
+		insExpected, err = k.createElysMultihopExpectedSwapOuts(ctx, routes, tokenOut, routeSwapFee, sumOfSwapFees, discount)
+	} else {
+		insExpected, err = k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut, discount)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// pools are updated hop by hop, keep the changes away from the query context
+	cacheCtx, _ := ctx.CacheContext()
+	hops := []types.SwapSimulationHop{}
+	for i, route := range routes {
+		_tokenOut := tokenOut
+		if i != len(routes)-1 {
+			_tokenOut = sdk.NewCoin(routes[i+1].TokenInDenom, insExpected[i+1])
+		}
+
+		pool, poolExists := k.GetPool(cacheCtx, route.PoolId)
+		if !poolExists {
+			return nil, types.ErrInvalidPoolId
+		}
+
+		swapFee := k.GetEffectiveSwapFee(ctx, pool)
+		if isMultiHopRouted && sumOfSwapFees.IsPositive() {
+			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
+		}
+		swapFee = ApplySwapFeeDiscount(swapFee, discount)
+
+		hop, err := k.SimulateSwapExactAmountOut(cacheCtx, pool, route.TokenInDenom, _tokenOut, swapFee)
+		if err != nil {
+			return nil, err
+		}
+
+		// intermediate hops are bounded by the expected inputs, as done when executing the route
+		if i != 0 && hop.TokenIn.Amount.GT(insExpected[i]) {
+			return nil, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "swap requires %s, which is greater than the amount %s", hop.TokenIn, insExpected[i])
+		}
+		hops = append(hops, hop)
+	}
+
+	tokenIn := hops[0].TokenIn
+	return &types.QuerySwapSimulationResponse{
+		TokenIn:        tokenIn,
+		TokenOut:       tokenOut,
+		ExecutionPrice: sdk.NewDecFromInt(tokenOut.Amount).Quo(sdk.NewDecFromInt(tokenIn.Amount)),
+		Hops:           hops,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+// GetSwapFeeDiscount returns the swap fee discount of the highest tier reached by the Eden and
+// EdenB amount committed by the trader
+func (k Keeper) GetSwapFeeDiscount(ctx sdk.Context, trader sdk.AccAddress) sdk.Dec {
+	discount := sdk.ZeroDec()
+	tiers := k.SwapFeeDiscountTiers(ctx)
+	if len(tiers) == 0 || k.commitmentKeeper == nil {
+		return discount
+	}
+
+	commitments, found := k.commitmentKeeper.GetCommitments(ctx, trader.String())
+	if !found {
+		return discount
+	}
+	committed := commitments.GetCommittedAmountForDenom(ptypes.Eden).Add(commitments.GetCommittedAmountForDenom(ptypes.EdenB))
+
+	for _, tier := range tiers {
+		if committed.GTE(tier.MinCommitted) {
+			discount = tier.Discount
+		}
+	}
+	return discount
+}
+
+// ApplySwapFeeDiscount reduces the swap fee by the discount
+func ApplySwapFeeDiscount(swapFee, discount sdk.Dec) sdk.Dec {
+	return swapFee.Mul(sdk.OneDec().Sub(discount))
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+	commitmenttypes "github.com/elys-network/elys/x/commitment/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+func (suite *KeeperTestSuite) TestSwapFeeDiscount() {
+	suite.SetupTest()
+	sender := suite.setupSimulationPool()
+
+	params := suite.app.AmmKeeper.GetParams(suite.ctx)
+	params.SwapFeeDiscountTiers = []types.SwapFeeDiscountTier{
+		{MinCommitted: sdk.NewInt(1000), Discount: sdk.NewDecWithPrec(10, 2)},
+		{MinCommitted: sdk.NewInt(10000), Discount: sdk.NewDecWithPrec(50, 2)},
+	}
+	suite.Require().NoError(params.Validate())
+	suite.app.AmmKeeper.SetParams(suite.ctx, params)
+
+	// no commitments, no discount
+	suite.Require().True(suite.app.AmmKeeper.GetSwapFeeDiscount(suite.ctx, sender).IsZero())
+
+	// eden and eden boost commitments are summed up to reach the highest tier
+	suite.app.CommitmentKeeper.SetCommitments(suite.ctx, commitmenttypes.Commitments{
+		Creator: sender.String(),
+		CommittedTokens: []*commitmenttypes.CommittedTokens{
+			{Denom: ptypes.Eden, Amount: sdk.NewInt(6000)},
+			{Denom: ptypes.EdenB, Amount: sdk.NewInt(4000)},
+		},
+	})
+	suite.Require().Equal(sdk.NewDecWithPrec(50, 2), suite.app.AmmKeeper.GetSwapFeeDiscount(suite.ctx, sender))
+
+	tokenIn := sdk.NewInt64Coin(ptypes.Elys, 10000)
+	routes := []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: ptypes.BaseCurrency}}
+	res, err := suite.app.AmmKeeper.SwapSimulation(sdk.WrapSDKContext(suite.ctx), &types.QuerySwapSimulationRequest{
+		Routes:  routes,
+		TokenIn: tokenIn,
+		Sender:  sender.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 3), res.Hops[0].SwapFee)
+
+	// the swap charges the discounted fee
+	tokenOut, err := suite.app.AmmKeeper.RouteExactAmountIn(suite.ctx, sender, routes, tokenIn, sdk.ZeroInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.TokenOut.Amount, tokenOut)
+
+	// exact amount out estimates also apply the discount
+	outRoutes := []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: ptypes.BaseCurrency}}
+	outRes, err := suite.app.AmmKeeper.SwapSimulationExactAmountOut(sdk.WrapSDKContext(suite.ctx), &types.QuerySwapSimulationExactAmountOutRequest{
+		Routes:   outRoutes,
+		TokenOut: sdk.NewInt64Coin(ptypes.Elys, 1000),
+		Sender:   sender.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 3), outRes.Hops[0].SwapFee)
+}
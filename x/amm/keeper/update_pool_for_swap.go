@@ -83,12 +83,14 @@ func (k Keeper) UpdatePoolForSwap(
 	}
 
 	// send bonus tokens to sender if positive
+	weightRecoveryPaid := sdk.Coins{}
 	if weightBalanceBonus.IsPositive() && bonusTokenAmount.IsPositive() {
 		bonusToken := sdk.NewCoin(tokenOut.Denom, bonusTokenAmount)
 		err = k.bankKeeper.SendCoins(ctx, rebalanceTreasuryAddr, sender, sdk.Coins{bonusToken})
 		if err != nil {
 			return err, sdk.ZeroInt()
 		}
+		weightRecoveryPaid = sdk.Coins{bonusToken}
 	}
 
 	types.EmitSwapEvent(ctx, sender, pool.GetPoolId(), tokensIn, tokensOut)
@@ -98,6 +100,8 @@ func (k Keeper) UpdatePoolForSwap(
 	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
 	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
 	k.RecordTotalLiquidityDecrease(ctx, swapFeeCoins)
+	k.TrackPoolSwap(ctx, pool.PoolId, tokensIn, weightRecoveryPaid)
+	k.UpdatePoolTwap(ctx, pool)
 
 	return nil, swapFeeOutCoins.AmountOf(tokenOut.Denom)
 }
@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+)
+
+// V2Migration sets the params added since v1 to their defaults
+func (m Migrator) V2Migration(ctx sdk.Context) error {
+	m.keeper.SetParamIfMissing(ctx, types.KeyMaxOracleSpotDivergence, types.DefaultMaxOracleSpotDivergence)
+	m.keeper.SetParamIfMissing(ctx, types.KeyMaxBlockSlippage, types.DefaultMaxBlockSlippage)
+	m.keeper.SetParamIfMissing(ctx, types.KeyDynamicSwapFeeFloor, types.DefaultDynamicSwapFeeFloor)
+	m.keeper.SetParamIfMissing(ctx, types.KeyDynamicSwapFeeCeiling, types.DefaultDynamicSwapFeeCeiling)
+	m.keeper.SetParamIfMissing(ctx, types.KeyVolatilityFeeMultiplier, types.DefaultVolatilityFeeMultiplier)
+	m.keeper.SetParamIfMissing(ctx, types.KeySlippageFeeMultiplier, types.DefaultSlippageFeeMultiplier)
+	m.keeper.SetParamIfMissing(ctx, types.KeyVolatilityWindow, types.DefaultVolatilityWindow)
+	m.keeper.SetParamIfMissing(ctx, types.KeySwapFeeDiscountTiers, types.DefaultSwapFeeDiscountTiers)
+	m.keeper.SetParamIfMissing(ctx, types.KeyPoolCreationFeeReceiver, types.DefaultPoolCreationFeeReceiver)
+	m.keeper.SetParamIfMissing(ctx, types.KeyMaxShareLockDuration, types.DefaultMaxShareLockDuration)
+	m.keeper.SetParamIfMissing(ctx, types.KeyMaxShareLockBoost, types.DefaultMaxShareLockBoost)
+	return nil
+}
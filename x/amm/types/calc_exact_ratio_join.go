@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CalcExactRatioJoin calculates the shares minted for joining the pool with tokensIn at the current pool ratio,
+// using integer arithmetic only. Shares are rounded down and the joined amounts are rounded up, so the pool is
+// never diluted and the joined amounts never exceed tokensIn. Pool assets missing from tokensIn yield zero shares.
+func (p Pool) CalcExactRatioJoin(tokensIn sdk.Coins) (numShares sdk.Int, tokensJoined sdk.Coins) {
+	totalShares := p.GetTotalShares().Amount
+	numShares = sdk.Int{}
+	for _, asset := range p.PoolAssets {
+		if !asset.Token.Amount.IsPositive() {
+			return sdk.ZeroInt(), sdk.Coins{}
+		}
+		// shares = floor(amountIn * totalShares / poolBalance)
+		shares := tokensIn.AmountOf(asset.Token.Denom).Mul(totalShares).Quo(asset.Token.Amount)
+		if numShares.IsNil() || shares.LT(numShares) {
+			numShares = shares
+		}
+	}
+	if numShares.IsNil() || !numShares.IsPositive() {
+		return sdk.ZeroInt(), sdk.Coins{}
+	}
+
+	tokensJoined = sdk.Coins{}
+	for _, asset := range p.PoolAssets {
+		// joined = ceil(shares * poolBalance / totalShares)
+		numerator := numShares.Mul(asset.Token.Amount)
+		joined := numerator.Quo(totalShares)
+		if !joined.Mul(totalShares).Equal(numerator) {
+			joined = joined.AddRaw(1)
+		}
+		tokensJoined = tokensJoined.Add(sdk.NewCoin(asset.Token.Denom, joined))
+	}
+	return numShares, tokensJoined
+}
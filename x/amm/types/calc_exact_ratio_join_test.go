@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/amm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcExactRatioJoin(t *testing.T) {
+	pool := types.Pool{
+		TotalShares: sdk.NewCoin("amm/pool/1", sdk.NewInt(3000)),
+		PoolAssets: []types.PoolAsset{
+			{Token: sdk.NewInt64Coin("uatom", 1000), Weight: sdk.NewInt(10)},
+			{Token: sdk.NewInt64Coin("uusdc", 3000), Weight: sdk.NewInt(10)},
+		},
+	}
+
+	tests := []struct {
+		desc        string
+		tokensIn    sdk.Coins
+		expShares   sdk.Int
+		expTokensIn sdk.Coins
+	}{
+		{
+			desc:        "exact ratio",
+			tokensIn:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin("uusdc", 300)),
+			expShares:   sdk.NewInt(300),
+			expTokensIn: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin("uusdc", 300)),
+		},
+		{
+			desc:        "shares rounded down, joined amounts rounded up",
+			tokensIn:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin("uusdc", 250)),
+			expShares:   sdk.NewInt(250),
+			expTokensIn: sdk.NewCoins(sdk.NewInt64Coin("uatom", 84), sdk.NewInt64Coin("uusdc", 250)),
+		},
+		{
+			desc:        "missing pool asset",
+			tokensIn:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+			expShares:   sdk.ZeroInt(),
+			expTokensIn: sdk.Coins{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			shares, tokensJoined := pool.CalcExactRatioJoin(tt.tokensIn)
+			require.Equal(t, tt.expShares.String(), shares.String())
+			require.Equal(t, tt.expTokensIn.String(), tokensJoined.String())
+		})
+	}
+}
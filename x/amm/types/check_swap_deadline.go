@@ -0,0 +1,19 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CheckSwapDeadline returns an error when the block time is past the swap deadline.
+// A nil deadline means the swap can be executed at any time.
+func CheckSwapDeadline(blockTime time.Time, deadline *time.Time) error {
+	if deadline == nil {
+		return nil
+	}
+	if blockTime.After(*deadline) {
+		return sdkerrors.Wrapf(ErrSwapDeadlinePassed, "deadline %s, block time %s", deadline.UTC(), blockTime.UTC())
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elys-network/elys/x/amm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSwapDeadline(t *testing.T) {
+	blockTime := time.Unix(1700000000, 0)
+	before := blockTime.Add(-time.Second)
+	after := blockTime.Add(time.Second)
+
+	require.NoError(t, types.CheckSwapDeadline(blockTime, nil))
+	require.NoError(t, types.CheckSwapDeadline(blockTime, &blockTime))
+	require.NoError(t, types.CheckSwapDeadline(blockTime, &after))
+	require.ErrorIs(t, types.CheckSwapDeadline(blockTime, &before), types.ErrSwapDeadlinePassed)
+}
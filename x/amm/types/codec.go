@@ -14,6 +14,13 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSwapExactAmountIn{}, "amm/SwapExactAmountIn", nil)
 	cdc.RegisterConcrete(&MsgFeedMultipleExternalLiquidity{}, "amm/FeedMultipleExternalLiquidity", nil)
 	cdc.RegisterConcrete(&MsgSwapExactAmountOut{}, "amm/SwapExactAmountOut", nil)
+	cdc.RegisterConcrete(&MsgPausePool{}, "amm/PausePool", nil)
+	cdc.RegisterConcrete(&MsgUnpausePool{}, "amm/UnpausePool", nil)
+	cdc.RegisterConcrete(&MsgFlashSwap{}, "amm/FlashSwap", nil)
+	cdc.RegisterConcrete(&MsgRepayFlashSwap{}, "amm/RepayFlashSwap", nil)
+	cdc.RegisterConcrete(&MsgSetExternalLiquidityBounds{}, "amm/SetExternalLiquidityBounds", nil)
+	cdc.RegisterConcrete(&MsgZapJoinPool{}, "amm/ZapJoinPool", nil)
+	cdc.RegisterConcrete(&MsgLockShares{}, "amm/LockShares", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -25,6 +32,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSwapExactAmountIn{},
 		&MsgSwapExactAmountOut{},
 		&MsgFeedMultipleExternalLiquidity{},
+		&MsgPausePool{},
+		&MsgUnpausePool{},
+		&MsgFlashSwap{},
+		&MsgRepayFlashSwap{},
+		&MsgSetExternalLiquidityBounds{},
+		&MsgZapJoinPool{},
+		&MsgLockShares{},
 	)
 	// this line is used by starport scaffolding # 3
 
@@ -8,6 +8,30 @@ const (
 	OneShareExponent = 18
 
 	BalancerGasFeeForSwap = 10_000
+
+	// PoolStatsBucketDuration is the length in seconds of a pool stats bucket
+	PoolStatsBucketDuration = 3600
+	// PoolStatsDayWindow and PoolStatsWeekWindow are the rolling windows reported by the PoolStats query
+	PoolStatsDayWindow  = 86400
+	PoolStatsWeekWindow = 86400 * 7
+
+	// MaxVolatilityWindow is the longest volatility window in seconds
+	MaxVolatilityWindow = 86400
+	// MaxVolatilityPriceEntries is the maximum number of oracle prices read to compute a price volatility
+	MaxVolatilityPriceEntries = 100
+
+	// MaxPrunedRecordsPerBlock is the maximum number of outdated records of each kind removed per block
+	MaxPrunedRecordsPerBlock = 100
+
+	// PoolTwapHistoryWindow is how long in seconds pool twap records are kept
+	PoolTwapHistoryWindow = 86400 * 2
+
+	// ExternalLiquidityHistorySize is the number of external liquidity submissions kept per pool
+	ExternalLiquidityHistorySize = 100
+	// ExternalLiquidityFeedMaxAge is the age in seconds after which a feeder submission is left out of the aggregated ratio
+	ExternalLiquidityFeedMaxAge = 3600
+	// ExternalLiquidityMinFeeders is the number of feeders with a recent submission required to update the pool ratio
+	ExternalLiquidityMinFeeders = 3
 )
 
 var (
@@ -24,6 +24,20 @@ var (
 
 	ErrInvalidPoolId      = sdkerrors.Register(ModuleName, 91, "invalid pool id")
 	ErrInvalidSwapMsgType = sdkerrors.Register(ModuleName, 92, "unexpected swap message type")
+	ErrSwapDeadlinePassed = sdkerrors.Register(ModuleName, 93, "swap deadline has passed")
+	ErrPoolPaused         = sdkerrors.Register(ModuleName, 94, "pool is paused")
+	ErrPoolNotPaused      = sdkerrors.Register(ModuleName, 95, "pool is not paused")
+	ErrInvalidFlashSwap   = sdkerrors.Register(ModuleName, 96, "invalid flash swap")
+	ErrFlashSwapNotRepaid = sdkerrors.Register(ModuleName, 97, "flash swap not repaid")
+	ErrPoolInvariant      = sdkerrors.Register(ModuleName, 98, "pool balance is lower than its reserves")
+	ErrTwapUnavailable    = sdkerrors.Register(ModuleName, 99, "twap unavailable")
+
+	ErrExternalLiquidityOutOfBounds = sdkerrors.Register(ModuleName, 100, "external liquidity ratio out of bounds")
+	ErrInvalidExternalLiquidity     = sdkerrors.Register(ModuleName, 101, "invalid external liquidity")
+	ErrPoolCreationNotPermitted     = sdkerrors.Register(ModuleName, 102, "asset is not permitted in pool creation")
+	ErrZapJoinNotSupported          = sdkerrors.Register(ModuleName, 103, "zap join is only supported on two asset non-oracle pools")
+	ErrInvalidShareLockDuration     = sdkerrors.Register(ModuleName, 104, "invalid share lock duration")
+	ErrFlashSwapOutstanding         = sdkerrors.Register(ModuleName, 105, "pool has an outstanding flash swap")
 )
 
 const (
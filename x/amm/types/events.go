@@ -11,11 +11,14 @@ const (
 	TypeEvtPoolExited   = "pool_exited"
 	TypeEvtPoolCreated  = "pool_created"
 	TypeEvtTokenSwapped = "token_swapped"
+	TypeEvtPoolPaused   = "pool_paused"
+	TypeEvtPoolUnpaused = "pool_unpaused"
 
 	AttributeValueCategory = ModuleName
 	AttributeKeyPoolId     = "pool_id"
 	AttributeKeyTokensIn   = "tokens_in"
 	AttributeKeyTokensOut  = "tokens_out"
+	AttributeKeyReason     = "reason"
 )
 
 func EmitSwapEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
@@ -36,6 +39,27 @@ func EmitRemoveLiquidityEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uin
 	})
 }
 
+func EmitPoolPausedEvent(ctx sdk.Context, poolId uint64, reason string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			TypeEvtPoolPaused,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(AttributeKeyReason, reason),
+		),
+	})
+}
+
+func EmitPoolUnpausedEvent(ctx sdk.Context, poolId uint64) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			TypeEvtPoolUnpaused,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		),
+	})
+}
+
 func NewSwapEvent(sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) sdk.Event {
 	return sdk.NewEvent(
 		TypeEvtTokenSwapped,
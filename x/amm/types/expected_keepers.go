@@ -26,6 +26,7 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	// Methods imported from bank should be defined here
 }
 
@@ -33,6 +34,7 @@ type BankKeeper interface {
 type OracleKeeper interface {
 	GetAssetPrice(ctx sdk.Context, asset string) (oracletypes.Price, bool)
 	GetAssetPriceFromDenom(ctx sdk.Context, denom string) sdk.Dec
+	GetAssetPriceHistoryFromDenom(ctx sdk.Context, denom string, since uint64, limit int) []sdk.Dec
 	GetPriceFeeder(ctx sdk.Context, feeder string) (val oracletypes.PriceFeeder, found bool)
 }
 
@@ -42,6 +44,8 @@ type AssetProfileKeeper interface {
 	SetEntry(ctx sdk.Context, entry atypes.Entry)
 	// GetEntry returns a entry from its index
 	GetEntry(ctx sdk.Context, baseDenom string) (val atypes.Entry, found bool)
+	// GetEntryByDenom returns a entry from its denom value
+	GetEntryByDenom(ctx sdk.Context, denom string) (val atypes.Entry, found bool)
 }
 
 // AccountedPoolKeeper defines the expected interfaces
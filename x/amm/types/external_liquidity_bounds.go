@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks the ratios are set and the min ratio doesn't exceed a non zero max ratio
+func (b ExternalLiquidityBounds) Validate() error {
+	if b.MinRatio.IsNil() || b.MinRatio.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidExternalLiquidity, "invalid min ratio")
+	}
+	if b.MaxRatio.IsNil() || b.MaxRatio.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidExternalLiquidity, "invalid max ratio")
+	}
+	if b.MaxRatio.IsPositive() && b.MinRatio.GT(b.MaxRatio) {
+		return sdkerrors.Wrapf(ErrInvalidExternalLiquidity, "min ratio %s is greater than max ratio %s", b.MinRatio, b.MaxRatio)
+	}
+	return nil
+}
+
+// Contains returns true when the ratio is within the bounds
+func (b ExternalLiquidityBounds) Contains(ratio sdk.Dec) bool {
+	if ratio.LT(b.MinRatio) {
+		return false
+	}
+	return b.MaxRatio.IsZero() || ratio.LTE(b.MaxRatio)
+}
@@ -0,0 +1,613 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: elys/amm/flash_swap.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FlashSwapLoan is an outstanding flash swap that must be repaid within the same tx
+type FlashSwapLoan struct {
+	Sender             string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	PoolId             uint64                                 `protobuf:"varint,2,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	TokenIn            types.Coin                             `protobuf:"bytes,3,opt,name=tokenIn,proto3" json:"tokenIn"`
+	TokenOut           types.Coin                             `protobuf:"bytes,4,opt,name=tokenOut,proto3" json:"tokenOut"`
+	SwapFee            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swapFee"`
+	WeightBalanceBonus github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=weightBalanceBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weightBalanceBonus"`
+	Slippage           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=slippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slippage"`
+}
+
+func (m *FlashSwapLoan) Reset()         { *m = FlashSwapLoan{} }
+func (m *FlashSwapLoan) String() string { return proto.CompactTextString(m) }
+func (*FlashSwapLoan) ProtoMessage()    {}
+func (*FlashSwapLoan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd89426f76041504, []int{0}
+}
+func (m *FlashSwapLoan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlashSwapLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlashSwapLoan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlashSwapLoan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlashSwapLoan.Merge(m, src)
+}
+func (m *FlashSwapLoan) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlashSwapLoan) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlashSwapLoan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlashSwapLoan proto.InternalMessageInfo
+
+func (m *FlashSwapLoan) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *FlashSwapLoan) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *FlashSwapLoan) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *FlashSwapLoan) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*FlashSwapLoan)(nil), "elys.amm.FlashSwapLoan")
+}
+
+func init() { proto.RegisterFile("elys/amm/flash_swap.proto", fileDescriptor_fd89426f76041504) }
+
+var fileDescriptor_fd89426f76041504 = []byte{
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x3f, 0x4f, 0xfa, 0x40,
+	0x18, 0xc7, 0xdb, 0x1f, 0xfc, 0x00, 0xcf, 0xb8, 0x5c, 0x8c, 0x29, 0x0c, 0x85, 0x38, 0x98, 0x2e,
+	0xdc, 0x05, 0x9d, 0x8c, 0x5b, 0x35, 0x44, 0x8c, 0x89, 0x49, 0xdd, 0x1c, 0x34, 0xd7, 0x72, 0x96,
+	0x86, 0xf6, 0x9e, 0x86, 0x3b, 0xac, 0xbc, 0x0b, 0xdf, 0x88, 0xef, 0x83, 0x91, 0xd1, 0x38, 0x10,
+	0x03, 0x6f, 0xc4, 0x5c, 0x5b, 0x88, 0x83, 0x83, 0x61, 0xea, 0xf3, 0xef, 0xfb, 0xf9, 0xf6, 0xee,
+	0x1e, 0xd4, 0xe4, 0xf1, 0x4c, 0x52, 0x96, 0x24, 0xf4, 0x39, 0x66, 0x72, 0xf4, 0x24, 0x33, 0x96,
+	0x92, 0x74, 0x02, 0x0a, 0x70, 0x43, 0xb7, 0x08, 0x4b, 0x92, 0xd6, 0x61, 0x08, 0x21, 0xe4, 0x45,
+	0xaa, 0xa3, 0xa2, 0xdf, 0xb2, 0x03, 0x90, 0x09, 0x48, 0xea, 0x33, 0xc9, 0xe9, 0x4b, 0xcf, 0xe7,
+	0x8a, 0xf5, 0x68, 0x00, 0x91, 0x28, 0xfa, 0xc7, 0xef, 0x15, 0x74, 0xd0, 0xd7, 0xd0, 0xfb, 0x8c,
+	0xa5, 0xb7, 0xc0, 0x04, 0x3e, 0x42, 0x35, 0xc9, 0xc5, 0x90, 0x4f, 0x2c, 0xb3, 0x63, 0x3a, 0x7b,
+	0x5e, 0x99, 0xe9, 0x7a, 0x0a, 0x10, 0x0f, 0x86, 0xd6, 0xbf, 0x8e, 0xe9, 0x54, 0xbd, 0x32, 0xc3,
+	0xe7, 0xa8, 0xae, 0x60, 0xcc, 0xc5, 0x40, 0x58, 0x95, 0x8e, 0xe9, 0xec, 0x9f, 0x36, 0x49, 0xe1,
+	0x49, 0xb4, 0x27, 0x29, 0x3d, 0xc9, 0x25, 0x44, 0xc2, 0xad, 0xce, 0x97, 0x6d, 0xc3, 0xdb, 0xcc,
+	0xe3, 0x0b, 0xd4, 0xc8, 0xc3, 0xbb, 0xa9, 0xb2, 0xaa, 0x7f, 0xd3, 0x6e, 0x05, 0xf8, 0x1a, 0xd5,
+	0xf5, 0x3d, 0xf4, 0x39, 0xb7, 0xfe, 0xeb, 0x1f, 0x75, 0x89, 0x1e, 0xf8, 0x5c, 0xb6, 0x4f, 0xc2,
+	0x48, 0x8d, 0xa6, 0x3e, 0x09, 0x20, 0xa1, 0xe5, 0xe9, 0x8b, 0x4f, 0x57, 0x0e, 0xc7, 0x54, 0xcd,
+	0x52, 0x2e, 0xc9, 0x15, 0x0f, 0xbc, 0x8d, 0x1c, 0x3f, 0x22, 0x9c, 0xf1, 0x28, 0x1c, 0x29, 0x97,
+	0xc5, 0x4c, 0x04, 0xdc, 0x05, 0x31, 0x95, 0x56, 0x6d, 0x27, 0xe8, 0x2f, 0x24, 0x7c, 0x83, 0x1a,
+	0x32, 0x8e, 0xd2, 0x94, 0x85, 0xdc, 0xaa, 0xef, 0x44, 0xdd, 0xea, 0x5d, 0x77, 0xbe, 0xb2, 0xcd,
+	0xc5, 0xca, 0x36, 0xbf, 0x56, 0xb6, 0xf9, 0xb6, 0xb6, 0x8d, 0xc5, 0xda, 0x36, 0x3e, 0xd6, 0xb6,
+	0xf1, 0xe0, 0xfc, 0x60, 0xe9, 0xa5, 0xe8, 0x0a, 0xae, 0x32, 0x98, 0x8c, 0xf3, 0x84, 0xbe, 0xe6,
+	0xeb, 0x93, 0x13, 0xfd, 0x5a, 0xfe, 0xf4, 0x67, 0xdf, 0x03, 0x00, 0x7c, 0xe6, 0xd7, 0x44, 0x57,
+	0x02, 0x00, 0x00,
+}
+
+func (m *FlashSwapLoan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlashSwapLoan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlashSwapLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Slippage.Size()
+		i -= size
+		if _, err := m.Slippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFlashSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.WeightBalanceBonus.Size()
+		i -= size
+		if _, err := m.WeightBalanceBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFlashSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFlashSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFlashSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFlashSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintFlashSwap(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintFlashSwap(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFlashSwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFlashSwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FlashSwapLoan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovFlashSwap(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovFlashSwap(uint64(m.PoolId))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovFlashSwap(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovFlashSwap(uint64(l))
+	l = m.SwapFee.Size()
+	n += 1 + l + sovFlashSwap(uint64(l))
+	l = m.WeightBalanceBonus.Size()
+	n += 1 + l + sovFlashSwap(uint64(l))
+	l = m.Slippage.Size()
+	n += 1 + l + sovFlashSwap(uint64(l))
+	return n
+}
+
+func sovFlashSwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFlashSwap(x uint64) (n int) {
+	return sovFlashSwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FlashSwapLoan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFlashSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlashSwapLoan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlashSwapLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlashSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlashSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFlashSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlashSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlashSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFlashSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFlashSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlashSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFlashSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFlashSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlashSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlashSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFlashSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightBalanceBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlashSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlashSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFlashSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightBalanceBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlashSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlashSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFlashSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFlashSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFlashSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFlashSwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFlashSwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFlashSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFlashSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFlashSwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFlashSwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFlashSwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFlashSwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFlashSwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFlashSwap = fmt.Errorf("proto: unexpected end of group")
+)
@@ -40,6 +40,11 @@ func (gs GenesisState) Validate() error {
 		}
 		denomLiquidityIndexMap[index] = struct{}{}
 	}
+	for _, bounds := range gs.ExternalLiquidityBounds {
+		if err := bounds.Validate(); err != nil {
+			return err
+		}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
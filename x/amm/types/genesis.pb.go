@@ -25,10 +25,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the amm module's genesis state.
 type GenesisState struct {
-	Params             Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PoolList           []Pool                    `protobuf:"bytes,2,rep,name=poolList,proto3" json:"poolList"`
-	DenomLiquidityList []DenomLiquidity          `protobuf:"bytes,3,rep,name=denomLiquidityList,proto3" json:"denomLiquidityList"`
-	SlippageTracks     []OraclePoolSlippageTrack `protobuf:"bytes,4,rep,name=slippageTracks,proto3" json:"slippageTracks"`
+	Params                             Params                        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PoolList                           []Pool                        `protobuf:"bytes,2,rep,name=poolList,proto3" json:"poolList"`
+	DenomLiquidityList                 []DenomLiquidity              `protobuf:"bytes,3,rep,name=denomLiquidityList,proto3" json:"denomLiquidityList"`
+	SlippageTracks                     []OraclePoolSlippageTrack     `protobuf:"bytes,4,rep,name=slippageTracks,proto3" json:"slippageTracks"`
+	PoolStatsBuckets                   []PoolStatsBucket             `protobuf:"bytes,5,rep,name=poolStatsBuckets,proto3" json:"poolStatsBuckets"`
+	PausedPools                        []PausedPool                  `protobuf:"bytes,6,rep,name=pausedPools,proto3" json:"pausedPools"`
+	TwapRecords                        []PoolTwapRecord              `protobuf:"bytes,7,rep,name=twapRecords,proto3" json:"twapRecords"`
+	ExternalLiquiditySubmissions       []ExternalLiquiditySubmission `protobuf:"bytes,8,rep,name=externalLiquiditySubmissions,proto3" json:"externalLiquiditySubmissions"`
+	ExternalLiquidityBounds            []ExternalLiquidityBounds     `protobuf:"bytes,9,rep,name=externalLiquidityBounds,proto3" json:"externalLiquidityBounds"`
+	ExternalLiquidityLatestSubmissions []ExternalLiquiditySubmission `protobuf:"bytes,10,rep,name=externalLiquidityLatestSubmissions,proto3" json:"externalLiquidityLatestSubmissions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +98,48 @@ func (m *GenesisState) GetSlippageTracks() []OraclePoolSlippageTrack {
 	return nil
 }
 
+func (m *GenesisState) GetPoolStatsBuckets() []PoolStatsBucket {
+	if m != nil {
+		return m.PoolStatsBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedPools() []PausedPool {
+	if m != nil {
+		return m.PausedPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTwapRecords() []PoolTwapRecord {
+	if m != nil {
+		return m.TwapRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExternalLiquiditySubmissions() []ExternalLiquiditySubmission {
+	if m != nil {
+		return m.ExternalLiquiditySubmissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExternalLiquidityBounds() []ExternalLiquidityBounds {
+	if m != nil {
+		return m.ExternalLiquidityBounds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExternalLiquidityLatestSubmissions() []ExternalLiquiditySubmission {
+	if m != nil {
+		return m.ExternalLiquidityLatestSubmissions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "elys.amm.GenesisState")
 }
@@ -99,27 +147,36 @@ func init() {
 func init() { proto.RegisterFile("elys/amm/genesis.proto", fileDescriptor_836f20eb8daba51a) }
 
 var fileDescriptor_836f20eb8daba51a = []byte{
-	// 311 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0x93, 0xb6, 0xaa, 0x2a, 0xf7, 0x53, 0xf5, 0xc9, 0xfc, 0x51, 0xd4, 0xc1, 0x14, 0xa6,
-	0x2e, 0x38, 0xa8, 0xbc, 0x41, 0x84, 0xc4, 0x52, 0x51, 0x44, 0x99, 0x58, 0x90, 0x9b, 0x5a, 0xc1,
-	0xaa, 0x1d, 0x87, 0xd8, 0x15, 0xe4, 0x2d, 0xe0, 0xad, 0x3a, 0x76, 0x64, 0x42, 0x28, 0x79, 0x11,
-	0x14, 0xc7, 0x4d, 0x0a, 0x62, 0xb3, 0xcf, 0x3d, 0xbf, 0x73, 0x8f, 0x2e, 0x38, 0xa6, 0x3c, 0x53,
-	0x3e, 0x11, 0xc2, 0x8f, 0x68, 0x4c, 0x15, 0x53, 0x38, 0x49, 0xa5, 0x96, 0xb0, 0x57, 0xea, 0x98,
-	0x08, 0x31, 0x3c, 0x8c, 0x64, 0x24, 0x8d, 0xe8, 0x97, 0xaf, 0x6a, 0x3e, 0x3c, 0xaa, 0xb9, 0x84,
-	0xa4, 0x44, 0x58, 0x6c, 0x78, 0xd0, 0xc8, 0x52, 0x72, 0x2b, 0xa2, 0x5a, 0x5c, 0xd2, 0x58, 0x8a,
-	0x47, 0xce, 0x9e, 0xd7, 0x6c, 0xc9, 0x74, 0x56, 0xcd, 0xcf, 0xde, 0x5b, 0xe0, 0xdf, 0x75, 0xb5,
-	0x7d, 0xae, 0x89, 0xa6, 0x10, 0x83, 0x6e, 0x95, 0xea, 0xb9, 0x23, 0x77, 0xdc, 0x9f, 0xfc, 0xc7,
-	0xbb, 0x36, 0xf8, 0xd6, 0xe8, 0x41, 0x67, 0xf3, 0x79, 0xe2, 0xdc, 0x59, 0x17, 0xbc, 0x00, 0xbd,
-	0x72, 0xdd, 0x94, 0x29, 0xed, 0xb5, 0x46, 0xed, 0x71, 0x7f, 0x32, 0xd8, 0x23, 0xa4, 0xe4, 0xd6,
-	0x5f, 0xbb, 0xe0, 0x0d, 0x80, 0xa6, 0xcb, 0x74, 0x57, 0xc5, 0xb0, 0x6d, 0xc3, 0x7a, 0x0d, 0x7b,
-	0xf5, 0xc3, 0x63, 0x53, 0xfe, 0x20, 0xe1, 0x0c, 0x0c, 0x14, 0x67, 0x49, 0x42, 0x22, 0x7a, 0x9f,
-	0x92, 0x70, 0xa5, 0xbc, 0x8e, 0xc9, 0x3a, 0x6d, 0xb2, 0x66, 0x29, 0x09, 0x39, 0x2d, 0xdb, 0xcc,
-	0xf7, 0x9d, 0x36, 0xf4, 0x17, 0x1e, 0x04, 0x9b, 0x1c, 0xb9, 0xdb, 0x1c, 0xb9, 0x5f, 0x39, 0x72,
-	0xdf, 0x0a, 0xe4, 0x6c, 0x0b, 0xe4, 0x7c, 0x14, 0xc8, 0x79, 0x18, 0x47, 0x4c, 0x3f, 0xad, 0x17,
-	0x38, 0x94, 0xc2, 0x2f, 0xc3, 0xcf, 0x63, 0xaa, 0x5f, 0x64, 0xba, 0x32, 0x1f, 0xff, 0xd5, 0xdc,
-	0x59, 0x67, 0x09, 0x55, 0x8b, 0xae, 0x39, 0xef, 0xe5, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x78,
-	0x06, 0xdc, 0x49, 0xe4, 0x01, 0x00, 0x00,
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x36, 0x42, 0x71, 0xd0, 0x34, 0x99, 0x01, 0xa6, 0x42, 0x61, 0x4c, 0x42, 0xea,
+	0x85, 0x04, 0x8d, 0x2b, 0x07, 0x14, 0x31, 0x71, 0xa0, 0x62, 0x68, 0xdd, 0x89, 0x0b, 0x72, 0x13,
+	0x2b, 0x58, 0x8d, 0xe3, 0x90, 0xd7, 0xd1, 0x56, 0xf1, 0x25, 0xf8, 0x26, 0x7c, 0x8d, 0x1d, 0x77,
+	0xe4, 0x84, 0x50, 0xfb, 0x45, 0x90, 0x1d, 0xe7, 0xcf, 0xda, 0x0d, 0xc1, 0xad, 0x7d, 0xde, 0xe7,
+	0xf9, 0xbd, 0x7f, 0x22, 0xa3, 0x87, 0x2c, 0x5b, 0x40, 0x48, 0x85, 0x08, 0x53, 0x96, 0x33, 0xe0,
+	0x10, 0x14, 0xa5, 0x54, 0x12, 0x0f, 0xb5, 0x1e, 0x50, 0x21, 0x46, 0x7b, 0xa9, 0x4c, 0xa5, 0x11,
+	0x43, 0xfd, 0xab, 0xae, 0x8f, 0x1e, 0xb4, 0xb9, 0x82, 0x96, 0x54, 0xd8, 0xd8, 0xe8, 0x7e, 0x27,
+	0x4b, 0x99, 0x59, 0xd1, 0x6f, 0xc5, 0x84, 0xe5, 0x52, 0x7c, 0xce, 0xf8, 0xd7, 0x8a, 0x27, 0x5c,
+	0x2d, 0xea, 0xfa, 0xc1, 0x0f, 0x17, 0xdd, 0x7b, 0x57, 0x77, 0x9f, 0x2a, 0xaa, 0x18, 0x0e, 0x90,
+	0x5b, 0x53, 0x89, 0xb3, 0xef, 0x8c, 0xbd, 0xc3, 0xdd, 0xa0, 0x99, 0x26, 0xf8, 0x68, 0xf4, 0x68,
+	0xfb, 0xe2, 0xd7, 0xd3, 0xc1, 0x89, 0x75, 0xe1, 0x97, 0x68, 0xa8, 0xdb, 0x4d, 0x38, 0x28, 0x72,
+	0x6b, 0x7f, 0x6b, 0xec, 0x1d, 0xee, 0xf4, 0x12, 0x52, 0x66, 0xd6, 0xdf, 0xba, 0xf0, 0x07, 0x84,
+	0xcd, 0x2c, 0x93, 0x66, 0x14, 0x93, 0xdd, 0x32, 0x59, 0xd2, 0x65, 0xdf, 0x5e, 0xf1, 0x58, 0xca,
+	0x35, 0x49, 0x7c, 0x8c, 0x76, 0x20, 0xe3, 0x45, 0x41, 0x53, 0x76, 0x5a, 0xd2, 0x78, 0x0e, 0x64,
+	0xdb, 0xb0, 0x9e, 0x75, 0xac, 0xe3, 0x92, 0xc6, 0x19, 0xd3, 0xd3, 0x4c, 0xfb, 0x4e, 0x0b, 0x5d,
+	0x8b, 0xe3, 0xf7, 0x68, 0x57, 0x0f, 0xab, 0xef, 0x01, 0x51, 0x15, 0xcf, 0x99, 0x02, 0x72, 0xdb,
+	0x20, 0x1f, 0x5f, 0x5d, 0xad, 0xe7, 0xb0, 0xa8, 0x8d, 0x20, 0x7e, 0x8d, 0xbc, 0x82, 0x56, 0xc0,
+	0x12, 0x1d, 0x00, 0xe2, 0x1a, 0xce, 0x5e, 0xff, 0xa8, 0x4d, 0xd1, 0x22, 0xfa, 0x76, 0xfc, 0x06,
+	0x79, 0xea, 0x8c, 0x16, 0x27, 0x2c, 0x96, 0x65, 0x02, 0xe4, 0xce, 0xfa, 0x91, 0xb4, 0xeb, 0xb4,
+	0x35, 0x34, 0x84, 0x5e, 0x04, 0x4b, 0xf4, 0x84, 0x9d, 0x2b, 0x56, 0xe6, 0x34, 0x6b, 0xcf, 0x36,
+	0xad, 0x66, 0x82, 0x03, 0x70, 0x99, 0x03, 0x19, 0x1a, 0xe4, 0xf3, 0x0e, 0x79, 0x74, 0xb3, 0xdb,
+	0xf2, 0xff, 0x0a, 0xc4, 0x14, 0x3d, 0xda, 0xa8, 0x47, 0xb2, 0xca, 0x13, 0x20, 0x77, 0xd7, 0xbf,
+	0xcb, 0xd1, 0xf5, 0x46, 0xdb, 0xe7, 0x26, 0x0e, 0xfe, 0x86, 0x0e, 0x36, 0x4a, 0x13, 0xaa, 0x18,
+	0xa8, 0xfe, 0x66, 0xe8, 0xff, 0x37, 0xfb, 0x07, 0x6c, 0x14, 0x5d, 0x2c, 0x7d, 0xe7, 0x72, 0xe9,
+	0x3b, 0xbf, 0x97, 0xbe, 0xf3, 0x7d, 0xe5, 0x0f, 0x2e, 0x57, 0xfe, 0xe0, 0xe7, 0xca, 0x1f, 0x7c,
+	0x1a, 0xa7, 0x5c, 0x7d, 0xa9, 0x66, 0x41, 0x2c, 0x45, 0xa8, 0x9b, 0xbe, 0xc8, 0x99, 0x3a, 0x93,
+	0xe5, 0xdc, 0xfc, 0x09, 0xcf, 0xcd, 0x2b, 0x54, 0x8b, 0x82, 0xc1, 0xcc, 0x35, 0x8f, 0xef, 0xd5,
+	0x9f, 0x01, 0x00, 0x96, 0xca, 0x46, 0x76, 0x02, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -142,6 +199,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExternalLiquidityLatestSubmissions) > 0 {
+		for iNdEx := len(m.ExternalLiquidityLatestSubmissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExternalLiquidityLatestSubmissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ExternalLiquidityBounds) > 0 {
+		for iNdEx := len(m.ExternalLiquidityBounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExternalLiquidityBounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ExternalLiquiditySubmissions) > 0 {
+		for iNdEx := len(m.ExternalLiquiditySubmissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExternalLiquiditySubmissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TwapRecords) > 0 {
+		for iNdEx := len(m.TwapRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PausedPools) > 0 {
+		for iNdEx := len(m.PausedPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PoolStatsBuckets) > 0 {
+		for iNdEx := len(m.PoolStatsBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolStatsBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.SlippageTracks) > 0 {
 		for iNdEx := len(m.SlippageTracks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -234,6 +375,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PoolStatsBuckets) > 0 {
+		for _, e := range m.PoolStatsBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedPools) > 0 {
+		for _, e := range m.PausedPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TwapRecords) > 0 {
+		for _, e := range m.TwapRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExternalLiquiditySubmissions) > 0 {
+		for _, e := range m.ExternalLiquiditySubmissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExternalLiquidityBounds) > 0 {
+		for _, e := range m.ExternalLiquidityBounds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExternalLiquidityLatestSubmissions) > 0 {
+		for _, e := range m.ExternalLiquidityLatestSubmissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -407,6 +584,210 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolStatsBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolStatsBuckets = append(m.PoolStatsBuckets, PoolStatsBucket{})
+			if err := m.PoolStatsBuckets[len(m.PoolStatsBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedPools = append(m.PausedPools, PausedPool{})
+			if err := m.PausedPools[len(m.PausedPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapRecords = append(m.TwapRecords, PoolTwapRecord{})
+			if err := m.TwapRecords[len(m.TwapRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalLiquiditySubmissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalLiquiditySubmissions = append(m.ExternalLiquiditySubmissions, ExternalLiquiditySubmission{})
+			if err := m.ExternalLiquiditySubmissions[len(m.ExternalLiquiditySubmissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalLiquidityBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalLiquidityBounds = append(m.ExternalLiquidityBounds, ExternalLiquidityBounds{})
+			if err := m.ExternalLiquidityBounds[len(m.ExternalLiquidityBounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalLiquidityLatestSubmissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalLiquidityLatestSubmissions = append(m.ExternalLiquidityLatestSubmissions, ExternalLiquiditySubmission{})
+			if err := m.ExternalLiquidityLatestSubmissions[len(m.ExternalLiquidityLatestSubmissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
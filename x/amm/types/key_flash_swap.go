@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TFlashSwapLoanKey = "flash-swap/loan/"
+	TFlashSwapPoolKey = "flash-swap/pool/"
+	TTouchedPoolKey   = "pool/touched/"
+)
+
+// TFlashSwapLoanKeyFor returns the transient store key of the flash swap of a sender on a pool
+func TFlashSwapLoanKeyFor(poolId uint64, sender sdk.AccAddress) []byte {
+	return append(sdk.Uint64ToBigEndian(poolId), sender.Bytes()...)
+}
@@ -11,6 +11,22 @@ const (
 	PoolKeyPrefix = "Pool/value/"
 	// OraclePoolSlippageTrackPrefix is the prefix to retrieve slippage tracked
 	OraclePoolSlippageTrackPrefix = "OraclePool/slippage/track/value/"
+	// PoolStatsBucketPrefix is the prefix to retrieve pool stats buckets
+	PoolStatsBucketPrefix = "Pool/stats/bucket/value/"
+	// PoolStatsBucketTimePrefix is the prefix to retrieve pool stats buckets ordered by timestamp
+	PoolStatsBucketTimePrefix = "Pool/stats/bucket/time/"
+	// PausedPoolPrefix is the prefix to retrieve paused pools
+	PausedPoolPrefix = "Pool/paused/value/"
+	// PoolTwapRecordPrefix is the prefix to retrieve pool twap records
+	PoolTwapRecordPrefix = "Pool/twap/record/value/"
+	// PoolTwapRecordPrunePrefix is the prefix to retrieve pool twap records ordered by the time they can be pruned
+	PoolTwapRecordPrunePrefix = "Pool/twap/record/prune/"
+	// ExternalLiquiditySubmissionPrefix is the prefix to retrieve external liquidity submissions
+	ExternalLiquiditySubmissionPrefix = "Pool/external-liquidity/submission/value/"
+	// ExternalLiquidityLatestSubmissionPrefix is the prefix to retrieve the latest external liquidity submission of each feeder
+	ExternalLiquidityLatestSubmissionPrefix = "Pool/external-liquidity/submission/latest/"
+	// ExternalLiquidityBoundsPrefix is the prefix to retrieve external liquidity bounds
+	ExternalLiquidityBoundsPrefix = "Pool/external-liquidity/bounds/value/"
 )
 
 // PoolKey returns the store key to retrieve a Pool from the index fields
@@ -28,3 +44,36 @@ func PoolKey(poolId uint64) []byte {
 func OraclePoolSlippageTrackKey(poolId uint64, timestamp uint64) []byte {
 	return append(sdk.Uint64ToBigEndian(poolId), sdk.Uint64ToBigEndian(timestamp)...)
 }
+
+func PoolStatsBucketKey(poolId uint64, timestamp uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(poolId), sdk.Uint64ToBigEndian(timestamp)...)
+}
+
+// PoolStatsBucketTimeKey returns the key indexing a pool stats bucket by its timestamp
+func PoolStatsBucketTimeKey(timestamp uint64, poolId uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(timestamp), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func PoolTwapRecordKey(poolId uint64, timestamp uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(poolId), sdk.Uint64ToBigEndian(timestamp)...)
+}
+
+// PoolTwapRecordPruneKey returns the key indexing a pool twap record by the timestamp of the next record of
+// the pool, the record can be pruned once that next record is out of the history window
+func PoolTwapRecordPruneKey(nextTimestamp uint64, poolId uint64, timestamp uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(nextTimestamp), PoolTwapRecordKey(poolId, timestamp)...)
+}
+
+// ExternalLiquiditySubmissionPoolPrefix returns the store prefix of the submissions of a pool
+func ExternalLiquiditySubmissionPoolPrefix(poolId uint64) []byte {
+	return sdk.Uint64ToBigEndian(poolId)
+}
+
+func ExternalLiquiditySubmissionKey(poolId uint64, timestamp uint64, feeder sdk.AccAddress) []byte {
+	key := append(ExternalLiquiditySubmissionPoolPrefix(poolId), sdk.Uint64ToBigEndian(timestamp)...)
+	return append(key, feeder.Bytes()...)
+}
+
+func ExternalLiquidityLatestSubmissionKey(poolId uint64, feeder sdk.AccAddress) []byte {
+	return append(ExternalLiquiditySubmissionPoolPrefix(poolId), feeder.Bytes()...)
+}
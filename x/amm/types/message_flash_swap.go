@@ -0,0 +1,66 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgFlashSwap = "flash_swap"
+
+var _ sdk.Msg = &MsgFlashSwap{}
+
+func NewMsgFlashSwap(sender string, poolId uint64, tokenOut sdk.Coin, tokenInDenom string, tokenInMaxAmount sdk.Int) *MsgFlashSwap {
+	return &MsgFlashSwap{
+		Sender:           sender,
+		PoolId:           poolId,
+		TokenOut:         tokenOut,
+		TokenInDenom:     tokenInDenom,
+		TokenInMaxAmount: tokenInMaxAmount,
+	}
+}
+
+func (msg *MsgFlashSwap) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFlashSwap) Type() string {
+	return TypeMsgFlashSwap
+}
+
+func (msg *MsgFlashSwap) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgFlashSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFlashSwap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenOut.IsValid() || !msg.TokenOut.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid token out (%s)", msg.TokenOut)
+	}
+
+	err = sdk.ValidateDenom(msg.TokenInDenom)
+	if err != nil {
+		return err
+	}
+
+	if msg.TokenInDenom == msg.TokenOut.Denom {
+		return sdkerrors.Wrapf(ErrInvalidFlashSwap, "cannot trade the same denomination in and out")
+	}
+
+	if msg.TokenInMaxAmount.IsNil() || !msg.TokenInMaxAmount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidFlashSwap, "token in max amount must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgLockShares = "lock_shares"
+
+var _ sdk.Msg = &MsgLockShares{}
+
+func NewMsgLockShares(sender string, poolId uint64, amount sdk.Int, duration uint64) *MsgLockShares {
+	return &MsgLockShares{
+		Sender:   sender,
+		PoolId:   poolId,
+		Amount:   amount,
+		Duration: duration,
+	}
+}
+
+func (msg *MsgLockShares) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgLockShares) Type() string {
+	return TypeMsgLockShares
+}
+
+func (msg *MsgLockShares) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgLockShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgLockShares) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+
+	if msg.Duration == 0 {
+		return sdkerrors.Wrapf(ErrInvalidShareLockDuration, "duration must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPausePool = "pause_pool"
+
+var _ sdk.Msg = &MsgPausePool{}
+
+func NewMsgPausePool(authority string, poolId uint64, reason string) *MsgPausePool {
+	return &MsgPausePool{
+		Authority: authority,
+		PoolId:    poolId,
+		Reason:    reason,
+	}
+}
+
+func (msg *MsgPausePool) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPausePool) Type() string {
+	return TypeMsgPausePool
+}
+
+func (msg *MsgPausePool) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgPausePool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPausePool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRepayFlashSwap = "repay_flash_swap"
+
+var _ sdk.Msg = &MsgRepayFlashSwap{}
+
+func NewMsgRepayFlashSwap(sender string, poolId uint64) *MsgRepayFlashSwap {
+	return &MsgRepayFlashSwap{
+		Sender: sender,
+		PoolId: poolId,
+	}
+}
+
+func (msg *MsgRepayFlashSwap) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRepayFlashSwap) Type() string {
+	return TypeMsgRepayFlashSwap
+}
+
+func (msg *MsgRepayFlashSwap) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgRepayFlashSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRepayFlashSwap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetExternalLiquidityBounds = "set_external_liquidity_bounds"
+
+var _ sdk.Msg = &MsgSetExternalLiquidityBounds{}
+
+func NewMsgSetExternalLiquidityBounds(authority string, poolId uint64, minRatio sdk.Dec, maxRatio sdk.Dec) *MsgSetExternalLiquidityBounds {
+	return &MsgSetExternalLiquidityBounds{
+		Authority: authority,
+		PoolId:    poolId,
+		MinRatio:  minRatio,
+		MaxRatio:  maxRatio,
+	}
+}
+
+func (msg *MsgSetExternalLiquidityBounds) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetExternalLiquidityBounds) Type() string {
+	return TypeMsgSetExternalLiquidityBounds
+}
+
+func (msg *MsgSetExternalLiquidityBounds) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetExternalLiquidityBounds) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetExternalLiquidityBounds) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	bounds := ExternalLiquidityBounds{PoolId: msg.PoolId, MinRatio: msg.MinRatio, MaxRatio: msg.MaxRatio}
+	return bounds.Validate()
+}
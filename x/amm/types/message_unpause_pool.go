@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUnpausePool = "unpause_pool"
+
+var _ sdk.Msg = &MsgUnpausePool{}
+
+func NewMsgUnpausePool(authority string, poolId uint64) *MsgUnpausePool {
+	return &MsgUnpausePool{
+		Authority: authority,
+		PoolId:    poolId,
+	}
+}
+
+func (msg *MsgUnpausePool) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnpausePool) Type() string {
+	return TypeMsgUnpausePool
+}
+
+func (msg *MsgUnpausePool) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUnpausePool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnpausePool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return nil
+}
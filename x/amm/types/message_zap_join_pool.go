@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgZapJoinPool = "zap_join_pool"
+
+var _ sdk.Msg = &MsgZapJoinPool{}
+
+func NewMsgZapJoinPool(sender string, poolId uint64, tokenIn sdk.Coin, shareAmountOutMin sdk.Int) *MsgZapJoinPool {
+	return &MsgZapJoinPool{
+		Sender:            sender,
+		PoolId:            poolId,
+		TokenIn:           tokenIn,
+		ShareAmountOutMin: shareAmountOutMin,
+	}
+}
+
+func (msg *MsgZapJoinPool) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgZapJoinPool) Type() string {
+	return TypeMsgZapJoinPool
+}
+
+func (msg *MsgZapJoinPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgZapJoinPool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgZapJoinPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid token in (%s)", msg.TokenIn)
+	}
+
+	if msg.ShareAmountOutMin.IsNil() || msg.ShareAmountOutMin.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidMathApprox, "share amount out min must not be negative")
+	}
+	return nil
+}
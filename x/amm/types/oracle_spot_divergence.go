@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OracleSpotDivergence returns the largest relative difference between the pool spot price,
+// computed from balances and target weights, and the oracle price over the pool asset pairs.
+// Zero is returned when an asset has no oracle price.
+func (p Pool) OracleSpotDivergence(ctx sdk.Context, oracle OracleKeeper, accountedPool AccountedPoolKeeper) (sdk.Dec, error) {
+	if len(p.PoolAssets) < 2 {
+		return sdk.ZeroDec(), nil
+	}
+
+	// spot price from balances and target weights, oracle weights would reflect the oracle price
+	balancerPool := p
+	balancerPool.PoolParams.UseOracle = false
+
+	baseDenom := p.PoolAssets[0].Token.Denom
+	basePrice := oracle.GetAssetPriceFromDenom(ctx, baseDenom)
+	if basePrice.IsZero() {
+		return sdk.ZeroDec(), nil
+	}
+
+	divergence := sdk.ZeroDec()
+	for _, asset := range p.PoolAssets[1:] {
+		assetPrice := oracle.GetAssetPriceFromDenom(ctx, asset.Token.Denom)
+		if assetPrice.IsZero() {
+			return sdk.ZeroDec(), nil
+		}
+		oraclePrice := basePrice.Quo(assetPrice)
+
+		spotPrice, err := balancerPool.SpotPrice(ctx, oracle, nil, baseDenom, asset.Token.Denom, accountedPool)
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+
+		diff := spotPrice.Sub(oraclePrice).Abs().Quo(oraclePrice)
+		if diff.GT(divergence) {
+			divergence = diff
+		}
+	}
+	return divergence, nil
+}
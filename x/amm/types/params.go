@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -13,6 +14,39 @@ var (
 	KeyPoolCreationFee = []byte("PoolCreationFee")
 	// TODO: Determine the default value
 	DefaultPoolCreationFee uint64 = 0
+
+	KeyMaxOracleSpotDivergence     = []byte("MaxOracleSpotDivergence")
+	DefaultMaxOracleSpotDivergence = sdk.ZeroDec() // disabled
+
+	KeyMaxBlockSlippage     = []byte("MaxBlockSlippage")
+	DefaultMaxBlockSlippage = sdk.NewDecWithPrec(5, 2) // 5%
+
+	KeyDynamicSwapFeeFloor     = []byte("DynamicSwapFeeFloor")
+	DefaultDynamicSwapFeeFloor = sdk.ZeroDec()
+
+	KeyDynamicSwapFeeCeiling     = []byte("DynamicSwapFeeCeiling")
+	DefaultDynamicSwapFeeCeiling = sdk.ZeroDec() // disabled
+
+	KeyVolatilityFeeMultiplier     = []byte("VolatilityFeeMultiplier")
+	DefaultVolatilityFeeMultiplier = sdk.NewDecWithPrec(5, 1) // 0.5
+
+	KeySlippageFeeMultiplier     = []byte("SlippageFeeMultiplier")
+	DefaultSlippageFeeMultiplier = sdk.NewDecWithPrec(5, 1) // 0.5
+
+	KeyVolatilityWindow            = []byte("VolatilityWindow")
+	DefaultVolatilityWindow uint64 = 3600 // 1 hour
+
+	KeySwapFeeDiscountTiers     = []byte("SwapFeeDiscountTiers")
+	DefaultSwapFeeDiscountTiers []SwapFeeDiscountTier
+
+	KeyPoolCreationFeeReceiver     = []byte("PoolCreationFeeReceiver")
+	DefaultPoolCreationFeeReceiver = ""
+
+	KeyMaxShareLockDuration            = []byte("MaxShareLockDuration")
+	DefaultMaxShareLockDuration uint64 = 365 * 24 * 3600 // 1 year
+
+	KeyMaxShareLockBoost     = []byte("MaxShareLockBoost")
+	DefaultMaxShareLockBoost = sdk.NewDec(2)
 )
 
 // ParamKeyTable the param key table for launch module
@@ -23,9 +57,31 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams(
 	poolCreationFee uint64,
+	maxOracleSpotDivergence sdk.Dec,
+	maxBlockSlippage sdk.Dec,
+	dynamicSwapFeeFloor sdk.Dec,
+	dynamicSwapFeeCeiling sdk.Dec,
+	volatilityFeeMultiplier sdk.Dec,
+	slippageFeeMultiplier sdk.Dec,
+	volatilityWindow uint64,
+	swapFeeDiscountTiers []SwapFeeDiscountTier,
+	poolCreationFeeReceiver string,
+	maxShareLockDuration uint64,
+	maxShareLockBoost sdk.Dec,
 ) Params {
 	return Params{
-		PoolCreationFee: poolCreationFee,
+		PoolCreationFee:         poolCreationFee,
+		MaxOracleSpotDivergence: maxOracleSpotDivergence,
+		MaxBlockSlippage:        maxBlockSlippage,
+		DynamicSwapFeeFloor:     dynamicSwapFeeFloor,
+		DynamicSwapFeeCeiling:   dynamicSwapFeeCeiling,
+		VolatilityFeeMultiplier: volatilityFeeMultiplier,
+		SlippageFeeMultiplier:   slippageFeeMultiplier,
+		VolatilityWindow:        volatilityWindow,
+		SwapFeeDiscountTiers:    swapFeeDiscountTiers,
+		PoolCreationFeeReceiver: poolCreationFeeReceiver,
+		MaxShareLockDuration:    maxShareLockDuration,
+		MaxShareLockBoost:       maxShareLockBoost,
 	}
 }
 
@@ -33,6 +89,17 @@ func NewParams(
 func DefaultParams() Params {
 	return NewParams(
 		DefaultPoolCreationFee,
+		DefaultMaxOracleSpotDivergence,
+		DefaultMaxBlockSlippage,
+		DefaultDynamicSwapFeeFloor,
+		DefaultDynamicSwapFeeCeiling,
+		DefaultVolatilityFeeMultiplier,
+		DefaultSlippageFeeMultiplier,
+		DefaultVolatilityWindow,
+		DefaultSwapFeeDiscountTiers,
+		DefaultPoolCreationFeeReceiver,
+		DefaultMaxShareLockDuration,
+		DefaultMaxShareLockBoost,
 	)
 }
 
@@ -40,6 +107,17 @@ func DefaultParams() Params {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyMaxOracleSpotDivergence, &p.MaxOracleSpotDivergence, validateCircuitBreakerThreshold),
+		paramtypes.NewParamSetPair(KeyMaxBlockSlippage, &p.MaxBlockSlippage, validateCircuitBreakerThreshold),
+		paramtypes.NewParamSetPair(KeyDynamicSwapFeeFloor, &p.DynamicSwapFeeFloor, validateDynamicSwapFee),
+		paramtypes.NewParamSetPair(KeyDynamicSwapFeeCeiling, &p.DynamicSwapFeeCeiling, validateDynamicSwapFee),
+		paramtypes.NewParamSetPair(KeyVolatilityFeeMultiplier, &p.VolatilityFeeMultiplier, validateFeeMultiplier),
+		paramtypes.NewParamSetPair(KeySlippageFeeMultiplier, &p.SlippageFeeMultiplier, validateFeeMultiplier),
+		paramtypes.NewParamSetPair(KeyVolatilityWindow, &p.VolatilityWindow, validateVolatilityWindow),
+		paramtypes.NewParamSetPair(KeySwapFeeDiscountTiers, &p.SwapFeeDiscountTiers, validateSwapFeeDiscountTiers),
+		paramtypes.NewParamSetPair(KeyPoolCreationFeeReceiver, &p.PoolCreationFeeReceiver, validatePoolCreationFeeReceiver),
+		paramtypes.NewParamSetPair(KeyMaxShareLockDuration, &p.MaxShareLockDuration, validateMaxShareLockDuration),
+		paramtypes.NewParamSetPair(KeyMaxShareLockBoost, &p.MaxShareLockBoost, validateMaxShareLockBoost),
 	}
 }
 
@@ -49,6 +127,54 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateCircuitBreakerThreshold(p.MaxOracleSpotDivergence); err != nil {
+		return err
+	}
+
+	if err := validateCircuitBreakerThreshold(p.MaxBlockSlippage); err != nil {
+		return err
+	}
+
+	if err := validateDynamicSwapFee(p.DynamicSwapFeeFloor); err != nil {
+		return err
+	}
+
+	if err := validateDynamicSwapFee(p.DynamicSwapFeeCeiling); err != nil {
+		return err
+	}
+
+	if !p.DynamicSwapFeeCeiling.IsZero() && p.DynamicSwapFeeFloor.GT(p.DynamicSwapFeeCeiling) {
+		return fmt.Errorf("dynamic swap fee floor %s is greater than ceiling %s", p.DynamicSwapFeeFloor, p.DynamicSwapFeeCeiling)
+	}
+
+	if err := validateFeeMultiplier(p.VolatilityFeeMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateFeeMultiplier(p.SlippageFeeMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateVolatilityWindow(p.VolatilityWindow); err != nil {
+		return err
+	}
+
+	if err := validateSwapFeeDiscountTiers(p.SwapFeeDiscountTiers); err != nil {
+		return err
+	}
+
+	if err := validatePoolCreationFeeReceiver(p.PoolCreationFeeReceiver); err != nil {
+		return err
+	}
+
+	if err := validateMaxShareLockDuration(p.MaxShareLockDuration); err != nil {
+		return err
+	}
+
+	if err := validateMaxShareLockBoost(p.MaxShareLockBoost); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -70,3 +196,151 @@ func validatePoolCreationFee(v interface{}) error {
 
 	return nil
 }
+
+// validateCircuitBreakerThreshold validates the MaxOracleSpotDivergence and MaxBlockSlippage params
+func validateCircuitBreakerThreshold(v interface{}) error {
+	threshold, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if threshold.IsNil() {
+		return fmt.Errorf("threshold must not be nil")
+	}
+	if threshold.IsNegative() {
+		return fmt.Errorf("threshold must not be negative: %s", threshold)
+	}
+
+	return nil
+}
+
+// validateDynamicSwapFee validates the DynamicSwapFeeFloor and DynamicSwapFeeCeiling params
+func validateDynamicSwapFee(v interface{}) error {
+	fee, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if fee.IsNil() {
+		return fmt.Errorf("swap fee must not be nil")
+	}
+	if fee.IsNegative() {
+		return ErrNegativeSwapFee
+	}
+	if fee.GTE(sdk.OneDec()) {
+		return ErrTooMuchSwapFee
+	}
+
+	return nil
+}
+
+// validateFeeMultiplier validates the VolatilityFeeMultiplier and SlippageFeeMultiplier params
+func validateFeeMultiplier(v interface{}) error {
+	multiplier, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if multiplier.IsNil() {
+		return fmt.Errorf("multiplier must not be nil")
+	}
+	if multiplier.IsNegative() {
+		return fmt.Errorf("multiplier must not be negative: %s", multiplier)
+	}
+
+	return nil
+}
+
+// validateVolatilityWindow validates the VolatilityWindow param
+func validateVolatilityWindow(v interface{}) error {
+	window, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if window == 0 {
+		return fmt.Errorf("volatility window must be positive")
+	}
+	if window > MaxVolatilityWindow {
+		return fmt.Errorf("volatility window must not exceed %d seconds", MaxVolatilityWindow)
+	}
+
+	return nil
+}
+
+// validateSwapFeeDiscountTiers validates the SwapFeeDiscountTiers param, tiers must be sorted by
+// increasing committed amount and discount
+func validateSwapFeeDiscountTiers(v interface{}) error {
+	tiers, ok := v.([]SwapFeeDiscountTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	for i, tier := range tiers {
+		if tier.MinCommitted.IsNil() || tier.MinCommitted.IsNegative() {
+			return fmt.Errorf("invalid tier min committed amount: %s", tier.MinCommitted)
+		}
+		if tier.Discount.IsNil() || tier.Discount.IsNegative() || tier.Discount.GTE(sdk.OneDec()) {
+			return fmt.Errorf("tier discount must be in [0, 1): %s", tier.Discount)
+		}
+		if i > 0 {
+			previous := tiers[i-1]
+			if tier.MinCommitted.LTE(previous.MinCommitted) {
+				return fmt.Errorf("tier min committed amounts must be strictly increasing")
+			}
+			if tier.Discount.LT(previous.Discount) {
+				return fmt.Errorf("tier discounts must not decrease")
+			}
+		}
+	}
+
+	return nil
+}
+
+// validatePoolCreationFeeReceiver validates the PoolCreationFeeReceiver param
+func validatePoolCreationFeeReceiver(v interface{}) error {
+	receiver, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if receiver == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+		return fmt.Errorf("invalid pool creation fee receiver: %w", err)
+	}
+
+	return nil
+}
+
+// validateMaxShareLockDuration validates the MaxShareLockDuration param
+func validateMaxShareLockDuration(v interface{}) error {
+	duration, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if duration == 0 {
+		return fmt.Errorf("max share lock duration must be positive")
+	}
+
+	return nil
+}
+
+// validateMaxShareLockBoost validates the MaxShareLockBoost param
+func validateMaxShareLockBoost(v interface{}) error {
+	boost, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if boost.IsNil() {
+		return fmt.Errorf("max share lock boost must not be nil")
+	}
+	if boost.LT(sdk.OneDec()) {
+		return fmt.Errorf("max share lock boost must be at least 1: %s", boost)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -25,7 +26,30 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// pool creation fee charged in USDC
 	PoolCreationFee uint64 `protobuf:"varint,1,opt,name=poolCreationFee,proto3" json:"poolCreationFee,omitempty"`
+	// pools are paused when the oracle price and spot price diverge by more than this ratio, zero disables the check
+	MaxOracleSpotDivergence github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=maxOracleSpotDivergence,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maxOracleSpotDivergence"`
+	// oracle pools are paused when the slippage tracked within a block exceeds this ratio of the pool TVL, zero disables the check
+	MaxBlockSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=maxBlockSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maxBlockSlippage"`
+	// lower bound of the dynamic swap fee of oracle pools
+	DynamicSwapFeeFloor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=dynamicSwapFeeFloor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dynamicSwapFeeFloor"`
+	// upper bound of the dynamic swap fee of oracle pools, zero disables dynamic swap fees
+	DynamicSwapFeeCeiling github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=dynamicSwapFeeCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dynamicSwapFeeCeiling"`
+	// fee added per unit of oracle price volatility
+	VolatilityFeeMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=volatilityFeeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volatilityFeeMultiplier"`
+	// fee added per unit of stacked slippage
+	SlippageFeeMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=slippageFeeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slippageFeeMultiplier"`
+	// window in seconds of oracle prices used to measure volatility
+	VolatilityWindow uint64 `protobuf:"varint,8,opt,name=volatilityWindow,proto3" json:"volatilityWindow,omitempty"`
+	// swap fee discounts granted to traders by their committed Eden and EdenB amount
+	SwapFeeDiscountTiers []SwapFeeDiscountTier `protobuf:"bytes,9,rep,name=swapFeeDiscountTiers,proto3" json:"swapFeeDiscountTiers"`
+	// address receiving the pool creation fee, the fee is burnt when empty
+	PoolCreationFeeReceiver string `protobuf:"bytes,10,opt,name=poolCreationFeeReceiver,proto3" json:"poolCreationFeeReceiver,omitempty"`
+	// longest duration in seconds pool shares can be locked for
+	MaxShareLockDuration uint64 `protobuf:"varint,11,opt,name=maxShareLockDuration,proto3" json:"maxShareLockDuration,omitempty"`
+	// Eden LP rewards multiplier of shares locked for maxShareLockDuration, shorter locks get a linearly smaller boost
+	MaxShareLockBoost github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=maxShareLockBoost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maxShareLockBoost"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -67,25 +91,114 @@ func (m *Params) GetPoolCreationFee() uint64 {
 	return 0
 }
 
+func (m *Params) GetVolatilityWindow() uint64 {
+	if m != nil {
+		return m.VolatilityWindow
+	}
+	return 0
+}
+
+func (m *Params) GetSwapFeeDiscountTiers() []SwapFeeDiscountTier {
+	if m != nil {
+		return m.SwapFeeDiscountTiers
+	}
+	return nil
+}
+
+func (m *Params) GetPoolCreationFeeReceiver() string {
+	if m != nil {
+		return m.PoolCreationFeeReceiver
+	}
+	return ""
+}
+
+func (m *Params) GetMaxShareLockDuration() uint64 {
+	if m != nil {
+		return m.MaxShareLockDuration
+	}
+	return 0
+}
+
+// SwapFeeDiscountTier discounts the swap fee of traders having at least minCommitted Eden and EdenB committed
+type SwapFeeDiscountTier struct {
+	MinCommitted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=minCommitted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minCommitted"`
+	Discount     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=discount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"discount"`
+}
+
+func (m *SwapFeeDiscountTier) Reset()         { *m = SwapFeeDiscountTier{} }
+func (m *SwapFeeDiscountTier) String() string { return proto.CompactTextString(m) }
+func (*SwapFeeDiscountTier) ProtoMessage()    {}
+func (*SwapFeeDiscountTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1209ca218537a425, []int{1}
+}
+func (m *SwapFeeDiscountTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapFeeDiscountTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapFeeDiscountTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapFeeDiscountTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapFeeDiscountTier.Merge(m, src)
+}
+func (m *SwapFeeDiscountTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapFeeDiscountTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapFeeDiscountTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapFeeDiscountTier proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "elys.amm.Params")
+	proto.RegisterType((*SwapFeeDiscountTier)(nil), "elys.amm.SwapFeeDiscountTier")
 }
 
 func init() { proto.RegisterFile("elys/amm/params.proto", fileDescriptor_1209ca218537a425) }
 
 var fileDescriptor_1209ca218537a425 = []byte{
-	// 176 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0xcd, 0xa9, 0x2c,
-	0xd6, 0x4f, 0xcc, 0xcd, 0xd5, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xe2, 0x00, 0x09, 0xeb, 0x25, 0xe6, 0xe6, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83,
-	0x05, 0xf5, 0x41, 0x2c, 0x88, 0xbc, 0x92, 0x05, 0x17, 0x5b, 0x00, 0x58, 0xbd, 0x90, 0x06, 0x17,
-	0x7f, 0x41, 0x7e, 0x7e, 0x8e, 0x73, 0x51, 0x6a, 0x62, 0x49, 0x66, 0x7e, 0x9e, 0x5b, 0x6a, 0xaa,
-	0x04, 0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0xba, 0xb0, 0x15, 0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e,
-	0x4e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
-	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x91, 0x9e, 0x59, 0x92,
-	0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xb2, 0x5e, 0x37, 0x2f, 0xb5, 0xa4, 0x3c, 0xbf,
-	0x28, 0x1b, 0xcc, 0xd1, 0xaf, 0x00, 0x3b, 0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec,
-	0x08, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x5b, 0xd0, 0xe6, 0xbd, 0x00, 0x00, 0x00,
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x6a, 0xdb, 0x4c,
+	0x14, 0x86, 0xad, 0x2f, 0xfe, 0xf2, 0x33, 0x09, 0x34, 0x9d, 0x38, 0x64, 0x28, 0x54, 0x36, 0x59,
+	0x14, 0x53, 0x88, 0x04, 0xe9, 0xa6, 0x74, 0x29, 0x1b, 0x43, 0x4b, 0x4b, 0x8b, 0x5c, 0x08, 0x84,
+	0x2e, 0x3a, 0x91, 0x0e, 0xf2, 0xe0, 0x19, 0x1d, 0x31, 0x33, 0x8e, 0xed, 0xbb, 0xe8, 0xb2, 0xcb,
+	0xde, 0x44, 0xef, 0x21, 0xcb, 0x2c, 0x4b, 0x17, 0xa1, 0xd8, 0x8b, 0xde, 0x46, 0x91, 0xe2, 0xb6,
+	0x71, 0x2c, 0x2f, 0xaa, 0x95, 0xad, 0xf7, 0xcc, 0x3c, 0xef, 0x9c, 0x1f, 0x0e, 0x39, 0x04, 0x39,
+	0x35, 0x3e, 0x57, 0xca, 0xcf, 0xb8, 0xe6, 0xca, 0x78, 0x99, 0x46, 0x8b, 0x74, 0x3b, 0x97, 0x3d,
+	0xae, 0xd4, 0xa3, 0x46, 0x82, 0x09, 0x16, 0xa2, 0x9f, 0xff, 0xbb, 0x8d, 0x1f, 0xff, 0xdc, 0x22,
+	0x9b, 0xef, 0x8a, 0x0b, 0xb4, 0x4d, 0x1e, 0x64, 0x88, 0xb2, 0xa3, 0x81, 0x5b, 0x81, 0x69, 0x0f,
+	0x80, 0x39, 0x2d, 0xa7, 0x5d, 0x0f, 0xef, 0xcb, 0x74, 0x40, 0x8e, 0x14, 0x9f, 0xbc, 0xd5, 0x3c,
+	0x92, 0xd0, 0xcf, 0xd0, 0x76, 0xc5, 0x25, 0xe8, 0x04, 0xd2, 0x08, 0xd8, 0x7f, 0x2d, 0xa7, 0xbd,
+	0x13, 0x78, 0x57, 0x37, 0xcd, 0xda, 0xf7, 0x9b, 0xe6, 0x93, 0x44, 0xd8, 0xc1, 0xe8, 0xc2, 0x8b,
+	0x50, 0xf9, 0x11, 0x1a, 0x85, 0x66, 0xf1, 0x73, 0x62, 0xe2, 0xa1, 0x6f, 0xa7, 0x19, 0x18, 0xaf,
+	0x0b, 0x51, 0xb8, 0x0e, 0x47, 0xcf, 0xc9, 0xbe, 0xe2, 0x93, 0x40, 0x62, 0x34, 0xec, 0x4b, 0x91,
+	0x65, 0x3c, 0x01, 0xb6, 0x51, 0xc9, 0x62, 0x85, 0x43, 0x3f, 0x92, 0x83, 0x78, 0x9a, 0x72, 0x25,
+	0xa2, 0xfe, 0x98, 0x67, 0x3d, 0x80, 0x9e, 0x44, 0xd4, 0xac, 0x5e, 0x09, 0x5f, 0x86, 0xa2, 0x31,
+	0x39, 0x5c, 0x96, 0x3b, 0x20, 0xa4, 0x48, 0x13, 0xf6, 0x7f, 0x25, 0x8f, 0x72, 0x58, 0xde, 0x8d,
+	0x4b, 0x94, 0xdc, 0x0a, 0x29, 0xec, 0xb4, 0x07, 0xf0, 0x66, 0x24, 0xad, 0xc8, 0xa4, 0x00, 0xcd,
+	0x36, 0xab, 0x75, 0x63, 0x0d, 0x2e, 0xcf, 0xc7, 0x2c, 0xaa, 0xb7, 0xec, 0xb3, 0x55, 0x2d, 0x9f,
+	0x52, 0x18, 0x7d, 0x4a, 0xf6, 0xff, 0x3e, 0xe0, 0x4c, 0xa4, 0x31, 0x8e, 0xd9, 0x76, 0x31, 0x88,
+	0x2b, 0x3a, 0x3d, 0x23, 0x0d, 0x73, 0x5b, 0x8d, 0xae, 0x30, 0x11, 0x8e, 0x52, 0xfb, 0x5e, 0x80,
+	0x36, 0x6c, 0xa7, 0xb5, 0xd1, 0xde, 0x3d, 0x7d, 0xec, 0xfd, 0x9e, 0x7e, 0xaf, 0xbf, 0x7a, 0x2a,
+	0xa8, 0xe7, 0xef, 0x0d, 0x4b, 0x01, 0xf4, 0x39, 0x39, 0xba, 0x37, 0xf5, 0x21, 0x44, 0x90, 0x0f,
+	0x26, 0x23, 0x79, 0xb2, 0xe1, 0xba, 0x30, 0x3d, 0x25, 0x0d, 0xc5, 0x27, 0xfd, 0x01, 0xd7, 0xf0,
+	0x1a, 0xa3, 0x61, 0x77, 0xa4, 0x8b, 0x23, 0x6c, 0xb7, 0x48, 0xa1, 0x34, 0x46, 0x3f, 0x90, 0x87,
+	0x77, 0xf5, 0x00, 0xd1, 0x58, 0xb6, 0x57, 0xa9, 0xa8, 0xab, 0xa0, 0x17, 0xf5, 0xcf, 0x5f, 0x9a,
+	0xb5, 0xe3, 0xaf, 0x0e, 0x39, 0x28, 0xa9, 0x02, 0x0d, 0xc9, 0x9e, 0x12, 0x69, 0x07, 0x95, 0x12,
+	0xd6, 0x42, 0xcc, 0x9c, 0x7f, 0xb6, 0x7d, 0x99, 0xda, 0x70, 0x89, 0x41, 0x5f, 0x91, 0xed, 0x78,
+	0xe1, 0x51, 0x71, 0x23, 0xfc, 0xb9, 0x1f, 0x04, 0x57, 0x33, 0xd7, 0xb9, 0x9e, 0xb9, 0xce, 0x8f,
+	0x99, 0xeb, 0x7c, 0x9a, 0xbb, 0xb5, 0xeb, 0xb9, 0x5b, 0xfb, 0x36, 0x77, 0x6b, 0xe7, 0xed, 0x3b,
+	0xac, 0xbc, 0xd1, 0x27, 0x29, 0xd8, 0x31, 0xea, 0x61, 0xf1, 0xe1, 0x4f, 0x8a, 0x65, 0x58, 0x10,
+	0x2f, 0x36, 0x8b, 0x65, 0xf7, 0xec, 0xd7, 0x00, 0x72, 0x1b, 0x75, 0x25, 0x25, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -108,6 +221,107 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxShareLockBoost.Size()
+		i -= size
+		if _, err := m.MaxShareLockBoost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.MaxShareLockDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxShareLockDuration))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.PoolCreationFeeReceiver) > 0 {
+		i -= len(m.PoolCreationFeeReceiver)
+		copy(dAtA[i:], m.PoolCreationFeeReceiver)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PoolCreationFeeReceiver)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SwapFeeDiscountTiers) > 0 {
+		for iNdEx := len(m.SwapFeeDiscountTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFeeDiscountTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.VolatilityWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VolatilityWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.SlippageFeeMultiplier.Size()
+		i -= size
+		if _, err := m.SlippageFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.VolatilityFeeMultiplier.Size()
+		i -= size
+		if _, err := m.VolatilityFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.DynamicSwapFeeCeiling.Size()
+		i -= size
+		if _, err := m.DynamicSwapFeeCeiling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DynamicSwapFeeFloor.Size()
+		i -= size
+		if _, err := m.DynamicSwapFeeFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxBlockSlippage.Size()
+		i -= size
+		if _, err := m.MaxBlockSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxOracleSpotDivergence.Size()
+		i -= size
+		if _, err := m.MaxOracleSpotDivergence.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.PoolCreationFee != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.PoolCreationFee))
 		i--
@@ -116,6 +330,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SwapFeeDiscountTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapFeeDiscountTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapFeeDiscountTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinCommitted.Size()
+		i -= size
+		if _, err := m.MinCommitted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -136,6 +393,49 @@ func (m *Params) Size() (n int) {
 	if m.PoolCreationFee != 0 {
 		n += 1 + sovParams(uint64(m.PoolCreationFee))
 	}
+	l = m.MaxOracleSpotDivergence.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxBlockSlippage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DynamicSwapFeeFloor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DynamicSwapFeeCeiling.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.VolatilityFeeMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlippageFeeMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.VolatilityWindow != 0 {
+		n += 1 + sovParams(uint64(m.VolatilityWindow))
+	}
+	if len(m.SwapFeeDiscountTiers) > 0 {
+		for _, e := range m.SwapFeeDiscountTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.PoolCreationFeeReceiver)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxShareLockDuration != 0 {
+		n += 1 + sovParams(uint64(m.MaxShareLockDuration))
+	}
+	l = m.MaxShareLockBoost.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *SwapFeeDiscountTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinCommitted.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Discount.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -193,6 +493,466 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleSpotDivergence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOracleSpotDivergence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBlockSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFeeFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicSwapFeeFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFeeCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicSwapFeeCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolatilityFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlippageFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlippageFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityWindow", wireType)
+			}
+			m.VolatilityWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VolatilityWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeDiscountTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapFeeDiscountTiers = append(m.SwapFeeDiscountTiers, SwapFeeDiscountTier{})
+			if err := m.SwapFeeDiscountTiers[len(m.SwapFeeDiscountTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFeeReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFeeReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxShareLockDuration", wireType)
+			}
+			m.MaxShareLockDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxShareLockDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxShareLockBoost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxShareLockBoost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapFeeDiscountTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapFeeDiscountTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapFeeDiscountTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommitted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommitted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
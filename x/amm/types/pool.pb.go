@@ -171,44 +171,467 @@ func (m *OraclePoolSlippageTrack) GetTracked() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+type PoolStatsBucket struct {
+	PoolId             uint64                                   `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	Timestamp          uint64                                   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Volume             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume"`
+	Fees               github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	WeightRecoveryPaid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=weightRecoveryPaid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"weightRecoveryPaid"`
+	LpRevenue          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=lpRevenue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"lpRevenue"`
+}
+
+func (m *PoolStatsBucket) Reset()         { *m = PoolStatsBucket{} }
+func (m *PoolStatsBucket) String() string { return proto.CompactTextString(m) }
+func (*PoolStatsBucket) ProtoMessage()    {}
+func (*PoolStatsBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ac3be9a215271f9, []int{2}
+}
+func (m *PoolStatsBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolStatsBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolStatsBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolStatsBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolStatsBucket.Merge(m, src)
+}
+func (m *PoolStatsBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolStatsBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolStatsBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolStatsBucket proto.InternalMessageInfo
+
+func (m *PoolStatsBucket) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolStatsBucket) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PoolStatsBucket) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *PoolStatsBucket) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *PoolStatsBucket) GetWeightRecoveryPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WeightRecoveryPaid
+	}
+	return nil
+}
+
+func (m *PoolStatsBucket) GetLpRevenue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LpRevenue
+	}
+	return nil
+}
+
+// PausedPool marks a pool in which swaps and joins are disabled, exits stay open
+type PausedPool struct {
+	PoolId    uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Timestamp uint64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *PausedPool) Reset()         { *m = PausedPool{} }
+func (m *PausedPool) String() string { return proto.CompactTextString(m) }
+func (*PausedPool) ProtoMessage()    {}
+func (*PausedPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ac3be9a215271f9, []int{3}
+}
+func (m *PausedPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedPool.Merge(m, src)
+}
+func (m *PausedPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausedPool proto.InternalMessageInfo
+
+func (m *PausedPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PausedPool) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *PausedPool) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// PoolTwapAccumulator tracks the spot price of base denominated in quote integrated over time
+type PoolTwapAccumulator struct {
+	Base          string                                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote         string                                 `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	LastSpotPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=lastSpotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lastSpotPrice"`
+	// sum of spot price * seconds since the first record of the pool
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulativePrice"`
+}
+
+func (m *PoolTwapAccumulator) Reset()         { *m = PoolTwapAccumulator{} }
+func (m *PoolTwapAccumulator) String() string { return proto.CompactTextString(m) }
+func (*PoolTwapAccumulator) ProtoMessage()    {}
+func (*PoolTwapAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ac3be9a215271f9, []int{4}
+}
+func (m *PoolTwapAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolTwapAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTwapAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTwapAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTwapAccumulator.Merge(m, src)
+}
+func (m *PoolTwapAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTwapAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTwapAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolTwapAccumulator proto.InternalMessageInfo
+
+func (m *PoolTwapAccumulator) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *PoolTwapAccumulator) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+// PoolTwapRecord holds the accumulators of every asset pair of a pool at the time reserves changed
+type PoolTwapRecord struct {
+	PoolId       uint64                `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	Timestamp    uint64                `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Height       int64                 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Accumulators []PoolTwapAccumulator `protobuf:"bytes,4,rep,name=accumulators,proto3" json:"accumulators"`
+}
+
+func (m *PoolTwapRecord) Reset()         { *m = PoolTwapRecord{} }
+func (m *PoolTwapRecord) String() string { return proto.CompactTextString(m) }
+func (*PoolTwapRecord) ProtoMessage()    {}
+func (*PoolTwapRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ac3be9a215271f9, []int{5}
+}
+func (m *PoolTwapRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolTwapRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTwapRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTwapRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTwapRecord.Merge(m, src)
+}
+func (m *PoolTwapRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTwapRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTwapRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolTwapRecord proto.InternalMessageInfo
+
+func (m *PoolTwapRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolTwapRecord) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PoolTwapRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PoolTwapRecord) GetAccumulators() []PoolTwapAccumulator {
+	if m != nil {
+		return m.Accumulators
+	}
+	return nil
+}
+
+// ExternalLiquiditySubmission is an external liquidity depth fed for a pool by a price feeder
+type ExternalLiquiditySubmission struct {
+	PoolId    uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	Feeder    string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty"`
+	Timestamp uint64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Height    int64  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// value of the external liquidity in usd
+	Value github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+	Depth github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=depth,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"depth"`
+	// ratio computed from this submission
+	Ratio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=ratio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ratio"`
+	// ratio set on the pool after aggregating the recent submissions of all feeders
+	AggregatedRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=aggregatedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"aggregatedRatio"`
+}
+
+func (m *ExternalLiquiditySubmission) Reset()         { *m = ExternalLiquiditySubmission{} }
+func (m *ExternalLiquiditySubmission) String() string { return proto.CompactTextString(m) }
+func (*ExternalLiquiditySubmission) ProtoMessage()    {}
+func (*ExternalLiquiditySubmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ac3be9a215271f9, []int{6}
+}
+func (m *ExternalLiquiditySubmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalLiquiditySubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalLiquiditySubmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalLiquiditySubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalLiquiditySubmission.Merge(m, src)
+}
+func (m *ExternalLiquiditySubmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalLiquiditySubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalLiquiditySubmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalLiquiditySubmission proto.InternalMessageInfo
+
+func (m *ExternalLiquiditySubmission) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *ExternalLiquiditySubmission) GetFeeder() string {
+	if m != nil {
+		return m.Feeder
+	}
+	return ""
+}
+
+func (m *ExternalLiquiditySubmission) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExternalLiquiditySubmission) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// ExternalLiquidityBounds limits the external liquidity ratio a feeder can submit for a pool,
+// a zero maxRatio leaves the ratio unbounded above
+type ExternalLiquidityBounds struct {
+	PoolId   uint64                                 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	MinRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=minRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minRatio"`
+	MaxRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=maxRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maxRatio"`
+}
+
+func (m *ExternalLiquidityBounds) Reset()         { *m = ExternalLiquidityBounds{} }
+func (m *ExternalLiquidityBounds) String() string { return proto.CompactTextString(m) }
+func (*ExternalLiquidityBounds) ProtoMessage()    {}
+func (*ExternalLiquidityBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ac3be9a215271f9, []int{7}
+}
+func (m *ExternalLiquidityBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalLiquidityBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalLiquidityBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalLiquidityBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalLiquidityBounds.Merge(m, src)
+}
+func (m *ExternalLiquidityBounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalLiquidityBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalLiquidityBounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalLiquidityBounds proto.InternalMessageInfo
+
+func (m *ExternalLiquidityBounds) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Pool)(nil), "elys.amm.Pool")
 	proto.RegisterType((*OraclePoolSlippageTrack)(nil), "elys.amm.OraclePoolSlippageTrack")
+	proto.RegisterType((*PoolStatsBucket)(nil), "elys.amm.PoolStatsBucket")
+	proto.RegisterType((*PausedPool)(nil), "elys.amm.PausedPool")
+	proto.RegisterType((*PoolTwapAccumulator)(nil), "elys.amm.PoolTwapAccumulator")
+	proto.RegisterType((*PoolTwapRecord)(nil), "elys.amm.PoolTwapRecord")
+	proto.RegisterType((*ExternalLiquiditySubmission)(nil), "elys.amm.ExternalLiquiditySubmission")
+	proto.RegisterType((*ExternalLiquidityBounds)(nil), "elys.amm.ExternalLiquidityBounds")
 }
 
 func init() { proto.RegisterFile("elys/amm/pool.proto", fileDescriptor_3ac3be9a215271f9) }
 
 var fileDescriptor_3ac3be9a215271f9 = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x1b, 0x93, 0xd0, 0xcd, 0x89, 0x6d, 0x05, 0xdb, 0x08, 0x39, 0x51, 0x0f, 0xc8, 0x07,
-	0xba, 0xa6, 0xe5, 0x04, 0xb7, 0x86, 0x53, 0x4f, 0x44, 0x6e, 0x25, 0x24, 0x2e, 0xd5, 0xda, 0x1e,
-	0x39, 0x56, 0xbc, 0x5e, 0x6b, 0x77, 0x0b, 0xe4, 0x2f, 0xf8, 0x0e, 0x3e, 0x80, 0x6f, 0xe8, 0xb1,
-	0x47, 0xc4, 0x21, 0xa0, 0xe4, 0x0f, 0xf8, 0x02, 0xb4, 0xeb, 0x35, 0x38, 0x42, 0x20, 0x4e, 0xf6,
-	0xcc, 0x9b, 0x79, 0x33, 0xef, 0xcd, 0xa2, 0x03, 0x28, 0x57, 0x2a, 0x62, 0x9c, 0x47, 0xb5, 0x10,
-	0x25, 0xad, 0xa5, 0xd0, 0x02, 0xdf, 0x37, 0x49, 0xca, 0x38, 0x1f, 0x8f, 0x77, 0xe0, 0xeb, 0x9a,
-	0x49, 0xc6, 0x55, 0x53, 0x35, 0x3e, 0xda, 0xc5, 0x98, 0x52, 0xa0, 0x1d, 0x74, 0x98, 0x8b, 0x5c,
-	0xd8, 0xdf, 0xc8, 0xfc, 0xb9, 0x6c, 0x90, 0x0a, 0xc5, 0x85, 0x8a, 0x12, 0xa6, 0x20, 0x7a, 0x77,
-	0x9a, 0x80, 0x66, 0xa7, 0x51, 0x2a, 0x8a, 0xaa, 0x25, 0x6c, 0xf0, 0xeb, 0xa6, 0xb1, 0x09, 0x1a,
-	0xe8, 0xf8, 0xc7, 0x1e, 0xf2, 0xe7, 0x42, 0x94, 0xf8, 0x21, 0x1a, 0x98, 0x69, 0x17, 0x19, 0xf1,
-	0xa6, 0x5e, 0xe8, 0xc7, 0x2e, 0xc2, 0x04, 0x0d, 0x59, 0x96, 0x49, 0x50, 0x8a, 0xec, 0x4d, 0xbd,
-	0x70, 0x3f, 0x6e, 0x43, 0xfc, 0x12, 0x21, 0x53, 0x33, 0xb7, 0xab, 0x93, 0xfe, 0xd4, 0x0b, 0x47,
-	0x67, 0x87, 0xb4, 0x55, 0x48, 0xe7, 0xbf, 0xb0, 0x99, 0x7f, 0xbb, 0x9e, 0xf4, 0xe2, 0x4e, 0x35,
-	0x3e, 0x47, 0x23, 0x2d, 0x34, 0x2b, 0x2f, 0x17, 0x4c, 0x82, 0x22, 0xbe, 0x6d, 0x3e, 0xa2, 0x6e,
-	0x35, 0xa3, 0x83, 0x3a, 0x1d, 0xf4, 0x95, 0x28, 0x2a, 0xc7, 0xd0, 0xed, 0xc1, 0x2f, 0x9a, 0xf1,
-	0xe7, 0xc6, 0x1d, 0x45, 0xee, 0x4d, 0xfb, 0xe1, 0xe8, 0xec, 0x60, 0x77, 0xbc, 0xc5, 0xba, 0xd3,
-	0x9b, 0x62, 0x3c, 0x77, 0xd3, 0xdf, 0x40, 0x91, 0x2f, 0x34, 0x19, 0x18, 0x5d, 0x33, 0x6a, 0xca,
-	0xbe, 0xae, 0x27, 0x4f, 0xf2, 0x42, 0x2f, 0x6e, 0x12, 0x9a, 0x0a, 0xee, 0xac, 0x72, 0x9f, 0x13,
-	0x95, 0x2d, 0x23, 0xbd, 0xaa, 0x41, 0xd1, 0x8b, 0x4a, 0xc7, 0x5d, 0x0a, 0xfc, 0x14, 0x3d, 0x90,
-	0x90, 0xb0, 0x92, 0x55, 0x29, 0x5c, 0x49, 0x60, 0xea, 0x46, 0xae, 0xc8, 0xd0, 0xfa, 0xf5, 0x27,
-	0x70, 0xfc, 0xd9, 0x43, 0x8f, 0x5e, 0x4b, 0x96, 0x96, 0x60, 0xb6, 0xbc, 0x2c, 0x8b, 0xba, 0x66,
-	0x39, 0x5c, 0x49, 0x96, 0x2e, 0xff, 0x7a, 0x87, 0xc7, 0x68, 0x5f, 0x17, 0x1c, 0x94, 0x66, 0xbc,
-	0xb6, 0x97, 0xf0, 0xe3, 0xdf, 0x09, 0x0c, 0x68, 0xa8, 0x4d, 0x3b, 0x64, 0xa4, 0x6f, 0x9d, 0xf8,
-	0x87, 0x97, 0xcf, 0x8c, 0xd0, 0x4f, 0xdf, 0x26, 0xe1, 0x7f, 0x08, 0x35, 0x0d, 0x2a, 0x6e, 0xb9,
-	0x67, 0xb3, 0xdb, 0x4d, 0xe0, 0xdd, 0x6d, 0x02, 0xef, 0xfb, 0x26, 0xf0, 0x3e, 0x6e, 0x83, 0xde,
-	0xdd, 0x36, 0xe8, 0x7d, 0xd9, 0x06, 0xbd, 0xb7, 0x5d, 0x32, 0x73, 0x83, 0x93, 0x0a, 0xf4, 0x7b,
-	0x21, 0x97, 0x36, 0x88, 0x3e, 0xd8, 0xd7, 0x6c, 0x29, 0x93, 0x81, 0x7d, 0x78, 0xcf, 0x7f, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0xb2, 0x38, 0xdd, 0x77, 0x21, 0x03, 0x00, 0x00,
+	// 826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xeb, 0xda, 0x71, 0xdb, 0x29, 0xb0, 0x62, 0x5a, 0x75, 0xbd, 0x05, 0xd2, 0xc8, 0x07,
+	0x94, 0x03, 0x6b, 0xb3, 0xcb, 0x09, 0x6e, 0x0d, 0x8b, 0xd0, 0x22, 0x24, 0x22, 0x27, 0x12, 0x68,
+	0x2f, 0xd5, 0xc4, 0x7e, 0xeb, 0x8c, 0x62, 0x7b, 0xbc, 0x33, 0xe3, 0xb4, 0x11, 0xff, 0x04, 0xff,
+	0x05, 0x12, 0x17, 0x6e, 0xfc, 0x0d, 0xcb, 0x6d, 0x8f, 0x88, 0xc3, 0x82, 0xda, 0x3f, 0x00, 0x09,
+	0x89, 0x3b, 0x9a, 0xf1, 0x64, 0x1b, 0x67, 0x69, 0x04, 0x56, 0x39, 0xc5, 0xcf, 0x6f, 0xde, 0xe7,
+	0xfd, 0x98, 0x6f, 0x9e, 0x8c, 0x0e, 0x20, 0x5b, 0x88, 0x90, 0xe4, 0x79, 0x58, 0x32, 0x96, 0x05,
+	0x25, 0x67, 0x92, 0xe1, 0x5d, 0xf5, 0x32, 0x20, 0x79, 0x7e, 0x7c, 0xdc, 0x70, 0x9f, 0x95, 0x84,
+	0x93, 0x5c, 0xd4, 0xa7, 0x8e, 0xef, 0x35, 0x7d, 0x44, 0x08, 0x90, 0xc6, 0x75, 0x98, 0xb2, 0x94,
+	0xe9, 0xc7, 0x50, 0x3d, 0x99, 0xb7, 0xdd, 0x98, 0x89, 0x9c, 0x89, 0x70, 0x42, 0x04, 0x84, 0xf3,
+	0x07, 0x13, 0x90, 0xe4, 0x41, 0x18, 0x33, 0x5a, 0x2c, 0x81, 0xb5, 0xff, 0xac, 0x0e, 0xac, 0x8d,
+	0xda, 0xe5, 0xff, 0xb9, 0x8d, 0x9c, 0x21, 0x63, 0x19, 0x3e, 0x42, 0xae, 0xca, 0xf6, 0x38, 0xf1,
+	0xac, 0x9e, 0xd5, 0x77, 0x22, 0x63, 0x61, 0x0f, 0xed, 0x90, 0x24, 0xe1, 0x20, 0x84, 0xb7, 0xdd,
+	0xb3, 0xfa, 0x7b, 0xd1, 0xd2, 0xc4, 0x9f, 0x20, 0xa4, 0xce, 0x0c, 0x75, 0xe9, 0x9e, 0xdd, 0xb3,
+	0xfa, 0xfb, 0x0f, 0x0f, 0x83, 0x65, 0x87, 0xc1, 0xf0, 0x95, 0x6f, 0xe0, 0x3c, 0x7f, 0x79, 0xb2,
+	0x15, 0xad, 0x9c, 0xc6, 0xa7, 0x68, 0x5f, 0x32, 0x49, 0xb2, 0xd1, 0x94, 0x70, 0x10, 0x9e, 0xa3,
+	0x83, 0xef, 0x05, 0xa6, 0x34, 0xd5, 0x47, 0x60, 0xfa, 0x08, 0x3e, 0x65, 0xb4, 0x30, 0x84, 0xd5,
+	0x18, 0xfc, 0x71, 0x9d, 0xfe, 0x54, 0x4d, 0x47, 0x78, 0x9d, 0x9e, 0xdd, 0xdf, 0x7f, 0x78, 0xd0,
+	0x4c, 0xaf, 0x7d, 0xab, 0xd9, 0xeb, 0xc3, 0x78, 0x68, 0xb2, 0x7f, 0x0d, 0x34, 0x9d, 0x4a, 0xcf,
+	0x55, 0x7d, 0x0d, 0x02, 0x75, 0xec, 0xd7, 0x97, 0x27, 0xef, 0xa7, 0x54, 0x4e, 0xab, 0x49, 0x10,
+	0xb3, 0xdc, 0x8c, 0xca, 0xfc, 0xdc, 0x17, 0xc9, 0x2c, 0x94, 0x8b, 0x12, 0x44, 0xf0, 0xb8, 0x90,
+	0xd1, 0x2a, 0x02, 0x7f, 0x80, 0xde, 0xe6, 0x30, 0x21, 0x19, 0x29, 0x62, 0x18, 0x73, 0x20, 0xa2,
+	0xe2, 0x0b, 0x6f, 0x47, 0xcf, 0xeb, 0x75, 0x87, 0xff, 0x93, 0x85, 0xee, 0x7e, 0xc5, 0x49, 0x9c,
+	0x81, 0xaa, 0x72, 0x94, 0xd1, 0xb2, 0x24, 0x29, 0x8c, 0x39, 0x89, 0x67, 0x37, 0xde, 0xc3, 0xbb,
+	0x68, 0x4f, 0xd2, 0x1c, 0x84, 0x24, 0x79, 0xa9, 0x6f, 0xc2, 0x89, 0xae, 0x5f, 0x60, 0x40, 0x3b,
+	0x52, 0x85, 0x43, 0xe2, 0xd9, 0x3d, 0x7b, 0xf3, 0x2c, 0x3f, 0x54, 0x8d, 0xfe, 0xf0, 0xdb, 0x49,
+	0xff, 0x5f, 0x34, 0xaa, 0x02, 0x44, 0xb4, 0x64, 0xfb, 0x7f, 0xd9, 0xe8, 0x8e, 0x2e, 0x59, 0x12,
+	0x29, 0x06, 0x55, 0x3c, 0x03, 0xd9, 0xb2, 0xe0, 0x18, 0xb9, 0x73, 0x96, 0x55, 0x39, 0xfc, 0x1f,
+	0xf5, 0x1a, 0x34, 0x3e, 0x43, 0xce, 0x53, 0xd0, 0xf2, 0xba, 0xf5, 0x14, 0x1a, 0x8c, 0xbf, 0x45,
+	0xf8, 0x5c, 0x0b, 0x20, 0x82, 0x98, 0xcd, 0x81, 0x2f, 0x86, 0x84, 0x26, 0x5e, 0xe7, 0xf6, 0xd3,
+	0xfd, 0x43, 0x1a, 0x4c, 0xd1, 0x5e, 0x56, 0x46, 0x30, 0x87, 0xa2, 0x02, 0xcf, 0xbd, 0xfd, 0x9c,
+	0xd7, 0x74, 0xff, 0x09, 0x42, 0x43, 0x52, 0x09, 0x48, 0x36, 0xae, 0x8a, 0x23, 0xe4, 0x2a, 0x85,
+	0xb3, 0xc2, 0x6c, 0x0a, 0x63, 0x35, 0x95, 0x60, 0xaf, 0x29, 0xc1, 0xff, 0xc3, 0x42, 0x07, 0x0a,
+	0x3b, 0x3e, 0x27, 0xe5, 0x69, 0x1c, 0x57, 0x79, 0x95, 0x11, 0xc9, 0x38, 0xc6, 0xc8, 0x51, 0x5d,
+	0xe8, 0x1c, 0x7b, 0x91, 0x7e, 0xc6, 0x87, 0xa8, 0xf3, 0xac, 0x62, 0x12, 0x4c, 0x82, 0xda, 0xc0,
+	0x63, 0xf4, 0x66, 0x46, 0x84, 0x1c, 0x95, 0x4c, 0x0e, 0x39, 0x8d, 0xc1, 0xb3, 0xff, 0xf3, 0x1f,
+	0xfa, 0x11, 0xc4, 0x51, 0x13, 0x82, 0xbf, 0x41, 0x77, 0x4c, 0x31, 0x74, 0x0e, 0x35, 0xd7, 0x69,
+	0xc5, 0x5d, 0xc7, 0xf8, 0xdf, 0x5b, 0xe8, 0xad, 0x65, 0xc7, 0xea, 0x46, 0x79, 0xd2, 0xf2, 0x4f,
+	0x74, 0x84, 0xdc, 0x69, 0xbd, 0xc2, 0x54, 0xc7, 0x76, 0x64, 0x2c, 0xfc, 0x39, 0x7a, 0x83, 0x5c,
+	0x4f, 0x72, 0xa9, 0xff, 0xf7, 0x9a, 0xcb, 0x71, 0x6d, 0xde, 0x66, 0x4d, 0x36, 0x02, 0xfd, 0x1f,
+	0x6d, 0xf4, 0xce, 0x67, 0x17, 0x12, 0x78, 0x41, 0xb2, 0x2f, 0xe9, 0xb3, 0x8a, 0x26, 0x54, 0x2e,
+	0x46, 0xd5, 0x24, 0xa7, 0x42, 0x50, 0x56, 0x6c, 0x52, 0xc2, 0x53, 0x80, 0x04, 0xf8, 0x52, 0x09,
+	0xb5, 0xb5, 0x59, 0x09, 0x2b, 0xed, 0x38, 0x8d, 0x76, 0x1e, 0xa1, 0xce, 0x9c, 0x64, 0x15, 0x78,
+	0x9d, 0x56, 0xf3, 0xaf, 0x83, 0x15, 0x25, 0x81, 0x52, 0x4e, 0x3d, 0xb7, 0x1d, 0x45, 0x07, 0x2b,
+	0x0a, 0x27, 0x92, 0x32, 0x6f, 0xa7, 0x1d, 0x45, 0x07, 0x2b, 0x6d, 0x91, 0x34, 0xe5, 0x90, 0x12,
+	0x09, 0x49, 0xa4, 0x79, 0xbb, 0xed, 0xb4, 0xb5, 0x86, 0xf1, 0x7f, 0xb6, 0xd0, 0xdd, 0xd7, 0x6e,
+	0x6c, 0xc0, 0xaa, 0x22, 0x11, 0x37, 0xde, 0xd6, 0x17, 0x68, 0x37, 0xa7, 0x45, 0x5d, 0xc6, 0x76,
+	0xab, 0x32, 0x5e, 0xc5, 0x6b, 0x16, 0xb9, 0xa8, 0x59, 0x76, 0x4b, 0x96, 0x89, 0x1f, 0x0c, 0x9e,
+	0x5f, 0x76, 0xad, 0x17, 0x97, 0x5d, 0xeb, 0xf7, 0xcb, 0xae, 0xf5, 0xdd, 0x55, 0x77, 0xeb, 0xc5,
+	0x55, 0x77, 0xeb, 0x97, 0xab, 0xee, 0xd6, 0x93, 0xd5, 0x25, 0xa6, 0x44, 0x7d, 0xbf, 0x00, 0x79,
+	0xce, 0xf8, 0x4c, 0x1b, 0xe1, 0x85, 0xfe, 0x76, 0xd2, 0xc4, 0x89, 0xab, 0x3f, 0x73, 0x3e, 0xfa,
+	0x7b, 0x00, 0x82, 0x04, 0xa1, 0x53, 0x8f, 0x09, 0x00, 0x00,
 }
 
 func (m *Pool) Marshal() (dAtA []byte, err error) {
@@ -344,77 +767,1512 @@ func (m *OraclePoolSlippageTrack) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPool(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PoolStatsBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *PoolStatsBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolStatsBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LpRevenue) > 0 {
+		for iNdEx := len(m.LpRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LpRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.WeightRecoveryPaid) > 0 {
+		for iNdEx := len(m.WeightRecoveryPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightRecoveryPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.PoolId != 0 {
-		n += 1 + sovPool(uint64(m.PoolId))
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *PausedPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.PoolParams.Size()
-	n += 1 + l + sovPool(uint64(l))
-	l = m.TotalShares.Size()
-	n += 1 + l + sovPool(uint64(l))
-	if len(m.PoolAssets) > 0 {
-		for _, e := range m.PoolAssets {
-			l = e.Size()
-			n += 1 + l + sovPool(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *PausedPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolTwapAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolTwapAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolTwapAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
 	}
-	l = m.TotalWeight.Size()
-	n += 1 + l + sovPool(uint64(l))
-	l = len(m.RebalanceTreasury)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LastSpotPrice.Size()
+		i -= size
+		if _, err := m.LastSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OraclePoolSlippageTrack) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolTwapRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolTwapRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolTwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.Accumulators) > 0 {
+		for iNdEx := len(m.Accumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.PoolId != 0 {
-		n += 1 + sovPool(uint64(m.PoolId))
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalLiquiditySubmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalLiquiditySubmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalLiquiditySubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AggregatedRatio.Size()
+		i -= size
+		if _, err := m.AggregatedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Depth.Size()
+		i -= size
+		if _, err := m.Depth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.Timestamp != 0 {
-		n += 1 + sovPool(uint64(m.Timestamp))
+		i = encodeVarintPool(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Tracked) > 0 {
-		for _, e := range m.Tracked {
-			l = e.Size()
-			n += 1 + l + sovPool(uint64(l))
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalLiquidityBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalLiquidityBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalLiquidityBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxRatio.Size()
+		i -= size
+		if _, err := m.MaxRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinRatio.Size()
+		i -= size
+		if _, err := m.MinRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = m.PoolParams.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.TotalShares.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if len(m.PoolAssets) > 0 {
+		for _, e := range m.PoolAssets {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = len(m.RebalanceTreasury)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	return n
+}
+
+func (m *OraclePoolSlippageTrack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovPool(uint64(m.Timestamp))
+	}
+	if len(m.Tracked) > 0 {
+		for _, e := range m.Tracked {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolStatsBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovPool(uint64(m.Timestamp))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	if len(m.WeightRecoveryPaid) > 0 {
+		for _, e := range m.WeightRecoveryPaid {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	if len(m.LpRevenue) > 0 {
+		for _, e := range m.LpRevenue {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PausedPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovPool(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *PoolTwapAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = m.LastSpotPrice.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func (m *PoolTwapRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovPool(uint64(m.Timestamp))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPool(uint64(m.Height))
+	}
+	if len(m.Accumulators) > 0 {
+		for _, e := range m.Accumulators {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExternalLiquiditySubmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovPool(uint64(m.Timestamp))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPool(uint64(m.Height))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.Depth.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.Ratio.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.AggregatedRatio.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func (m *ExternalLiquidityBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	l = m.MinRatio.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.MaxRatio.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func sovPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPool(x uint64) (n int) {
+	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAssets = append(m.PoolAssets, PoolAsset{})
+			if err := m.PoolAssets[len(m.PoolAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceTreasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RebalanceTreasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OraclePoolSlippageTrack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OraclePoolSlippageTrack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OraclePoolSlippageTrack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tracked = append(m.Tracked, types.Coin{})
+			if err := m.Tracked[len(m.Tracked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolStatsBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolStatsBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolStatsBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightRecoveryPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightRecoveryPaid = append(m.WeightRecoveryPaid, types.Coin{})
+			if err := m.WeightRecoveryPaid[len(m.WeightRecoveryPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpRevenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpRevenue = append(m.LpRevenue, types.Coin{})
+			if err := m.LpRevenue[len(m.LpRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausedPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolTwapAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolTwapAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolTwapAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovPool(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPool(x uint64) (n int) {
-	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *PoolTwapRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -437,10 +2295,10 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolTwapRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolTwapRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -463,10 +2321,48 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -476,29 +2372,100 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPool
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPool
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Accumulators = append(m.Accumulators, PoolTwapAccumulator{})
+			if err := m.Accumulators[len(m.Accumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalLiquiditySubmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalLiquiditySubmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalLiquiditySubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -508,30 +2475,67 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPool
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPool
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -541,30 +2545,31 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPool
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPool
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -574,29 +2579,29 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPool
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPool
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolAssets = append(m.PoolAssets, PoolAsset{})
-			if err := m.PoolAssets[len(m.PoolAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Depth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -624,13 +2629,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceTreasury", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -658,7 +2663,9 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RebalanceTreasury = string(dAtA[iNdEx:postIndex])
+			if err := m.AggregatedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -681,7 +2688,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OraclePoolSlippageTrack) Unmarshal(dAtA []byte) error {
+func (m *ExternalLiquidityBounds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -704,10 +2711,10 @@ func (m *OraclePoolSlippageTrack) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OraclePoolSlippageTrack: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExternalLiquidityBounds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OraclePoolSlippageTrack: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExternalLiquidityBounds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -730,10 +2737,10 @@ func (m *OraclePoolSlippageTrack) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRatio", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -743,16 +2750,31 @@ func (m *OraclePoolSlippageTrack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tracked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -762,23 +2784,23 @@ func (m *OraclePoolSlippageTrack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPool
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPool
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tracked = append(m.Tracked, types.Coin{})
-			if err := m.Tracked[len(m.Tracked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -580,6 +580,298 @@ func (m *QuerySwapEstimationResponse) GetTokenOut() types.Coin {
 	return types.Coin{}
 }
 
+type QuerySwapSimulationRequest struct {
+	Routes  []SwapAmountInRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	TokenIn types.Coin          `protobuf:"bytes,2,opt,name=tokenIn,proto3" json:"tokenIn"`
+	// optional trader address, used to apply its committed Eden swap fee discount
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *QuerySwapSimulationRequest) Reset()         { *m = QuerySwapSimulationRequest{} }
+func (m *QuerySwapSimulationRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapSimulationRequest) ProtoMessage()    {}
+func (*QuerySwapSimulationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_763da04a7298bbac, []int{12}
+}
+func (m *QuerySwapSimulationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapSimulationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapSimulationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapSimulationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapSimulationRequest.Merge(m, src)
+}
+func (m *QuerySwapSimulationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapSimulationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapSimulationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapSimulationRequest proto.InternalMessageInfo
+
+func (m *QuerySwapSimulationRequest) GetRoutes() []SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *QuerySwapSimulationRequest) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySwapSimulationRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type QuerySwapSimulationExactAmountOutRequest struct {
+	Routes   []SwapAmountOutRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	TokenOut types.Coin           `protobuf:"bytes,2,opt,name=tokenOut,proto3" json:"tokenOut"`
+	// optional trader address, used to apply its committed Eden swap fee discount
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *QuerySwapSimulationExactAmountOutRequest) Reset() {
+	*m = QuerySwapSimulationExactAmountOutRequest{}
+}
+func (m *QuerySwapSimulationExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapSimulationExactAmountOutRequest) ProtoMessage()    {}
+func (*QuerySwapSimulationExactAmountOutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_763da04a7298bbac, []int{13}
+}
+func (m *QuerySwapSimulationExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapSimulationExactAmountOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapSimulationExactAmountOutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapSimulationExactAmountOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapSimulationExactAmountOutRequest.Merge(m, src)
+}
+func (m *QuerySwapSimulationExactAmountOutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapSimulationExactAmountOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapSimulationExactAmountOutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapSimulationExactAmountOutRequest proto.InternalMessageInfo
+
+func (m *QuerySwapSimulationExactAmountOutRequest) GetRoutes() []SwapAmountOutRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *QuerySwapSimulationExactAmountOutRequest) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySwapSimulationExactAmountOutRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// SwapSimulationHop holds the values computed by the swap path for a single routed pool
+type SwapSimulationHop struct {
+	PoolId    uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	UseOracle bool   `protobuf:"varint,2,opt,name=useOracle,proto3" json:"useOracle,omitempty"`
+	// tokenIn is the amount sent by the trader to the pool
+	TokenIn types.Coin `protobuf:"bytes,3,opt,name=tokenIn,proto3" json:"tokenIn"`
+	// tokenOut is the amount received by the trader after the swap fee, weightRecoveryReward is paid on top of it
+	TokenOut types.Coin `protobuf:"bytes,4,opt,name=tokenOut,proto3" json:"tokenOut"`
+	// swapFee is the fee rate applied on the pool, after multihop discounts
+	SwapFee       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swapFee"`
+	SwapFeeAmount types.Coin                             `protobuf:"bytes,6,opt,name=swapFeeAmount,proto3" json:"swapFeeAmount"`
+	// weightBreakingFee is the fee rate charged for moving the pool away from its target weights
+	WeightBreakingFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=weightBreakingFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weightBreakingFee"`
+	// weightRecoveryReward is the bonus paid from the rebalance treasury for moving the pool towards its target weights
+	WeightRecoveryReward types.Coin `protobuf:"bytes,8,opt,name=weightRecoveryReward,proto3" json:"weightRecoveryReward"`
+	// slippage is the price impact ratio of the swap
+	Slippage       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=slippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slippage"`
+	SlippageAmount types.Coin                             `protobuf:"bytes,10,opt,name=slippageAmount,proto3" json:"slippageAmount"`
+	// spotPrice is the pool price of tokenIn denominated in tokenOut before the swap
+	SpotPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spotPrice"`
+	// oraclePrice is the oracle price of tokenIn denominated in tokenOut, zero when prices are not set
+	OraclePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=oraclePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"oraclePrice"`
+}
+
+func (m *SwapSimulationHop) Reset()         { *m = SwapSimulationHop{} }
+func (m *SwapSimulationHop) String() string { return proto.CompactTextString(m) }
+func (*SwapSimulationHop) ProtoMessage()    {}
+func (*SwapSimulationHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_763da04a7298bbac, []int{14}
+}
+func (m *SwapSimulationHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapSimulationHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapSimulationHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapSimulationHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapSimulationHop.Merge(m, src)
+}
+func (m *SwapSimulationHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapSimulationHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapSimulationHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapSimulationHop proto.InternalMessageInfo
+
+func (m *SwapSimulationHop) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SwapSimulationHop) GetUseOracle() bool {
+	if m != nil {
+		return m.UseOracle
+	}
+	return false
+}
+
+func (m *SwapSimulationHop) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *SwapSimulationHop) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+func (m *SwapSimulationHop) GetSwapFeeAmount() types.Coin {
+	if m != nil {
+		return m.SwapFeeAmount
+	}
+	return types.Coin{}
+}
+
+func (m *SwapSimulationHop) GetWeightRecoveryReward() types.Coin {
+	if m != nil {
+		return m.WeightRecoveryReward
+	}
+	return types.Coin{}
+}
+
+func (m *SwapSimulationHop) GetSlippageAmount() types.Coin {
+	if m != nil {
+		return m.SlippageAmount
+	}
+	return types.Coin{}
+}
+
+type QuerySwapSimulationResponse struct {
+	TokenIn  types.Coin `protobuf:"bytes,1,opt,name=tokenIn,proto3" json:"tokenIn"`
+	TokenOut types.Coin `protobuf:"bytes,2,opt,name=tokenOut,proto3" json:"tokenOut"`
+	// executionPrice is the amount of tokenOut received per tokenIn
+	ExecutionPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=executionPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"executionPrice"`
+	Hops           []SwapSimulationHop                    `protobuf:"bytes,4,rep,name=hops,proto3" json:"hops"`
+}
+
+func (m *QuerySwapSimulationResponse) Reset()         { *m = QuerySwapSimulationResponse{} }
+func (m *QuerySwapSimulationResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapSimulationResponse) ProtoMessage()    {}
+func (*QuerySwapSimulationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_763da04a7298bbac, []int{15}
+}
+func (m *QuerySwapSimulationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapSimulationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapSimulationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapSimulationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapSimulationResponse.Merge(m, src)
+}
+func (m *QuerySwapSimulationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapSimulationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapSimulationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapSimulationResponse proto.InternalMessageInfo
+
+func (m *QuerySwapSimulationResponse) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySwapSimulationResponse) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySwapSimulationResponse) GetHops() []SwapSimulationHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
 type QuerySlippageTrackRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
 }
@@ -588,7 +880,7 @@ func (m *QuerySlippageTrackRequest) Reset()         { *m = QuerySlippageTrackReq
 func (m *QuerySlippageTrackRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySlippageTrackRequest) ProtoMessage()    {}
 func (*QuerySlippageTrackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_763da04a7298bbac, []int{12}
+	return fileDescriptor_763da04a7298bbac, []int{16}
 }
 func (m *QuerySlippageTrackRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +924,7 @@ func (m *QuerySlippageTrackResponse) Reset()         { *m = QuerySlippageTrackRe
 func (m *QuerySlippageTrackResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySlippageTrackResponse) ProtoMessage()    {}
 func (*QuerySlippageTrackResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_763da04a7298bbac, []int{13}
+	return fileDescriptor_763da04a7298bbac, []int{17}
 }
 func (m *QuerySlippageTrackResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -675,7 +967,7 @@ func (m *QuerySlippageTrackAllRequest) Reset()         { *m = QuerySlippageTrack
 func (m *QuerySlippageTrackAllRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySlippageTrackAllRequest) ProtoMessage()    {}
 func (*QuerySlippageTrackAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_763da04a7298bbac, []int{14}
+	return fileDescriptor_763da04a7298bbac, []int{18}
 }
 func (m *QuerySlippageTrackAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,7 +1004,7 @@ func (m *QuerySlippageTrackAllResponse) Reset()         { *m = QuerySlippageTrac
 func (m *QuerySlippageTrackAllResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySlippageTrackAllResponse) ProtoMessage()    {}
 func (*QuerySlippageTrackAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_763da04a7298bbac, []int{15}
+	return fileDescriptor_763da04a7298bbac, []int{19}
 }
 func (m *QuerySlippageTrackAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -748,923 +1040,1287 @@ func (m *QuerySlippageTrackAllResponse) GetTracks() []OraclePoolSlippageTrack {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "elys.amm.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "elys.amm.QueryParamsResponse")
-	proto.RegisterType((*QueryGetPoolRequest)(nil), "elys.amm.QueryGetPoolRequest")
-	proto.RegisterType((*QueryGetPoolResponse)(nil), "elys.amm.QueryGetPoolResponse")
-	proto.RegisterType((*QueryAllPoolRequest)(nil), "elys.amm.QueryAllPoolRequest")
-	proto.RegisterType((*QueryAllPoolResponse)(nil), "elys.amm.QueryAllPoolResponse")
-	proto.RegisterType((*QueryGetDenomLiquidityRequest)(nil), "elys.amm.QueryGetDenomLiquidityRequest")
-	proto.RegisterType((*QueryGetDenomLiquidityResponse)(nil), "elys.amm.QueryGetDenomLiquidityResponse")
-	proto.RegisterType((*QueryAllDenomLiquidityRequest)(nil), "elys.amm.QueryAllDenomLiquidityRequest")
-	proto.RegisterType((*QueryAllDenomLiquidityResponse)(nil), "elys.amm.QueryAllDenomLiquidityResponse")
-	proto.RegisterType((*QuerySwapEstimationRequest)(nil), "elys.amm.QuerySwapEstimationRequest")
-	proto.RegisterType((*QuerySwapEstimationResponse)(nil), "elys.amm.QuerySwapEstimationResponse")
-	proto.RegisterType((*QuerySlippageTrackRequest)(nil), "elys.amm.QuerySlippageTrackRequest")
-	proto.RegisterType((*QuerySlippageTrackResponse)(nil), "elys.amm.QuerySlippageTrackResponse")
-	proto.RegisterType((*QuerySlippageTrackAllRequest)(nil), "elys.amm.QuerySlippageTrackAllRequest")
-	proto.RegisterType((*QuerySlippageTrackAllResponse)(nil), "elys.amm.QuerySlippageTrackAllResponse")
+type QueryPoolStatsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
 }
 
-func init() { proto.RegisterFile("elys/amm/query.proto", fileDescriptor_763da04a7298bbac) }
-
-var fileDescriptor_763da04a7298bbac = []byte{
-	// 948 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x6b, 0x1c, 0x55,
-	0x18, 0xce, 0xb4, 0xc9, 0x36, 0x7d, 0xc5, 0x50, 0x4f, 0x57, 0x69, 0x26, 0xc9, 0x44, 0xa7, 0xe9,
-	0x66, 0x11, 0x33, 0x63, 0x1b, 0x14, 0x44, 0x44, 0x37, 0xf6, 0x83, 0x40, 0xa1, 0x71, 0xf5, 0x4a,
-	0x91, 0x78, 0xb2, 0x7b, 0x98, 0x0e, 0x99, 0x99, 0x33, 0xd9, 0x73, 0xd6, 0x18, 0x4a, 0x6f, 0xbc,
-	0x13, 0x14, 0xc4, 0x82, 0x37, 0xfe, 0x01, 0x6f, 0xfd, 0x17, 0x05, 0x6f, 0x0a, 0xde, 0x88, 0x17,
-	0x45, 0x12, 0x7f, 0x88, 0x9c, 0x73, 0xde, 0xc9, 0xec, 0xd9, 0xd9, 0xaf, 0x42, 0xaf, 0x92, 0x7d,
-	0x3f, 0x9e, 0xe7, 0x79, 0xdf, 0x73, 0xce, 0xb3, 0x0b, 0x75, 0x96, 0x9c, 0x88, 0x90, 0xa6, 0x69,
-	0x78, 0xd4, 0x67, 0xbd, 0x93, 0x20, 0xef, 0x71, 0xc9, 0xc9, 0xa2, 0x8a, 0x06, 0x34, 0x4d, 0xdd,
-	0x7a, 0xc4, 0x23, 0xae, 0x83, 0xa1, 0xfa, 0xcf, 0xe4, 0xdd, 0xd5, 0x88, 0xf3, 0x28, 0x61, 0x21,
-	0xcd, 0xe3, 0x90, 0x66, 0x19, 0x97, 0x54, 0xc6, 0x3c, 0x13, 0x98, 0x7d, 0xbb, 0xc3, 0x45, 0xca,
-	0x45, 0x78, 0x40, 0x05, 0x33, 0xb0, 0xe1, 0xb7, 0x37, 0x0f, 0x98, 0xa4, 0x37, 0xc3, 0x9c, 0x46,
-	0x71, 0xa6, 0x8b, 0xb1, 0xf6, 0xf5, 0x73, 0xfe, 0x9c, 0xf6, 0x68, 0x5a, 0x40, 0x5c, 0x2d, 0xc3,
-	0x9c, 0x27, 0x18, 0x5c, 0xb6, 0x82, 0xfb, 0x54, 0x08, 0x26, 0x31, 0xe5, 0xda, 0x29, 0x0b, 0xcb,
-	0x1b, 0x94, 0x53, 0x08, 0xe9, 0xf0, 0xb8, 0x90, 0xe0, 0x9d, 0xf7, 0x76, 0x59, 0xc6, 0xd3, 0xfd,
-	0x24, 0x3e, 0xea, 0xc7, 0xdd, 0x58, 0x9e, 0x54, 0x68, 0xc5, 0x31, 0xcd, 0xf7, 0x7b, 0xbc, 0x2f,
-	0x99, 0x49, 0xf9, 0x75, 0x20, 0x9f, 0xa9, 0xf9, 0xf6, 0x34, 0x5f, 0x9b, 0x1d, 0xf5, 0x99, 0x90,
-	0xfe, 0x1d, 0xb8, 0x6a, 0x45, 0x45, 0xce, 0x33, 0xc1, 0x48, 0x00, 0x35, 0xa3, 0xeb, 0x9a, 0xf3,
-	0xa6, 0xd3, 0x7c, 0xe5, 0xd6, 0x95, 0xa0, 0xd8, 0x72, 0x60, 0x2a, 0x77, 0xe6, 0x9f, 0x3e, 0x5f,
-	0x9f, 0x6b, 0x63, 0x95, 0xbf, 0x85, 0x30, 0xf7, 0x98, 0xdc, 0xe3, 0x3c, 0x41, 0x74, 0xf2, 0x06,
-	0xd4, 0xd4, 0x8c, 0xbb, 0x5d, 0x0d, 0x33, 0xdf, 0xc6, 0x4f, 0xfe, 0x27, 0x50, 0xb7, 0xcb, 0x91,
-	0xb6, 0x09, 0xf3, 0xaa, 0x02, 0x49, 0x97, 0x06, 0x48, 0x39, 0x4f, 0x90, 0x52, 0x57, 0xf8, 0x5f,
-	0x23, 0x61, 0x2b, 0x49, 0x06, 0x09, 0xef, 0x02, 0x94, 0xc7, 0x86, 0x30, 0x8d, 0xc0, 0x2c, 0x35,
-	0x50, 0x4b, 0x0d, 0xcc, 0xd5, 0xc1, 0xd5, 0x06, 0x7b, 0x34, 0x62, 0xd8, 0xdb, 0x1e, 0xe8, 0xf4,
-	0x7f, 0x70, 0x50, 0xe1, 0x39, 0x7e, 0x45, 0xe1, 0xc5, 0xc9, 0x0a, 0xc9, 0x3d, 0x4b, 0xca, 0x05,
-	0x2d, 0x65, 0x73, 0xaa, 0x14, 0x43, 0x63, 0x69, 0x79, 0x0f, 0xd6, 0x8a, 0x65, 0xdd, 0x56, 0x87,
-	0x7e, 0xbf, 0x38, 0xf3, 0x62, 0xe8, 0x3a, 0x2c, 0xe8, 0xdb, 0xa0, 0xe7, 0xbd, 0xdc, 0x36, 0x1f,
-	0xfc, 0x87, 0xe0, 0x8d, 0x6b, 0xc3, 0x59, 0xee, 0xc2, 0x52, 0xd7, 0xca, 0xe0, 0xc2, 0xae, 0x95,
-	0x53, 0xd9, 0x9d, 0x38, 0xdf, 0x50, 0x97, 0x1f, 0xa1, 0xc0, 0x56, 0x92, 0x8c, 0x16, 0xf8, 0xb2,
-	0x4e, 0xe5, 0x0f, 0x07, 0x67, 0x1a, 0xc1, 0x34, 0x61, 0xa6, 0x8b, 0x2f, 0x3e, 0xd3, 0xcb, 0x3b,
-	0xbd, 0x1f, 0x1d, 0x70, 0xb5, 0xe6, 0xcf, 0x8f, 0x69, 0x7e, 0x47, 0xc8, 0x38, 0xd5, 0xf1, 0x62,
-	0x35, 0xdb, 0x50, 0xd3, 0x8f, 0x54, 0xa0, 0xce, 0x95, 0x52, 0xa7, 0x6a, 0x68, 0xa5, 0xbc, 0x9f,
-	0xc9, 0xdd, 0xac, 0xad, 0x6a, 0xda, 0x58, 0x4a, 0x3e, 0x80, 0x4b, 0x92, 0x1f, 0xb2, 0x6c, 0xb7,
-	0x50, 0xb6, 0x6c, 0x29, 0x2b, 0x34, 0x7d, 0xca, 0xe3, 0x0c, 0xc7, 0x2b, 0xea, 0xfd, 0xdf, 0x1d,
-	0x58, 0x19, 0x29, 0x07, 0xf7, 0x77, 0x1f, 0x2e, 0x8b, 0x9c, 0xcb, 0xbd, 0x5e, 0xdc, 0x61, 0xe6,
-	0x3e, 0xed, 0x04, 0x0a, 0xe1, 0x9f, 0xe7, 0xeb, 0x8d, 0x28, 0x96, 0x0f, 0xfb, 0x07, 0x41, 0x87,
-	0xa7, 0x21, 0xda, 0x94, 0xf9, 0xb3, 0x25, 0xba, 0x87, 0xa1, 0x3c, 0xc9, 0x99, 0x08, 0x6e, 0xb3,
-	0x4e, 0xbb, 0x04, 0x20, 0x1f, 0xc2, 0xa2, 0x26, 0x7e, 0xd0, 0x97, 0xb3, 0x2a, 0x3d, 0x6f, 0xf0,
-	0xb7, 0x61, 0xd9, 0x28, 0x4d, 0xe2, 0x3c, 0xa7, 0x11, 0xfb, 0xa2, 0x47, 0x3b, 0x87, 0xd3, 0x9c,
-	0xe5, 0xab, 0x62, 0xdb, 0x76, 0x13, 0x4e, 0xf7, 0x11, 0x2c, 0x48, 0x15, 0xc0, 0x3b, 0xf8, 0x56,
-	0xb9, 0xec, 0x07, 0x3d, 0xda, 0x49, 0x98, 0x7a, 0xc4, 0x56, 0x27, 0x8a, 0x32, 0x5d, 0xbe, 0x07,
-	0xab, 0x55, 0xf0, 0x56, 0x52, 0xb8, 0x8f, 0xff, 0x0d, 0x3e, 0x84, 0x6a, 0x1e, 0xf9, 0x3f, 0x86,
-	0x9a, 0x46, 0x2a, 0x4e, 0x7b, 0x66, 0x01, 0xd8, 0x76, 0xeb, 0xcf, 0x45, 0x58, 0xd0, 0x14, 0x24,
-	0x81, 0x9a, 0x71, 0x62, 0xb2, 0x5a, 0x82, 0x54, 0x0d, 0xde, 0x5d, 0x1b, 0x93, 0x35, 0x8a, 0xfc,
-	0x1b, 0xdf, 0xff, 0xf5, 0xdf, 0x93, 0x0b, 0xeb, 0x64, 0x2d, 0x54, 0x65, 0x5b, 0x19, 0x93, 0xc7,
-	0xbc, 0x77, 0x18, 0x0e, 0x7d, 0xd3, 0x11, 0x01, 0xf3, 0x4a, 0x1a, 0x19, 0x46, 0xb3, 0xfd, 0xde,
-	0xf5, 0xc6, 0xa5, 0x91, 0xed, 0x1d, 0xcd, 0xd6, 0x20, 0x1b, 0xe3, 0xd8, 0x38, 0x4f, 0xc2, 0x47,
-	0xe6, 0x28, 0x1f, 0x93, 0x14, 0x2e, 0xa9, 0xee, 0x56, 0x52, 0xe5, 0xb5, 0x6d, 0xbf, 0xc2, 0x3b,
-	0xe4, 0xda, 0xfe, 0x75, 0xcd, 0xbb, 0x46, 0x56, 0x26, 0xf0, 0x92, 0xdf, 0x1c, 0x58, 0xb2, 0xbd,
-	0x81, 0x6c, 0x56, 0xe7, 0x19, 0xe9, 0x70, 0x6e, 0x73, 0x7a, 0x21, 0x4a, 0x79, 0x5f, 0x4b, 0x79,
-	0x97, 0x04, 0x63, 0xa4, 0x0c, 0x7d, 0xaf, 0x87, 0x8f, 0x74, 0xe0, 0x31, 0xf9, 0xd5, 0x81, 0xd7,
-	0x6c, 0x48, 0xb5, 0x97, 0xcd, 0xea, 0xe0, 0xb3, 0x09, 0x1c, 0xeb, 0xa0, 0x7e, 0xa0, 0x05, 0x36,
-	0x49, 0x63, 0x36, 0x81, 0xe4, 0x27, 0x07, 0x96, 0x6c, 0x33, 0x21, 0x1b, 0x43, 0x64, 0x23, 0xad,
-	0xcf, 0xbd, 0x31, 0xa5, 0x6a, 0x46, 0x3d, 0xfa, 0x87, 0x0e, 0x2b, 0xc9, 0x7f, 0x71, 0xe0, 0x55,
-	0xeb, 0x09, 0x91, 0xeb, 0xc3, 0x44, 0x23, 0x0c, 0xc5, 0xdd, 0x98, 0x5c, 0x34, 0xe3, 0xe9, 0x09,
-	0xec, 0xda, 0xd7, 0xef, 0xb5, 0xbc, 0xca, 0x4f, 0x1c, 0xb8, 0x32, 0xec, 0x0a, 0xa4, 0x31, 0x89,
-	0xb2, 0xb4, 0x15, 0x77, 0x73, 0x6a, 0xdd, 0xac, 0xab, 0xb2, 0xd4, 0x89, 0x9d, 0x9d, 0xa7, 0xa7,
-	0x9e, 0xf3, 0xec, 0xd4, 0x73, 0xfe, 0x3d, 0xf5, 0x9c, 0x9f, 0xcf, 0xbc, 0xb9, 0x67, 0x67, 0xde,
-	0xdc, 0xdf, 0x67, 0xde, 0xdc, 0x97, 0xcd, 0x01, 0xaf, 0xaf, 0x62, 0x7d, 0xa7, 0xd1, 0xb4, 0xe3,
-	0x1f, 0xd4, 0xf4, 0xaf, 0xcb, 0xed, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x31, 0xef, 0x0f, 0x61,
-	0x9d, 0x0b, 0x00, 0x00,
+func (m *QueryPoolStatsRequest) Reset()         { *m = QueryPoolStatsRequest{} }
+func (m *QueryPoolStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolStatsRequest) ProtoMessage()    {}
+func (*QueryPoolStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_763da04a7298bbac, []int{20}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of Pool items.
-	Pool(ctx context.Context, in *QueryGetPoolRequest, opts ...grpc.CallOption) (*QueryGetPoolResponse, error)
-	PoolAll(ctx context.Context, in *QueryAllPoolRequest, opts ...grpc.CallOption) (*QueryAllPoolResponse, error)
-	// Queries a list of DenomLiquidity items.
-	DenomLiquidity(ctx context.Context, in *QueryGetDenomLiquidityRequest, opts ...grpc.CallOption) (*QueryGetDenomLiquidityResponse, error)
-	DenomLiquidityAll(ctx context.Context, in *QueryAllDenomLiquidityRequest, opts ...grpc.CallOption) (*QueryAllDenomLiquidityResponse, error)
-	// Queries a list of SwapEstimation items.
-	SwapEstimation(ctx context.Context, in *QuerySwapEstimationRequest, opts ...grpc.CallOption) (*QuerySwapEstimationResponse, error)
-	// Queries slippage track for a week.
-	SlippageTrack(ctx context.Context, in *QuerySlippageTrackRequest, opts ...grpc.CallOption) (*QuerySlippageTrackResponse, error)
-	// Queries all slippage tracks for a week.
-	SlippageTrackAll(ctx context.Context, in *QuerySlippageTrackAllRequest, opts ...grpc.CallOption) (*QuerySlippageTrackAllResponse, error)
+func (m *QueryPoolStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPoolStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPoolStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolStatsRequest.Merge(m, src)
+}
+func (m *QueryPoolStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolStatsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/elys.amm.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolStatsRequest proto.InternalMessageInfo
+
+func (m *QueryPoolStatsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Pool(ctx context.Context, in *QueryGetPoolRequest, opts ...grpc.CallOption) (*QueryGetPoolResponse, error) {
-	out := new(QueryGetPoolResponse)
-	err := c.cc.Invoke(ctx, "/elys.amm.Query/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
+type PoolStats struct {
+	Window                uint64                                   `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	Volume                github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume"`
+	Fees                  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	WeightRecoveryPaid    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=weightRecoveryPaid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"weightRecoveryPaid"`
+	LpRevenue             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=lpRevenue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"lpRevenue"`
+	VolumeUsd             github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,6,opt,name=volumeUsd,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volumeUsd"`
+	FeesUsd               github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,7,opt,name=feesUsd,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"feesUsd"`
+	WeightRecoveryPaidUsd github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,8,opt,name=weightRecoveryPaidUsd,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weightRecoveryPaidUsd"`
+	LpRevenueUsd          github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,9,opt,name=lpRevenueUsd,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lpRevenueUsd"`
+}
+
+func (m *PoolStats) Reset()         { *m = PoolStats{} }
+func (m *PoolStats) String() string { return proto.CompactTextString(m) }
+func (*PoolStats) ProtoMessage()    {}
+func (*PoolStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_763da04a7298bbac, []int{21}
+}
+func (m *PoolStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PoolStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolStats.Merge(m, src)
+}
+func (m *PoolStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolStats.DiscardUnknown(m)
 }
 
-func (c *queryClient) PoolAll(ctx context.Context, in *QueryAllPoolRequest, opts ...grpc.CallOption) (*QueryAllPoolResponse, error) {
-	out := new(QueryAllPoolResponse)
-	err := c.cc.Invoke(ctx, "/elys.amm.Query/PoolAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PoolStats proto.InternalMessageInfo
+
+func (m *PoolStats) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) DenomLiquidity(ctx context.Context, in *QueryGetDenomLiquidityRequest, opts ...grpc.CallOption) (*QueryGetDenomLiquidityResponse, error) {
-	out := new(QueryGetDenomLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/elys.amm.Query/DenomLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PoolStats) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DenomLiquidityAll(ctx context.Context, in *QueryAllDenomLiquidityRequest, opts ...grpc.CallOption) (*QueryAllDenomLiquidityResponse, error) {
-	out := new(QueryAllDenomLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/elys.amm.Query/DenomLiquidityAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PoolStats) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SwapEstimation(ctx context.Context, in *QuerySwapEstimationRequest, opts ...grpc.CallOption) (*QuerySwapEstimationResponse, error) {
-	out := new(QuerySwapEstimationResponse)
-	err := c.cc.Invoke(ctx, "/elys.amm.Query/SwapEstimation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PoolStats) GetWeightRecoveryPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WeightRecoveryPaid
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SlippageTrack(ctx context.Context, in *QuerySlippageTrackRequest, opts ...grpc.CallOption) (*QuerySlippageTrackResponse, error) {
-	out := new(QuerySlippageTrackResponse)
-	err := c.cc.Invoke(ctx, "/elys.amm.Query/SlippageTrack", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PoolStats) GetLpRevenue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LpRevenue
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SlippageTrackAll(ctx context.Context, in *QuerySlippageTrackAllRequest, opts ...grpc.CallOption) (*QuerySlippageTrackAllResponse, error) {
-	out := new(QuerySlippageTrackAllResponse)
-	err := c.cc.Invoke(ctx, "/elys.amm.Query/SlippageTrackAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryPoolStatsResponse struct {
+	PoolId   uint64                                 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	Stats24H PoolStats                              `protobuf:"bytes,2,opt,name=stats24h,proto3" json:"stats24h"`
+	Stats7D  PoolStats                              `protobuf:"bytes,3,opt,name=stats7d,proto3" json:"stats7d"`
+	Tvl      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=tvl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tvl"`
+	// annualized LP revenue of the last 7 days over the current TVL
+	Apr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=apr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"apr"`
+}
+
+func (m *QueryPoolStatsResponse) Reset()         { *m = QueryPoolStatsResponse{} }
+func (m *QueryPoolStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolStatsResponse) ProtoMessage()    {}
+func (*QueryPoolStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_763da04a7298bbac, []int{22}
+}
+func (m *QueryPoolStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPoolStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolStatsResponse.Merge(m, src)
+}
+func (m *QueryPoolStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolStatsResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of Pool items.
-	Pool(context.Context, *QueryGetPoolRequest) (*QueryGetPoolResponse, error)
-	PoolAll(context.Context, *QueryAllPoolRequest) (*QueryAllPoolResponse, error)
-	// Queries a list of DenomLiquidity items.
-	DenomLiquidity(context.Context, *QueryGetDenomLiquidityRequest) (*QueryGetDenomLiquidityResponse, error)
-	DenomLiquidityAll(context.Context, *QueryAllDenomLiquidityRequest) (*QueryAllDenomLiquidityResponse, error)
-	// Queries a list of SwapEstimation items.
-	SwapEstimation(context.Context, *QuerySwapEstimationRequest) (*QuerySwapEstimationResponse, error)
-	// Queries slippage track for a week.
-	SlippageTrack(context.Context, *QuerySlippageTrackRequest) (*QuerySlippageTrackResponse, error)
-	// Queries all slippage tracks for a week.
-	SlippageTrackAll(context.Context, *QuerySlippageTrackAllRequest) (*QuerySlippageTrackAllResponse, error)
+var xxx_messageInfo_QueryPoolStatsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolStatsResponse) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryPoolStatsResponse) GetStats24H() PoolStats {
+	if m != nil {
+		return m.Stats24H
+	}
+	return PoolStats{}
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPoolStatsResponse) GetStats7D() PoolStats {
+	if m != nil {
+		return m.Stats7D
+	}
+	return PoolStats{}
 }
-func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryGetPoolRequest) (*QueryGetPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+
+type QueryPausedPoolsRequest struct {
 }
-func (*UnimplementedQueryServer) PoolAll(ctx context.Context, req *QueryAllPoolRequest) (*QueryAllPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolAll not implemented")
+
+func (m *QueryPausedPoolsRequest) Reset()         { *m = QueryPausedPoolsRequest{} }
+func (m *QueryPausedPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedPoolsRequest) ProtoMessage()    {}
+func (*QueryPausedPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_763da04a7298bbac, []int{23}
 }
-func (*UnimplementedQueryServer) DenomLiquidity(ctx context.Context, req *QueryGetDenomLiquidityRequest) (*QueryGetDenomLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomLiquidity not implemented")
+func (m *QueryPausedPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DenomLiquidityAll(ctx context.Context, req *QueryAllDenomLiquidityRequest) (*QueryAllDenomLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomLiquidityAll not implemented")
+func (m *QueryPausedPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) SwapEstimation(ctx context.Context, req *QuerySwapEstimationRequest) (*QuerySwapEstimationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SwapEstimation not implemented")
+func (m *QueryPausedPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedPoolsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) SlippageTrack(ctx context.Context, req *QuerySlippageTrackRequest) (*QuerySlippageTrackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SlippageTrack not implemented")
+func (m *QueryPausedPoolsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) SlippageTrackAll(ctx context.Context, req *QuerySlippageTrackAllRequest) (*QuerySlippageTrackAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SlippageTrackAll not implemented")
+func (m *QueryPausedPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedPoolsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryPausedPoolsRequest proto.InternalMessageInfo
+
+type QueryPausedPoolsResponse struct {
+	PausedPools []PausedPool `protobuf:"bytes,1,rep,name=pausedPools,proto3" json:"pausedPools"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.amm.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryPausedPoolsResponse) Reset()         { *m = QueryPausedPoolsResponse{} }
+func (m *QueryPausedPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedPoolsResponse) ProtoMessage()    {}
+func (*QueryPausedPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_763da04a7298bbac, []int{24}
+}
+func (m *QueryPausedPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPausedPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedPoolsResponse.Merge(m, src)
+}
+func (m *QueryPausedPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedPoolsResponse.DiscardUnknown(m)
 }
 
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.amm.Query/Pool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*QueryGetPoolRequest))
+var xxx_messageInfo_QueryPausedPoolsResponse proto.InternalMessageInfo
+
+func (m *QueryPausedPoolsResponse) GetPausedPools() []PausedPool {
+	if m != nil {
+		return m.PausedPools
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PoolAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.amm.Query/PoolAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolAll(ctx, req.(*QueryAllPoolRequest))
+type QueryPoolTwapRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	Base   string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Quote  string `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	// unix timestamps in seconds, end defaults to the current block time
+	Start uint64 `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	End   uint64 `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *QueryPoolTwapRequest) Reset()         { *m = QueryPoolTwapRequest{} }
+func (m *QueryPoolTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolTwapRequest) ProtoMessage()    {}
+func (*QueryPoolTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_763da04a7298bbac, []int{25}
+}
+func (m *QueryPoolTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPoolTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolTwapRequest.Merge(m, src)
+}
+func (m *QueryPoolTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolTwapRequest.DiscardUnknown(m)
 }
 
-func _Query_DenomLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDenomLiquidityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolTwapRequest proto.InternalMessageInfo
+
+func (m *QueryPoolTwapRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomLiquidity(ctx, in)
+	return 0
+}
+
+func (m *QueryPoolTwapRequest) GetBase() string {
+	if m != nil {
+		return m.Base
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.amm.Query/DenomLiquidity",
+	return ""
+}
+
+func (m *QueryPoolTwapRequest) GetQuote() string {
+	if m != nil {
+		return m.Quote
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomLiquidity(ctx, req.(*QueryGetDenomLiquidityRequest))
+	return ""
+}
+
+func (m *QueryPoolTwapRequest) GetStart() uint64 {
+	if m != nil {
+		return m.Start
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_DenomLiquidityAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllDenomLiquidityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomLiquidityAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.amm.Query/DenomLiquidityAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomLiquidityAll(ctx, req.(*QueryAllDenomLiquidityRequest))
+func (m *QueryPoolTwapRequest) GetEnd() uint64 {
+	if m != nil {
+		return m.End
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_SwapEstimation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySwapEstimationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SwapEstimation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.amm.Query/SwapEstimation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SwapEstimation(ctx, req.(*QuerySwapEstimationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPoolTwapResponse struct {
+	Twap  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap"`
+	Start uint64                                 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   uint64                                 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func _Query_SlippageTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySlippageTrackRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SlippageTrack(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.amm.Query/SlippageTrack",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SlippageTrack(ctx, req.(*QuerySlippageTrackRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPoolTwapResponse) Reset()         { *m = QueryPoolTwapResponse{} }
+func (m *QueryPoolTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolTwapResponse) ProtoMessage()    {}
+func (*QueryPoolTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_763da04a7298bbac, []int{26}
 }
-
-func _Query_SlippageTrackAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySlippageTrackAllRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SlippageTrackAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/elys.amm.Query/SlippageTrackAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SlippageTrackAll(ctx, req.(*QuerySlippageTrackAllRequest))
+func (m *QueryPoolTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "elys.amm.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Pool",
-			Handler:    _Query_Pool_Handler,
-		},
-		{
-			MethodName: "PoolAll",
-			Handler:    _Query_PoolAll_Handler,
-		},
-		{
-			MethodName: "DenomLiquidity",
-			Handler:    _Query_DenomLiquidity_Handler,
-		},
-		{
-			MethodName: "DenomLiquidityAll",
-			Handler:    _Query_DenomLiquidityAll_Handler,
-		},
-		{
-			MethodName: "SwapEstimation",
-			Handler:    _Query_SwapEstimation_Handler,
-		},
-		{
-			MethodName: "SlippageTrack",
-			Handler:    _Query_SlippageTrack_Handler,
-		},
-		{
-			MethodName: "SlippageTrackAll",
-			Handler:    _Query_SlippageTrackAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "elys/amm/query.proto",
+func (m *QueryPoolTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolTwapResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPoolTwapResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPoolTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolTwapResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryPoolTwapResponse proto.InternalMessageInfo
+
+func (m *QueryPoolTwapResponse) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPoolTwapResponse) GetEnd() uint64 {
+	if m != nil {
+		return m.End
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryExternalLiquidityHistoryRequest struct {
+	PoolId     uint64             `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryExternalLiquidityHistoryRequest) Reset()         { *m = QueryExternalLiquidityHistoryRequest{} }
+func (m *QueryExternalLiquidityHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExternalLiquidityHistoryRequest) ProtoMessage()    {}
+func (*QueryExternalLiquidityHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_763da04a7298bbac, []int{27}
+}
+func (m *QueryExternalLiquidityHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExternalLiquidityHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExternalLiquidityHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryExternalLiquidityHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExternalLiquidityHistoryRequest.Merge(m, src)
 }
-
-func (m *QueryGetPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryExternalLiquidityHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExternalLiquidityHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExternalLiquidityHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryExternalLiquidityHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryExternalLiquidityHistoryRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryExternalLiquidityHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryExternalLiquidityHistoryResponse struct {
+	Submissions []ExternalLiquiditySubmission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions"`
+	Bounds      ExternalLiquidityBounds       `protobuf:"bytes,2,opt,name=bounds,proto3" json:"bounds"`
+	Pagination  *query.PageResponse           `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryExternalLiquidityHistoryResponse) Reset()         { *m = QueryExternalLiquidityHistoryResponse{} }
+func (m *QueryExternalLiquidityHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExternalLiquidityHistoryResponse) ProtoMessage()    {}
+func (*QueryExternalLiquidityHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_763da04a7298bbac, []int{28}
+}
+func (m *QueryExternalLiquidityHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExternalLiquidityHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExternalLiquidityHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryExternalLiquidityHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExternalLiquidityHistoryResponse.Merge(m, src)
+}
+func (m *QueryExternalLiquidityHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExternalLiquidityHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExternalLiquidityHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExternalLiquidityHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryExternalLiquidityHistoryResponse) GetSubmissions() []ExternalLiquiditySubmission {
+	if m != nil {
+		return m.Submissions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryExternalLiquidityHistoryResponse) GetBounds() ExternalLiquidityBounds {
+	if m != nil {
+		return m.Bounds
+	}
+	return ExternalLiquidityBounds{}
 }
 
-func (m *QueryAllPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryExternalLiquidityHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "elys.amm.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "elys.amm.QueryParamsResponse")
+	proto.RegisterType((*QueryGetPoolRequest)(nil), "elys.amm.QueryGetPoolRequest")
+	proto.RegisterType((*QueryGetPoolResponse)(nil), "elys.amm.QueryGetPoolResponse")
+	proto.RegisterType((*QueryAllPoolRequest)(nil), "elys.amm.QueryAllPoolRequest")
+	proto.RegisterType((*QueryAllPoolResponse)(nil), "elys.amm.QueryAllPoolResponse")
+	proto.RegisterType((*QueryGetDenomLiquidityRequest)(nil), "elys.amm.QueryGetDenomLiquidityRequest")
+	proto.RegisterType((*QueryGetDenomLiquidityResponse)(nil), "elys.amm.QueryGetDenomLiquidityResponse")
+	proto.RegisterType((*QueryAllDenomLiquidityRequest)(nil), "elys.amm.QueryAllDenomLiquidityRequest")
+	proto.RegisterType((*QueryAllDenomLiquidityResponse)(nil), "elys.amm.QueryAllDenomLiquidityResponse")
+	proto.RegisterType((*QuerySwapEstimationRequest)(nil), "elys.amm.QuerySwapEstimationRequest")
+	proto.RegisterType((*QuerySwapEstimationResponse)(nil), "elys.amm.QuerySwapEstimationResponse")
+	proto.RegisterType((*QuerySwapSimulationRequest)(nil), "elys.amm.QuerySwapSimulationRequest")
+	proto.RegisterType((*QuerySwapSimulationExactAmountOutRequest)(nil), "elys.amm.QuerySwapSimulationExactAmountOutRequest")
+	proto.RegisterType((*SwapSimulationHop)(nil), "elys.amm.SwapSimulationHop")
+	proto.RegisterType((*QuerySwapSimulationResponse)(nil), "elys.amm.QuerySwapSimulationResponse")
+	proto.RegisterType((*QuerySlippageTrackRequest)(nil), "elys.amm.QuerySlippageTrackRequest")
+	proto.RegisterType((*QuerySlippageTrackResponse)(nil), "elys.amm.QuerySlippageTrackResponse")
+	proto.RegisterType((*QuerySlippageTrackAllRequest)(nil), "elys.amm.QuerySlippageTrackAllRequest")
+	proto.RegisterType((*QuerySlippageTrackAllResponse)(nil), "elys.amm.QuerySlippageTrackAllResponse")
+	proto.RegisterType((*QueryPoolStatsRequest)(nil), "elys.amm.QueryPoolStatsRequest")
+	proto.RegisterType((*PoolStats)(nil), "elys.amm.PoolStats")
+	proto.RegisterType((*QueryPoolStatsResponse)(nil), "elys.amm.QueryPoolStatsResponse")
+	proto.RegisterType((*QueryPausedPoolsRequest)(nil), "elys.amm.QueryPausedPoolsRequest")
+	proto.RegisterType((*QueryPausedPoolsResponse)(nil), "elys.amm.QueryPausedPoolsResponse")
+	proto.RegisterType((*QueryPoolTwapRequest)(nil), "elys.amm.QueryPoolTwapRequest")
+	proto.RegisterType((*QueryPoolTwapResponse)(nil), "elys.amm.QueryPoolTwapResponse")
+	proto.RegisterType((*QueryExternalLiquidityHistoryRequest)(nil), "elys.amm.QueryExternalLiquidityHistoryRequest")
+	proto.RegisterType((*QueryExternalLiquidityHistoryResponse)(nil), "elys.amm.QueryExternalLiquidityHistoryResponse")
+}
+
+func init() { proto.RegisterFile("elys/amm/query.proto", fileDescriptor_763da04a7298bbac) }
+
+var fileDescriptor_763da04a7298bbac = []byte{
+	// 1859 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0x7b, 0xc6, 0x63, 0xfb, 0x79, 0xd7, 0x24, 0x95, 0xf1, 0x32, 0xee, 0xd8, 0xe3, 0x4d,
+	0xc7, 0x5f, 0x5a, 0x36, 0xd3, 0xbb, 0x36, 0x61, 0x49, 0x58, 0xb4, 0xf1, 0xb0, 0x76, 0x12, 0x14,
+	0x14, 0xd3, 0x4e, 0x10, 0xe2, 0x43, 0x43, 0x7b, 0xa6, 0x18, 0xb7, 0xdc, 0xd3, 0xd5, 0xee, 0xea,
+	0xf6, 0xd8, 0xb2, 0x2c, 0x85, 0x20, 0x21, 0x21, 0x11, 0x09, 0x11, 0x89, 0x0b, 0x17, 0x24, 0x38,
+	0xa0, 0xdc, 0xb8, 0x86, 0x03, 0xd7, 0x1c, 0x23, 0x71, 0x41, 0x1c, 0x02, 0x4a, 0xf8, 0x0f, 0xf8,
+	0x07, 0x50, 0x55, 0x57, 0x4f, 0x7f, 0x4c, 0xf7, 0xcc, 0x78, 0xec, 0x93, 0xa7, 0xab, 0xde, 0x7b,
+	0xbf, 0xdf, 0x7b, 0x55, 0xf5, 0xea, 0xbd, 0x32, 0x14, 0xb1, 0x79, 0x4c, 0x55, 0xbd, 0xd5, 0x52,
+	0x0f, 0x3c, 0xec, 0x1c, 0x57, 0x6c, 0x87, 0xb8, 0x04, 0x4d, 0xb0, 0xd1, 0x8a, 0xde, 0x6a, 0xc9,
+	0xc5, 0x26, 0x69, 0x12, 0x3e, 0xa8, 0xb2, 0x5f, 0xfe, 0xbc, 0x3c, 0xd7, 0x24, 0xa4, 0x69, 0x62,
+	0x55, 0xb7, 0x0d, 0x55, 0xb7, 0x2c, 0xe2, 0xea, 0xae, 0x41, 0x2c, 0x2a, 0x66, 0x3f, 0xaa, 0x13,
+	0xda, 0x22, 0x54, 0xdd, 0xd5, 0x29, 0xf6, 0xcd, 0xaa, 0x87, 0x9f, 0xee, 0x62, 0x57, 0xff, 0x54,
+	0xb5, 0xf5, 0xa6, 0x61, 0x71, 0x61, 0x21, 0x3b, 0xd3, 0xc1, 0xb7, 0x75, 0x47, 0x6f, 0x05, 0x26,
+	0xae, 0x84, 0xc3, 0x84, 0x98, 0x62, 0x70, 0x36, 0x36, 0x58, 0xd3, 0x29, 0xc5, 0xae, 0x98, 0x92,
+	0xe3, 0x53, 0x31, 0x5b, 0xe5, 0x28, 0x9d, 0x80, 0x48, 0x9d, 0x18, 0x01, 0x85, 0x72, 0x47, 0xb7,
+	0x81, 0x2d, 0xd2, 0xaa, 0x99, 0xc6, 0x81, 0x67, 0x34, 0x0c, 0xf7, 0xb8, 0x0b, 0x96, 0xb6, 0x75,
+	0xbb, 0xe6, 0x10, 0xcf, 0xc5, 0xfe, 0x94, 0x52, 0x04, 0xf4, 0x7d, 0xe6, 0xdf, 0x36, 0xc7, 0xd3,
+	0xf0, 0x81, 0x87, 0xa9, 0xab, 0x6c, 0xc2, 0x95, 0xd8, 0x28, 0xb5, 0x89, 0x45, 0x31, 0xaa, 0x40,
+	0xc1, 0xe7, 0x55, 0x92, 0x3e, 0x94, 0x56, 0xa7, 0xd6, 0x2e, 0x55, 0x82, 0x28, 0x57, 0x7c, 0xc9,
+	0x6a, 0xfe, 0xd5, 0x9b, 0x85, 0x11, 0x4d, 0x48, 0x29, 0x37, 0x84, 0x99, 0xbb, 0xd8, 0xdd, 0x26,
+	0xc4, 0x14, 0xd6, 0xd1, 0x07, 0x50, 0x60, 0x3e, 0xde, 0x6f, 0x70, 0x33, 0x79, 0x4d, 0x7c, 0x29,
+	0x77, 0xa0, 0x18, 0x17, 0x17, 0xb0, 0xab, 0x90, 0x67, 0x12, 0x02, 0x74, 0x3a, 0x02, 0x4a, 0x88,
+	0x29, 0x20, 0xb9, 0x84, 0xf2, 0x53, 0x01, 0xb8, 0x61, 0x9a, 0x51, 0xc0, 0x2d, 0x80, 0x70, 0xd9,
+	0x84, 0x99, 0xe5, 0x8a, 0x1f, 0xd4, 0x0a, 0x0b, 0x6a, 0xc5, 0xdf, 0x3a, 0x22, 0xb4, 0x95, 0x6d,
+	0xbd, 0x89, 0x85, 0xae, 0x16, 0xd1, 0x54, 0x7e, 0x2d, 0x41, 0x31, 0x6e, 0xbf, 0x8b, 0x61, 0xae,
+	0x37, 0x43, 0x74, 0x37, 0x46, 0x65, 0x94, 0x53, 0x59, 0xe9, 0x4b, 0xc5, 0x87, 0x89, 0x71, 0xb9,
+	0x09, 0xf3, 0x41, 0xb0, 0xbe, 0x64, 0x8b, 0xfe, 0x20, 0x58, 0xf3, 0xc0, 0xe9, 0x22, 0x8c, 0xf1,
+	0xdd, 0xc0, 0xfd, 0x9d, 0xd4, 0xfc, 0x0f, 0x65, 0x0f, 0xca, 0x59, 0x6a, 0xc2, 0x97, 0x2d, 0x98,
+	0x6e, 0xc4, 0x66, 0x44, 0xc0, 0x4a, 0xa1, 0x57, 0x71, 0x4d, 0xe1, 0x5f, 0x42, 0x4b, 0x69, 0x0a,
+	0x82, 0x1b, 0xa6, 0x99, 0x4e, 0xf0, 0xa2, 0x56, 0xe5, 0xaf, 0x12, 0x94, 0xb3, 0x90, 0x7a, 0xf8,
+	0x94, 0x3b, 0xbb, 0x4f, 0x17, 0xb7, 0x7a, 0xbf, 0x91, 0x40, 0xe6, 0x9c, 0x77, 0xda, 0xba, 0xbd,
+	0x49, 0x5d, 0xa3, 0xc5, 0xc7, 0x83, 0xd0, 0xac, 0x43, 0x81, 0x1f, 0x52, 0x2a, 0x78, 0x5e, 0x0d,
+	0x79, 0x32, 0x85, 0x8d, 0x16, 0xf1, 0x2c, 0xf7, 0xbe, 0xa5, 0x31, 0x19, 0x4d, 0x88, 0xa2, 0x5b,
+	0x30, 0xee, 0x92, 0x7d, 0x6c, 0xdd, 0x0f, 0x98, 0xcd, 0xc6, 0x98, 0x05, 0x9c, 0xbe, 0x43, 0x0c,
+	0x4b, 0xb8, 0x17, 0xc8, 0x2b, 0x7f, 0x91, 0xe0, 0x6a, 0x2a, 0x1d, 0x11, 0xbf, 0x07, 0x30, 0x49,
+	0x6d, 0xe2, 0x6e, 0x3b, 0x46, 0x1d, 0xfb, 0xfb, 0xa9, 0x5a, 0x61, 0x16, 0xfe, 0xf5, 0x66, 0x61,
+	0xb9, 0x69, 0xb8, 0x7b, 0xde, 0x6e, 0xa5, 0x4e, 0x5a, 0xaa, 0x48, 0x53, 0xfe, 0x9f, 0x1b, 0xb4,
+	0xb1, 0xaf, 0xba, 0xc7, 0x36, 0xa6, 0x95, 0x2f, 0x71, 0x5d, 0x0b, 0x0d, 0xa0, 0x6f, 0xc1, 0x04,
+	0x07, 0x7e, 0xe8, 0xb9, 0x83, 0x32, 0xed, 0x28, 0x28, 0x2f, 0xa2, 0x91, 0xdb, 0x31, 0x5a, 0x9e,
+	0x19, 0x8b, 0xdc, 0xad, 0x33, 0x44, 0x2e, 0xc8, 0x56, 0xe7, 0x8e, 0x1f, 0xcb, 0x68, 0x14, 0x5b,
+	0x0d, 0xec, 0x94, 0x72, 0xfc, 0xb0, 0x89, 0x2f, 0xe5, 0x6f, 0x12, 0xac, 0xa6, 0x90, 0xdd, 0x3c,
+	0xd2, 0xeb, 0xae, 0x4f, 0xe5, 0xa1, 0xe7, 0x06, 0xd4, 0x6f, 0x27, 0xa8, 0xcf, 0xa5, 0x51, 0x67,
+	0xf2, 0x29, 0xdc, 0xcf, 0x13, 0xd2, 0x4c, 0xf6, 0x2f, 0x0b, 0x70, 0x39, 0x4e, 0xfc, 0x1e, 0xb1,
+	0xb3, 0xb2, 0x37, 0x9a, 0x83, 0x49, 0x8f, 0xe2, 0x87, 0x8e, 0x5e, 0x37, 0x31, 0xe7, 0x30, 0xa1,
+	0x85, 0x03, 0xd1, 0xe0, 0xe6, 0xce, 0x18, 0xdc, 0xa8, 0x6f, 0xf9, 0xb3, 0xfa, 0x76, 0x0f, 0xc6,
+	0xd9, 0x9d, 0xb7, 0x85, 0x71, 0x69, 0x6c, 0xa8, 0x7d, 0x1b, 0xa8, 0xa3, 0x4d, 0x78, 0x5f, 0xfc,
+	0xf4, 0x57, 0xa2, 0x54, 0x18, 0x8c, 0x4b, 0x5c, 0x0b, 0xfd, 0x04, 0x2e, 0xb7, 0xb1, 0xd1, 0xdc,
+	0x73, 0xab, 0x0e, 0xd6, 0xf7, 0x0d, 0xab, 0xc9, 0xa8, 0x8d, 0x0f, 0x45, 0xad, 0xdb, 0x10, 0xda,
+	0x81, 0xa2, 0x3f, 0xa8, 0xe1, 0x3a, 0x39, 0xc4, 0xce, 0xb1, 0x86, 0xdb, 0xba, 0xd3, 0x28, 0x4d,
+	0x0c, 0xc6, 0x35, 0x55, 0x19, 0x7d, 0x17, 0x26, 0xa8, 0x69, 0xd8, 0xb6, 0xde, 0xc4, 0xa5, 0xc9,
+	0xa1, 0x98, 0x76, 0xf4, 0xd1, 0x5d, 0x98, 0x0e, 0x7e, 0x8b, 0x30, 0xc2, 0x60, 0xd4, 0x12, 0x6a,
+	0xf1, 0x94, 0x34, 0x75, 0xde, 0x94, 0xb4, 0x0d, 0x53, 0x84, 0x6f, 0x54, 0xdf, 0xde, 0x7b, 0x43,
+	0xd9, 0x8b, 0x9a, 0x50, 0xfe, 0x3c, 0x1a, 0x49, 0xa9, 0xd1, 0x3c, 0x25, 0x52, 0x6a, 0xe4, 0x40,
+	0x48, 0xe7, 0x38, 0x10, 0x67, 0x3e, 0xec, 0x3f, 0x80, 0x69, 0x7c, 0x84, 0xeb, 0x1e, 0x23, 0xe3,
+	0x3b, 0x9b, 0x1b, 0xca, 0xd9, 0x84, 0x15, 0x74, 0x13, 0xf2, 0x7b, 0xc4, 0xa6, 0xa5, 0x7c, 0x5a,
+	0xda, 0x8d, 0x65, 0x90, 0xa0, 0x1e, 0x62, 0xe2, 0xca, 0x3a, 0xcc, 0xfa, 0x51, 0x12, 0xab, 0xfb,
+	0xc8, 0xd1, 0xeb, 0xfb, 0xfd, 0x0a, 0xc5, 0x1f, 0x83, 0x9c, 0xa6, 0x24, 0x22, 0xfb, 0x6d, 0x18,
+	0x73, 0xd9, 0x80, 0x88, 0xeb, 0xb5, 0x90, 0x8a, 0x9f, 0x8b, 0x58, 0x4d, 0x16, 0xd3, 0x14, 0x84,
+	0x7c, 0x2d, 0xa5, 0x0c, 0x73, 0xdd, 0xc6, 0x37, 0xcc, 0xa0, 0x98, 0x54, 0x7e, 0x06, 0xf3, 0x19,
+	0xf3, 0x02, 0xff, 0x0b, 0x28, 0x70, 0x4b, 0x41, 0x1e, 0x1f, 0x98, 0x80, 0x50, 0x53, 0x54, 0x98,
+	0xf1, 0xab, 0x6f, 0x26, 0xe7, 0xea, 0x2e, 0xed, 0x17, 0x8f, 0x3f, 0x15, 0x60, 0xb2, 0x23, 0xcc,
+	0xa4, 0xda, 0x86, 0xd5, 0x20, 0xed, 0x40, 0xca, 0xff, 0x42, 0x75, 0x28, 0x1c, 0x12, 0xd3, 0x6b,
+	0xb1, 0xec, 0x9c, 0xeb, 0xbd, 0x69, 0x3e, 0x61, 0x7c, 0x5e, 0xfc, 0x7b, 0x61, 0x75, 0x80, 0xcd,
+	0xc0, 0x14, 0xa8, 0x26, 0x4c, 0xa3, 0x1a, 0xe4, 0x7f, 0x8e, 0x31, 0x2d, 0xe5, 0x2e, 0x1e, 0x82,
+	0x1b, 0x46, 0x27, 0x80, 0xe2, 0x49, 0x6a, 0x5b, 0x37, 0x1a, 0xa5, 0xfc, 0xc5, 0xc3, 0xa5, 0xc0,
+	0x20, 0x03, 0x26, 0x4d, 0x5b, 0xc3, 0x87, 0xd8, 0xf2, 0xd8, 0x7d, 0x72, 0xe1, 0x98, 0xa1, 0x75,
+	0x96, 0xdf, 0xfc, 0x90, 0x3e, 0xa6, 0x8d, 0x52, 0x61, 0xa8, 0x23, 0x1a, 0x1a, 0x60, 0xd7, 0x20,
+	0x8b, 0x1e, 0xb3, 0x35, 0xdc, 0x5d, 0x13, 0xa8, 0xa3, 0x06, 0xcc, 0x74, 0x07, 0xe6, 0x31, 0xf5,
+	0xaf, 0x98, 0xb3, 0xdb, 0x4d, 0x37, 0x86, 0x34, 0x78, 0xaf, 0x13, 0x0a, 0x66, 0x7c, 0xb8, 0x6b,
+	0x27, 0x66, 0x43, 0xf9, 0xe3, 0x28, 0x7c, 0x90, 0x3c, 0x57, 0xe2, 0xc8, 0x66, 0xd5, 0x34, 0x37,
+	0x61, 0x82, 0x32, 0xc1, 0xb5, 0xaf, 0xef, 0x89, 0x4c, 0x7b, 0x25, 0xde, 0xdb, 0x71, 0x33, 0x41,
+	0x8e, 0x0d, 0x44, 0xd1, 0x3a, 0x8c, 0xf3, 0xdf, 0x9f, 0x35, 0x4a, 0xb9, 0x7e, 0x5a, 0x81, 0x24,
+	0xba, 0x03, 0x39, 0xf7, 0xd0, 0x2c, 0xe5, 0x87, 0xf2, 0x94, 0xa9, 0x32, 0x0b, 0xba, 0xed, 0x0c,
+	0x59, 0xe7, 0x30, 0x55, 0x65, 0x16, 0xbe, 0x2a, 0xfa, 0x7e, 0x8f, 0xe2, 0x06, 0xa3, 0xda, 0x79,
+	0x12, 0xf8, 0x21, 0x94, 0xba, 0xa7, 0x44, 0xf8, 0x3e, 0x87, 0x29, 0x3b, 0x1c, 0x16, 0x69, 0xaf,
+	0x18, 0xf1, 0xb9, 0x33, 0x29, 0x9c, 0x8e, 0x8a, 0x2b, 0x4f, 0x82, 0xae, 0x9a, 0x7d, 0x3e, 0x6a,
+	0xeb, 0x76, 0x9f, 0x74, 0x87, 0x10, 0xe4, 0xd9, 0x61, 0xe3, 0x2b, 0x32, 0xa9, 0xf1, 0xdf, 0xac,
+	0xdb, 0x3d, 0xf0, 0x88, 0x2b, 0x6e, 0x33, 0xcd, 0xff, 0x60, 0xa3, 0xd4, 0xd5, 0x1d, 0xbf, 0x6e,
+	0xcc, 0x6b, 0xfe, 0x07, 0xba, 0x04, 0x39, 0x6c, 0x35, 0x78, 0x9c, 0xf2, 0x1a, 0xfb, 0xa9, 0xfc,
+	0x52, 0x82, 0x99, 0x04, 0x05, 0xe1, 0x5a, 0x15, 0xf2, 0x6e, 0x5b, 0xb7, 0x87, 0x6c, 0x7a, 0xb8,
+	0x6e, 0xc8, 0x62, 0x34, 0x85, 0x45, 0x2e, 0x64, 0xf1, 0x2b, 0x09, 0x16, 0x39, 0x8b, 0xcd, 0x23,
+	0x17, 0x3b, 0x96, 0x6e, 0x76, 0x1a, 0xcf, 0x7b, 0x06, 0x75, 0x89, 0x73, 0xdc, 0x2f, 0x30, 0x5b,
+	0x29, 0xed, 0xe9, 0x30, 0x1d, 0xf5, 0x93, 0x51, 0x58, 0xea, 0x43, 0x44, 0x84, 0xe7, 0x7b, 0x30,
+	0x45, 0xbd, 0xdd, 0x96, 0x41, 0xa9, 0x41, 0xac, 0x60, 0xe5, 0x97, 0xc2, 0x95, 0xef, 0x32, 0xb0,
+	0xd3, 0x91, 0x0e, 0xb6, 0x42, 0x44, 0x9f, 0x5d, 0x9d, 0xbb, 0xc4, 0xb3, 0x1a, 0x54, 0x90, 0xbf,
+	0xd6, 0xc3, 0x52, 0x95, 0x0b, 0x06, 0x57, 0xa7, 0xaf, 0x96, 0x68, 0xd0, 0x73, 0x43, 0x37, 0xe8,
+	0x6b, 0xff, 0xfb, 0x0a, 0x8c, 0xf1, 0x10, 0x20, 0x13, 0x0a, 0xfe, 0xe3, 0x16, 0x8a, 0x34, 0x64,
+	0xdd, 0x6f, 0x66, 0xf2, 0x7c, 0xc6, 0xac, 0x6f, 0x5c, 0x59, 0x7a, 0xfa, 0x8f, 0xff, 0x3e, 0x1f,
+	0x5d, 0x40, 0xf3, 0x2a, 0x13, 0xbb, 0x61, 0x61, 0xb7, 0x4d, 0x9c, 0x7d, 0x35, 0xf1, 0x78, 0x88,
+	0x28, 0xe4, 0xd9, 0x1e, 0x44, 0x49, 0x6b, 0xf1, 0x27, 0x34, 0xb9, 0x9c, 0x35, 0x2d, 0xd0, 0x3e,
+	0xe6, 0x68, 0xcb, 0x68, 0x31, 0x0b, 0x8d, 0x10, 0x53, 0x3d, 0xf1, 0xb7, 0xcd, 0x29, 0x6a, 0xc1,
+	0x38, 0xd3, 0xde, 0x30, 0xbb, 0x71, 0xe3, 0x2f, 0x69, 0x72, 0x39, 0x6b, 0x5a, 0xe0, 0x5e, 0xe7,
+	0xb8, 0xf3, 0xe8, 0x6a, 0x0f, 0x5c, 0xf4, 0x07, 0x09, 0xa6, 0xe3, 0xcf, 0x2d, 0x68, 0xa5, 0xdb,
+	0x9f, 0xd4, 0x47, 0x23, 0x79, 0xb5, 0xbf, 0xa0, 0xa0, 0xf2, 0x0d, 0x4e, 0xe5, 0x13, 0x54, 0xc9,
+	0xa0, 0x92, 0x78, 0x2a, 0x55, 0x4f, 0xf8, 0xc0, 0x29, 0xfa, 0xbd, 0x04, 0x97, 0xe3, 0x26, 0x59,
+	0x5c, 0x56, 0xba, 0x1d, 0x1f, 0x8c, 0x60, 0xe6, 0xa3, 0x94, 0x52, 0xe1, 0x04, 0x57, 0xd1, 0xf2,
+	0x60, 0x04, 0xd1, 0x33, 0x09, 0xa6, 0xe3, 0xef, 0x33, 0x68, 0x31, 0x01, 0x96, 0xfa, 0x9a, 0x24,
+	0x2f, 0xf5, 0x91, 0x1a, 0x90, 0x0f, 0x7f, 0x3b, 0xc6, 0x21, 0x78, 0xc0, 0x27, 0x2c, 0xee, 0x53,
+	0xf9, 0x74, 0xbd, 0xd1, 0xc8, 0x4b, 0x7d, 0xa4, 0xce, 0xc2, 0x87, 0x86, 0xe0, 0x2f, 0x25, 0x98,
+	0xeb, 0xf5, 0xce, 0x82, 0xd6, 0x7a, 0xe2, 0xa6, 0x3e, 0xca, 0x0c, 0xca, 0xf5, 0x0b, 0xce, 0xf5,
+	0x16, 0xfa, 0x6c, 0x30, 0xae, 0x35, 0xcc, 0xc0, 0x6a, 0x3a, 0x47, 0xab, 0x11, 0xcf, 0x45, 0xbf,
+	0x93, 0xe0, 0xfd, 0x58, 0x4f, 0x80, 0xae, 0x27, 0x91, 0x53, 0x3a, 0x24, 0x79, 0xb1, 0xb7, 0xd0,
+	0x80, 0x47, 0x21, 0x68, 0xad, 0x6b, 0xbc, 0x01, 0x09, 0xf3, 0xc2, 0x73, 0x09, 0x2e, 0x25, 0xdb,
+	0x1c, 0xb4, 0xdc, 0x0b, 0x32, 0xec, 0x93, 0xe4, 0x95, 0xbe, 0x72, 0x83, 0xae, 0x73, 0x8c, 0x1d,
+	0x45, 0x4f, 0xa5, 0x68, 0xb7, 0xb3, 0x90, 0x4c, 0xbb, 0x89, 0xa6, 0x49, 0xfe, 0x30, 0x5b, 0x40,
+	0x10, 0x58, 0xe3, 0x04, 0x3e, 0x46, 0x1f, 0xf5, 0x48, 0x5a, 0x35, 0x5e, 0xa6, 0x85, 0xa1, 0xf9,
+	0x85, 0x04, 0x53, 0x91, 0x52, 0x08, 0x5d, 0x4b, 0xa2, 0x74, 0x55, 0x50, 0xb2, 0xd2, 0x4b, 0x44,
+	0x50, 0xf9, 0x1a, 0xa7, 0xb2, 0x84, 0xae, 0x67, 0xde, 0x12, 0x4c, 0xa7, 0x66, 0x73, 0xcc, 0x67,
+	0x12, 0x4c, 0x04, 0x05, 0x0b, 0x2a, 0xa7, 0xb8, 0x19, 0x29, 0xa6, 0xe4, 0x85, 0xcc, 0x79, 0x01,
+	0x7d, 0x87, 0x43, 0xdf, 0x46, 0xdf, 0xec, 0x15, 0x05, 0x56, 0xcf, 0x74, 0x82, 0xa0, 0x9e, 0xb0,
+	0x1b, 0xf5, 0x54, 0x3d, 0xe1, 0xc5, 0xd6, 0x29, 0xfa, 0xbb, 0x04, 0xa5, 0xac, 0x8a, 0x01, 0x55,
+	0x12, 0xf8, 0x7d, 0x6a, 0x1c, 0x59, 0x1d, 0x58, 0x5e, 0xf0, 0xaf, 0x72, 0xfe, 0x9f, 0xa3, 0xdb,
+	0x19, 0xfc, 0xb1, 0x30, 0x10, 0x66, 0xd4, 0xda, 0x9e, 0x6f, 0xa2, 0xe3, 0x50, 0xb5, 0xfa, 0xea,
+	0x6d, 0x59, 0x7a, 0xfd, 0xb6, 0x2c, 0xfd, 0xe7, 0x6d, 0x59, 0xfa, 0xed, 0xbb, 0xf2, 0xc8, 0xeb,
+	0x77, 0xe5, 0x91, 0x7f, 0xbe, 0x2b, 0x8f, 0xfc, 0x28, 0xda, 0xc3, 0x75, 0xdb, 0x3f, 0xe2, 0x08,
+	0xbc, 0xee, 0xdb, 0x2d, 0xf0, 0x7f, 0xac, 0xad, 0xff, 0x7f, 0x00, 0x73, 0x25, 0xad, 0x91, 0x98,
+	0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Pool items.
+	Pool(ctx context.Context, in *QueryGetPoolRequest, opts ...grpc.CallOption) (*QueryGetPoolResponse, error)
+	PoolAll(ctx context.Context, in *QueryAllPoolRequest, opts ...grpc.CallOption) (*QueryAllPoolResponse, error)
+	// Queries a list of DenomLiquidity items.
+	DenomLiquidity(ctx context.Context, in *QueryGetDenomLiquidityRequest, opts ...grpc.CallOption) (*QueryGetDenomLiquidityResponse, error)
+	DenomLiquidityAll(ctx context.Context, in *QueryAllDenomLiquidityRequest, opts ...grpc.CallOption) (*QueryAllDenomLiquidityResponse, error)
+	// Queries a list of SwapEstimation items.
+	SwapEstimation(ctx context.Context, in *QuerySwapEstimationRequest, opts ...grpc.CallOption) (*QuerySwapEstimationResponse, error)
+	// Queries a swap simulation for exact amount in routes with fee and price impact breakdown.
+	SwapSimulation(ctx context.Context, in *QuerySwapSimulationRequest, opts ...grpc.CallOption) (*QuerySwapSimulationResponse, error)
+	// Queries a swap simulation for exact amount out routes with fee and price impact breakdown.
+	SwapSimulationExactAmountOut(ctx context.Context, in *QuerySwapSimulationExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapSimulationResponse, error)
+	// Queries slippage track for a week.
+	SlippageTrack(ctx context.Context, in *QuerySlippageTrackRequest, opts ...grpc.CallOption) (*QuerySlippageTrackResponse, error)
+	// Queries all slippage tracks for a week.
+	SlippageTrackAll(ctx context.Context, in *QuerySlippageTrackAllRequest, opts ...grpc.CallOption) (*QuerySlippageTrackAllResponse, error)
+	// Queries rolling 24h and 7d volume, fee and revenue stats of a pool.
+	PoolStats(ctx context.Context, in *QueryPoolStatsRequest, opts ...grpc.CallOption) (*QueryPoolStatsResponse, error)
+	// Queries the pools paused by authority or by the circuit breaker.
+	PausedPools(ctx context.Context, in *QueryPausedPoolsRequest, opts ...grpc.CallOption) (*QueryPausedPoolsResponse, error)
+	// Queries the time weighted average spot price of base in quote between start and end.
+	PoolTwap(ctx context.Context, in *QueryPoolTwapRequest, opts ...grpc.CallOption) (*QueryPoolTwapResponse, error)
+	// Queries the external liquidity depths submitted for a pool in submission order, with its bounds.
+	ExternalLiquidityHistory(ctx context.Context, in *QueryExternalLiquidityHistoryRequest, opts ...grpc.CallOption) (*QueryExternalLiquidityHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/elys.amm.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Pool(ctx context.Context, in *QueryGetPoolRequest, opts ...grpc.CallOption) (*QueryGetPoolResponse, error) {
+	out := new(QueryGetPoolResponse)
+	err := c.cc.Invoke(ctx, "/elys.amm.Query/Pool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pool) > 0 {
-		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) PoolAll(ctx context.Context, in *QueryAllPoolRequest, opts ...grpc.CallOption) (*QueryAllPoolResponse, error) {
+	out := new(QueryAllPoolResponse)
+	err := c.cc.Invoke(ctx, "/elys.amm.Query/PoolAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetDenomLiquidityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DenomLiquidity(ctx context.Context, in *QueryGetDenomLiquidityRequest, opts ...grpc.CallOption) (*QueryGetDenomLiquidityResponse, error) {
+	out := new(QueryGetDenomLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/elys.amm.Query/DenomLiquidity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetDenomLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DenomLiquidityAll(ctx context.Context, in *QueryAllDenomLiquidityRequest, opts ...grpc.CallOption) (*QueryAllDenomLiquidityResponse, error) {
+	out := new(QueryAllDenomLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/elys.amm.Query/DenomLiquidityAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetDenomLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) SwapEstimation(ctx context.Context, in *QuerySwapEstimationRequest, opts ...grpc.CallOption) (*QuerySwapEstimationResponse, error) {
+	out := new(QuerySwapEstimationResponse)
+	err := c.cc.Invoke(ctx, "/elys.amm.Query/SwapEstimation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetDenomLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SwapSimulation(ctx context.Context, in *QuerySwapSimulationRequest, opts ...grpc.CallOption) (*QuerySwapSimulationResponse, error) {
+	out := new(QuerySwapSimulationResponse)
+	err := c.cc.Invoke(ctx, "/elys.amm.Query/SwapSimulation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetDenomLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SwapSimulationExactAmountOut(ctx context.Context, in *QuerySwapSimulationExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapSimulationResponse, error) {
+	out := new(QuerySwapSimulationResponse)
+	err := c.cc.Invoke(ctx, "/elys.amm.Query/SwapSimulationExactAmountOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetDenomLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DenomLiquidity.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) SlippageTrack(ctx context.Context, in *QuerySlippageTrackRequest, opts ...grpc.CallOption) (*QuerySlippageTrackResponse, error) {
+	out := new(QuerySlippageTrackResponse)
+	err := c.cc.Invoke(ctx, "/elys.amm.Query/SlippageTrack", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllDenomLiquidityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SlippageTrackAll(ctx context.Context, in *QuerySlippageTrackAllRequest, opts ...grpc.CallOption) (*QuerySlippageTrackAllResponse, error) {
+	out := new(QuerySlippageTrackAllResponse)
+	err := c.cc.Invoke(ctx, "/elys.amm.Query/SlippageTrackAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllDenomLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolStats(ctx context.Context, in *QueryPoolStatsRequest, opts ...grpc.CallOption) (*QueryPoolStatsResponse, error) {
+	out := new(QueryPoolStatsResponse)
+	err := c.cc.Invoke(ctx, "/elys.amm.Query/PoolStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllDenomLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PausedPools(ctx context.Context, in *QueryPausedPoolsRequest, opts ...grpc.CallOption) (*QueryPausedPoolsResponse, error) {
+	out := new(QueryPausedPoolsResponse)
+	err := c.cc.Invoke(ctx, "/elys.amm.Query/PausedPools", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllDenomLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolTwap(ctx context.Context, in *QueryPoolTwapRequest, opts ...grpc.CallOption) (*QueryPoolTwapResponse, error) {
+	out := new(QueryPoolTwapResponse)
+	err := c.cc.Invoke(ctx, "/elys.amm.Query/PoolTwap", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllDenomLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ExternalLiquidityHistory(ctx context.Context, in *QueryExternalLiquidityHistoryRequest, opts ...grpc.CallOption) (*QueryExternalLiquidityHistoryResponse, error) {
+	out := new(QueryExternalLiquidityHistoryResponse)
+	err := c.cc.Invoke(ctx, "/elys.amm.Query/ExternalLiquidityHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllDenomLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DenomLiquidity) > 0 {
-		for iNdEx := len(m.DenomLiquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DenomLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Pool items.
+	Pool(context.Context, *QueryGetPoolRequest) (*QueryGetPoolResponse, error)
+	PoolAll(context.Context, *QueryAllPoolRequest) (*QueryAllPoolResponse, error)
+	// Queries a list of DenomLiquidity items.
+	DenomLiquidity(context.Context, *QueryGetDenomLiquidityRequest) (*QueryGetDenomLiquidityResponse, error)
+	DenomLiquidityAll(context.Context, *QueryAllDenomLiquidityRequest) (*QueryAllDenomLiquidityResponse, error)
+	// Queries a list of SwapEstimation items.
+	SwapEstimation(context.Context, *QuerySwapEstimationRequest) (*QuerySwapEstimationResponse, error)
+	// Queries a swap simulation for exact amount in routes with fee and price impact breakdown.
+	SwapSimulation(context.Context, *QuerySwapSimulationRequest) (*QuerySwapSimulationResponse, error)
+	// Queries a swap simulation for exact amount out routes with fee and price impact breakdown.
+	SwapSimulationExactAmountOut(context.Context, *QuerySwapSimulationExactAmountOutRequest) (*QuerySwapSimulationResponse, error)
+	// Queries slippage track for a week.
+	SlippageTrack(context.Context, *QuerySlippageTrackRequest) (*QuerySlippageTrackResponse, error)
+	// Queries all slippage tracks for a week.
+	SlippageTrackAll(context.Context, *QuerySlippageTrackAllRequest) (*QuerySlippageTrackAllResponse, error)
+	// Queries rolling 24h and 7d volume, fee and revenue stats of a pool.
+	PoolStats(context.Context, *QueryPoolStatsRequest) (*QueryPoolStatsResponse, error)
+	// Queries the pools paused by authority or by the circuit breaker.
+	PausedPools(context.Context, *QueryPausedPoolsRequest) (*QueryPausedPoolsResponse, error)
+	// Queries the time weighted average spot price of base in quote between start and end.
+	PoolTwap(context.Context, *QueryPoolTwapRequest) (*QueryPoolTwapResponse, error)
+	// Queries the external liquidity depths submitted for a pool in submission order, with its bounds.
+	ExternalLiquidityHistory(context.Context, *QueryExternalLiquidityHistoryRequest) (*QueryExternalLiquidityHistoryResponse, error)
 }
 
-func (m *QuerySwapEstimationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QuerySwapEstimationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QuerySwapEstimationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryGetPoolRequest) (*QueryGetPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
 }
-
-func (m *QuerySwapEstimationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) PoolAll(ctx context.Context, req *QueryAllPoolRequest) (*QueryAllPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolAll not implemented")
+}
+func (*UnimplementedQueryServer) DenomLiquidity(ctx context.Context, req *QueryGetDenomLiquidityRequest) (*QueryGetDenomLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) DenomLiquidityAll(ctx context.Context, req *QueryAllDenomLiquidityRequest) (*QueryAllDenomLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomLiquidityAll not implemented")
+}
+func (*UnimplementedQueryServer) SwapEstimation(ctx context.Context, req *QuerySwapEstimationRequest) (*QuerySwapEstimationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapEstimation not implemented")
+}
+func (*UnimplementedQueryServer) SwapSimulation(ctx context.Context, req *QuerySwapSimulationRequest) (*QuerySwapSimulationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapSimulation not implemented")
+}
+func (*UnimplementedQueryServer) SwapSimulationExactAmountOut(ctx context.Context, req *QuerySwapSimulationExactAmountOutRequest) (*QuerySwapSimulationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapSimulationExactAmountOut not implemented")
+}
+func (*UnimplementedQueryServer) SlippageTrack(ctx context.Context, req *QuerySlippageTrackRequest) (*QuerySlippageTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlippageTrack not implemented")
+}
+func (*UnimplementedQueryServer) SlippageTrackAll(ctx context.Context, req *QuerySlippageTrackAllRequest) (*QuerySlippageTrackAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlippageTrackAll not implemented")
+}
+func (*UnimplementedQueryServer) PoolStats(ctx context.Context, req *QueryPoolStatsRequest) (*QueryPoolStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolStats not implemented")
+}
+func (*UnimplementedQueryServer) PausedPools(ctx context.Context, req *QueryPausedPoolsRequest) (*QueryPausedPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedPools not implemented")
+}
+func (*UnimplementedQueryServer) PoolTwap(ctx context.Context, req *QueryPoolTwapRequest) (*QueryPoolTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolTwap not implemented")
+}
+func (*UnimplementedQueryServer) ExternalLiquidityHistory(ctx context.Context, req *QueryExternalLiquidityHistoryRequest) (*QueryExternalLiquidityHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalLiquidityHistory not implemented")
 }
 
-func (m *QuerySwapEstimationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QuerySwapEstimationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.SpotPrice.Size()
-		i -= size
-		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.amm.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlippageTrackRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPoolRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.amm.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*QueryGetPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlippageTrackRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PoolAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.amm.Query/PoolAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolAll(ctx, req.(*QueryAllPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlippageTrackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func _Query_DenomLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDenomLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).DenomLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.amm.Query/DenomLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomLiquidity(ctx, req.(*QueryGetDenomLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlippageTrackResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DenomLiquidityAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDenomLiquidityRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).DenomLiquidityAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.amm.Query/DenomLiquidityAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomLiquidityAll(ctx, req.(*QueryAllDenomLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlippageTrackResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+func _Query_SwapEstimation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapEstimationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapEstimation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.amm.Query/SwapEstimation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapEstimation(ctx, req.(*QuerySwapEstimationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapSimulation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapSimulationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapSimulation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.amm.Query/SwapSimulation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapSimulation(ctx, req.(*QuerySwapSimulationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SwapSimulationExactAmountOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapSimulationExactAmountOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapSimulationExactAmountOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.amm.Query/SwapSimulationExactAmountOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapSimulationExactAmountOut(ctx, req.(*QuerySwapSimulationExactAmountOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlippageTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlippageTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlippageTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.amm.Query/SlippageTrack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlippageTrack(ctx, req.(*QuerySlippageTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlippageTrackAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlippageTrackAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlippageTrackAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.amm.Query/SlippageTrackAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlippageTrackAll(ctx, req.(*QuerySlippageTrackAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.amm.Query/PoolStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolStats(ctx, req.(*QueryPoolStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PausedPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.amm.Query/PausedPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedPools(ctx, req.(*QueryPausedPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.amm.Query/PoolTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolTwap(ctx, req.(*QueryPoolTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExternalLiquidityHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExternalLiquidityHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExternalLiquidityHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.amm.Query/ExternalLiquidityHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExternalLiquidityHistory(ctx, req.(*QueryExternalLiquidityHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "elys.amm.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "PoolAll",
+			Handler:    _Query_PoolAll_Handler,
+		},
+		{
+			MethodName: "DenomLiquidity",
+			Handler:    _Query_DenomLiquidity_Handler,
+		},
+		{
+			MethodName: "DenomLiquidityAll",
+			Handler:    _Query_DenomLiquidityAll_Handler,
+		},
+		{
+			MethodName: "SwapEstimation",
+			Handler:    _Query_SwapEstimation_Handler,
+		},
+		{
+			MethodName: "SwapSimulation",
+			Handler:    _Query_SwapSimulation_Handler,
+		},
+		{
+			MethodName: "SwapSimulationExactAmountOut",
+			Handler:    _Query_SwapSimulationExactAmountOut_Handler,
+		},
+		{
+			MethodName: "SlippageTrack",
+			Handler:    _Query_SlippageTrack_Handler,
+		},
+		{
+			MethodName: "SlippageTrackAll",
+			Handler:    _Query_SlippageTrackAll_Handler,
+		},
+		{
+			MethodName: "PoolStats",
+			Handler:    _Query_PoolStats_Handler,
+		},
+		{
+			MethodName: "PausedPools",
+			Handler:    _Query_PausedPools_Handler,
+		},
+		{
+			MethodName: "PoolTwap",
+			Handler:    _Query_PoolTwap_Handler,
+		},
+		{
+			MethodName: "ExternalLiquidityHistory",
+			Handler:    _Query_ExternalLiquidityHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "elys/amm/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySlippageTrackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Track.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1676,7 +2332,7 @@ func (m *QuerySlippageTrackResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySlippageTrackAllRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1686,20 +2342,25 @@ func (m *QuerySlippageTrackAllRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySlippageTrackAllRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetPoolRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySlippageTrackAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySlippageTrackAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetPoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1709,259 +2370,3916 @@ func (m *QuerySlippageTrackAllResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySlippageTrackAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetPoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySlippageTrackAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tracks) > 0 {
-		for iNdEx := len(m.Tracks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tracks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryAllPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAllPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pool) > 0 {
-		for _, e := range m.Pool {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetDenomLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetDenomLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDenomLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDenomLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetDenomLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetDenomLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.DenomLiquidity.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllDenomLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetDenomLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDenomLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.DenomLiquidity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllDenomLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllDenomLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDenomLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDenomLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.DenomLiquidity) > 0 {
-		for _, e := range m.DenomLiquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapEstimationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Routes) > 0 {
+func (m *QueryAllDenomLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDenomLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDenomLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomLiquidity) > 0 {
+		for iNdEx := len(m.DenomLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapEstimationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapEstimationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapEstimationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapEstimationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapEstimationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapEstimationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapSimulationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapSimulationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapSimulationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapSimulationExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapSimulationExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapSimulationExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapSimulationHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapSimulationHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapSimulationHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OraclePrice.Size()
+		i -= size
+		if _, err := m.OraclePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.SlippageAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Slippage.Size()
+		i -= size
+		if _, err := m.Slippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.WeightRecoveryReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.WeightBreakingFee.Size()
+		i -= size
+		if _, err := m.WeightBreakingFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.SwapFeeAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.UseOracle {
+		i--
+		if m.UseOracle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapSimulationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapSimulationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapSimulationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.ExecutionPrice.Size()
+		i -= size
+		if _, err := m.ExecutionPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlippageTrackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlippageTrackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlippageTrackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlippageTrackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlippageTrackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlippageTrackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Track.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlippageTrackAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlippageTrackAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlippageTrackAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlippageTrackAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlippageTrackAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlippageTrackAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tracks) > 0 {
+		for iNdEx := len(m.Tracks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tracks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LpRevenueUsd.Size()
+		i -= size
+		if _, err := m.LpRevenueUsd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.WeightRecoveryPaidUsd.Size()
+		i -= size
+		if _, err := m.WeightRecoveryPaidUsd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.FeesUsd.Size()
+		i -= size
+		if _, err := m.FeesUsd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.VolumeUsd.Size()
+		i -= size
+		if _, err := m.VolumeUsd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.LpRevenue) > 0 {
+		for iNdEx := len(m.LpRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LpRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.WeightRecoveryPaid) > 0 {
+		for iNdEx := len(m.WeightRecoveryPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightRecoveryPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Tvl.Size()
+		i -= size
+		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Stats7D.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Stats24H.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedPools) > 0 {
+		for iNdEx := len(m.PausedPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Start != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Start != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExternalLiquidityHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExternalLiquidityHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExternalLiquidityHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExternalLiquidityHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExternalLiquidityHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExternalLiquidityHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Submissions) > 0 {
+		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryGetPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDenomLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDenomLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DenomLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllDenomLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDenomLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomLiquidity) > 0 {
+		for _, e := range m.DenomLiquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapEstimationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapEstimationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapSimulationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapSimulationExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
 		for _, e := range m.Routes {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	l = m.TokenIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QuerySwapEstimationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SpotPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TokenOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	l = m.TokenOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapSimulationHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.UseOracle {
+		n += 2
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SwapFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SwapFeeAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WeightBreakingFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WeightRecoveryReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Slippage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SlippageAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OraclePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapSimulationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExecutionPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySlippageTrackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QuerySlippageTrackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Track.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySlippageTrackAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySlippageTrackAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tracks) > 0 {
+		for _, e := range m.Tracks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WeightRecoveryPaid) > 0 {
+		for _, e := range m.WeightRecoveryPaid {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.LpRevenue) > 0 {
+		for _, e := range m.LpRevenue {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.VolumeUsd.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeesUsd.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WeightRecoveryPaidUsd.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LpRevenueUsd.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.Stats24H.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Stats7D.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Tvl.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPausedPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausedPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PausedPools) > 0 {
+		for _, e := range m.PausedPools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovQuery(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovQuery(uint64(m.End))
+	}
+	return n
+}
+
+func (m *QueryPoolTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Twap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Start != 0 {
+		n += 1 + sovQuery(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovQuery(uint64(m.End))
+	}
+	return n
+}
+
+func (m *QueryExternalLiquidityHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExternalLiquidityHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Submissions) > 0 {
+		for _, e := range m.Submissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Bounds.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = append(m.Pool, Pool{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDenomLiquidityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDenomLiquidityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDenomLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDenomLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDenomLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDenomLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomLiquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DenomLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDenomLiquidityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDenomLiquidityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDenomLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDenomLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDenomLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDenomLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomLiquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomLiquidity = append(m.DenomLiquidity, DenomLiquidity{})
+			if err := m.DenomLiquidity[len(m.DenomLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapEstimationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapEstimationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapEstimationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, &SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapEstimationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapEstimationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapEstimationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapSimulationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapSimulationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapSimulationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapSimulationExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapSimulationExactAmountOutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapSimulationExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapSimulationHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapSimulationHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapSimulationHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseOracle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseOracle = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightBreakingFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightBreakingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightRecoveryReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightRecoveryReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlippageAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlippageAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OraclePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapSimulationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapSimulationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapSimulationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutionPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, SwapSimulationHop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QuerySlippageTrackRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QuerySlippageTrackResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySlippageTrackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlippageTrackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlippageTrackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Track.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QuerySlippageTrackAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QuerySlippageTrackAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Tracks) > 0 {
-		for _, e := range m.Tracks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySlippageTrackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlippageTrackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlippageTrackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Track", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Track.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlippageTrackAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1984,10 +6302,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlippageTrackAllRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlippageTrackAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2011,7 +6329,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlippageTrackAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2034,15 +6352,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlippageTrackAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlippageTrackAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2069,7 +6387,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tracks = append(m.Tracks, OraclePoolSlippageTrack{})
+			if err := m.Tracks[len(m.Tracks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2094,7 +6413,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2117,10 +6436,10 @@ func (m *QueryGetPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2163,7 +6482,7 @@ func (m *QueryGetPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2186,15 +6505,34 @@ func (m *QueryGetPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2221,63 +6559,82 @@ func (m *QueryGetPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllPoolRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightRecoveryPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WeightRecoveryPaid = append(m.WeightRecoveryPaid, types.Coin{})
+			if err := m.WeightRecoveryPaid[len(m.WeightRecoveryPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPoolRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LpRevenue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2294,20 +6651,154 @@ func (m *QueryAllPoolRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpRevenue = append(m.LpRevenue, types.Coin{})
+			if err := m.LpRevenue[len(m.LpRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolumeUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeesUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightRecoveryPaidUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightRecoveryPaidUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpRevenueUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LpRevenueUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2332,7 +6823,7 @@ func (m *QueryAllPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2355,15 +6846,34 @@ func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats24H", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2390,14 +6900,13 @@ func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pool = append(m.Pool, Pool{})
-			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats24H.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats7D", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2424,66 +6933,47 @@ func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats7D.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetDenomLiquidityRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDenomLiquidityRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDenomLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2511,7 +7001,9 @@ func (m *QueryGetDenomLiquidityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2534,7 +7026,7 @@ func (m *QueryGetDenomLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDenomLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPausedPoolsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2557,45 +7049,12 @@ func (m *QueryGetDenomLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDenomLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPausedPoolsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDenomLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPausedPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomLiquidity", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DenomLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2617,7 +7076,7 @@ func (m *QueryGetDenomLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDenomLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPausedPoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2640,15 +7099,15 @@ func (m *QueryAllDenomLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDenomLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPausedPoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDenomLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPausedPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedPools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2675,10 +7134,8 @@ func (m *QueryAllDenomLiquidityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PausedPools = append(m.PausedPools, PausedPool{})
+			if err := m.PausedPools[len(m.PausedPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2703,7 +7160,7 @@ func (m *QueryAllDenomLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDenomLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolTwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2726,17 +7183,17 @@ func (m *QueryAllDenomLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDenomLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolTwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDenomLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomLiquidity", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2746,31 +7203,16 @@ func (m *QueryAllDenomLiquidityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DenomLiquidity = append(m.DenomLiquidity, DenomLiquidity{})
-			if err := m.DenomLiquidity[len(m.DenomLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2780,83 +7222,29 @@ func (m *QueryAllDenomLiquidityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySwapEstimationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapEstimationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapEstimationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2866,31 +7254,29 @@ func (m *QuerySwapEstimationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, &SwapAmountInRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var msglen int
+			m.Start = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2900,25 +7286,30 @@ func (m *QuerySwapEstimationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Start |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2940,7 +7331,7 @@ func (m *QuerySwapEstimationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapEstimationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolTwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2963,15 +7354,15 @@ func (m *QuerySwapEstimationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapEstimationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolTwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapEstimationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2999,15 +7390,15 @@ func (m *QuerySwapEstimationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var msglen int
+			m.Start = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3017,25 +7408,30 @@ func (m *QuerySwapEstimationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Start |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3057,7 +7453,7 @@ func (m *QuerySwapEstimationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySlippageTrackRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExternalLiquidityHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3080,10 +7476,10 @@ func (m *QuerySlippageTrackRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlippageTrackRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExternalLiquidityHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlippageTrackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExternalLiquidityHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3105,59 +7501,9 @@ func (m *QuerySlippageTrackRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySlippageTrackResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlippageTrackResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlippageTrackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Track", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3184,7 +7530,10 @@ func (m *QuerySlippageTrackResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Track.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3209,7 +7558,7 @@ func (m *QuerySlippageTrackResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySlippageTrackAllRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExternalLiquidityHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3232,65 +7581,82 @@ func (m *QuerySlippageTrackAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlippageTrackAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExternalLiquidityHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlippageTrackAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExternalLiquidityHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySlippageTrackAllResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Submissions = append(m.Submissions, ExternalLiquiditySubmission{})
+			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlippageTrackAllResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlippageTrackAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tracks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3317,8 +7683,10 @@ func (m *QuerySlippageTrackAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tracks = append(m.Tracks, OraclePoolSlippageTrack{})
-			if err := m.Tracks[len(m.Tracks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,57 @@
+package types
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SpotPrice returns the marginal price of tokenIn denominated in tokenOut, using the same
+// balances and weights as the balancer swap formula.
+func (p Pool) SpotPrice(
+	ctx sdk.Context,
+	oracle OracleKeeper,
+	snapshot *Pool,
+	tokenInDenom string,
+	tokenOutDenom string,
+	accountedPool AccountedPoolKeeper,
+) (sdk.Dec, error) {
+	poolAssetIn, poolAssetOut, err := p.parsePoolAssetsByDenoms(tokenInDenom, tokenOutDenom)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	poolTokenInBalance := sdk.NewDecFromInt(poolAssetIn.Token.Amount)
+	acountedPoolAssetInAmt := accountedPool.GetAccountedBalance(ctx, p.PoolId, tokenInDenom)
+	if acountedPoolAssetInAmt.GT(sdk.ZeroInt()) {
+		poolTokenInBalance = sdk.NewDecFromInt(acountedPoolAssetInAmt)
+	}
+
+	poolTokenOutBalance := sdk.NewDecFromInt(poolAssetOut.Token.Amount)
+	acountedPoolAssetOutAmt := accountedPool.GetAccountedBalance(ctx, p.PoolId, tokenOutDenom)
+	if acountedPoolAssetOutAmt.GT(sdk.ZeroInt()) {
+		poolTokenOutBalance = sdk.NewDecFromInt(acountedPoolAssetOutAmt)
+	}
+
+	inWeight := sdk.NewDecFromInt(poolAssetIn.Weight)
+	outWeight := sdk.NewDecFromInt(poolAssetOut.Weight)
+	if p.PoolParams.UseOracle {
+		snapshotAssetIn, snapshotAssetOut, err := snapshot.parsePoolAssetsByDenoms(tokenInDenom, tokenOutDenom)
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+		oracleWeights, err := OraclePoolNormalizedWeights(ctx, oracle, []PoolAsset{snapshotAssetIn, snapshotAssetOut})
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+		inWeight = oracleWeights[0].Weight
+		outWeight = oracleWeights[1].Weight
+	}
+
+	if poolTokenInBalance.IsZero() || inWeight.IsZero() || outWeight.IsZero() {
+		return sdk.ZeroDec(), fmt.Errorf("spot price undefined for %s/%s", tokenInDenom, tokenOutDenom)
+	}
+
+	// spot price = (balanceOut / weightOut) / (balanceIn / weightIn)
+	return poolTokenOutBalance.Quo(outWeight).Quo(poolTokenInBalance.Quo(inWeight)), nil
+}
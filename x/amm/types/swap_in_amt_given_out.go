@@ -79,8 +79,6 @@ func (p *Pool) SwapInAmtGivenOut(
 		return sdk.Coin{}, sdk.ZeroDec(), sdk.ZeroDec(), fmt.Errorf("price for outToken not set: %s", poolAssetOut.Token.Denom)
 	}
 
-	initialWeightDistance := p.WeightDistanceFromTarget(ctx, oracleKeeper, p.PoolAssets)
-
 	// in amount is calculated in this formula
 	// balancer slippage amount = Max(oracleOutAmount-balancerOutAmount, 0)
 	// resizedAmount = tokenIn / externalLiquidityRatio
@@ -101,28 +99,15 @@ func (p *Pool) SwapInAmtGivenOut(
 	}
 	inAmountAfterSlippage := oracleInAmount.Add(slippageAmount)
 
-	// calculate weight distance difference to calculate bonus/cut on the operation
-	newAssetPools, err := p.NewPoolAssetsAfterSwap(
+	weightBreakingFee, weightBalanceBonus, err := p.WeightBreakingFeeAndBonus(
+		ctx,
+		oracleKeeper,
 		sdk.Coins{sdk.NewCoin(tokenInDenom, inAmountAfterSlippage.TruncateInt())},
 		tokensOut,
 	)
 	if err != nil {
 		return sdk.Coin{}, sdk.ZeroDec(), sdk.ZeroDec(), err
 	}
-	weightDistance := p.WeightDistanceFromTarget(ctx, oracleKeeper, newAssetPools)
-	distanceDiff := weightDistance.Sub(initialWeightDistance)
-
-	// cut is valid when distance higher than original distance
-	weightBreakingFee := sdk.ZeroDec()
-	if distanceDiff.IsPositive() {
-		weightBreakingFee = p.PoolParams.WeightBreakingFeeMultiplier.Mul(distanceDiff)
-	}
-
-	// bonus is valid when distance is lower than original distance and when threshold weight reached
-	weightBalanceBonus = sdk.ZeroDec()
-	if initialWeightDistance.GT(p.PoolParams.ThresholdWeightDifference) && distanceDiff.IsNegative() {
-		weightBalanceBonus = p.PoolParams.WeightBreakingFeeMultiplier.Mul(distanceDiff).Abs()
-	}
 	tokenAmountInInt := inAmountAfterSlippage.
 		Mul(sdk.OneDec().Add(weightBreakingFee)).
 		Quo(sdk.OneDec().Sub(swapFee)).
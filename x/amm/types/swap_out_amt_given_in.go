@@ -172,8 +172,6 @@ func (p *Pool) SwapOutAmtGivenIn(
 		return sdk.Coin{}, sdk.ZeroDec(), sdk.ZeroDec(), fmt.Errorf("price for outToken not set: %s", poolAssetOut.Token.Denom)
 	}
 
-	initialWeightDistance := p.WeightDistanceFromTarget(ctx, oracleKeeper, p.PoolAssets)
-
 	// out amount is calculated in this formula
 	// balancer slippage amount = Max(oracleOutAmount-balancerOutAmount, 0)
 	// resizedAmount = tokenIn / externalLiquidityRatio
@@ -235,28 +233,15 @@ func (p *Pool) SwapOutAmtGivenIn(
 	// $1000 trade: 95%
 	// $10000 trade: 80%
 
-	// calculate weight distance difference to calculate bonus/cut on the operation
-	newAssetPools, err := p.NewPoolAssetsAfterSwap(
+	weightBreakingFee, weightBalanceBonus, err := p.WeightBreakingFeeAndBonus(
+		ctx,
+		oracleKeeper,
 		tokensIn,
 		sdk.Coins{sdk.NewCoin(tokenOutDenom, outAmountAfterSlippage.TruncateInt())},
 	)
 	if err != nil {
 		return sdk.Coin{}, sdk.ZeroDec(), sdk.ZeroDec(), err
 	}
-	weightDistance := p.WeightDistanceFromTarget(ctx, oracleKeeper, newAssetPools)
-	distanceDiff := weightDistance.Sub(initialWeightDistance)
-
-	// cut is valid when distance higher than original distance
-	weightBreakingFee := sdk.ZeroDec()
-	if distanceDiff.IsPositive() {
-		weightBreakingFee = p.PoolParams.WeightBreakingFeeMultiplier.Mul(distanceDiff)
-	}
-
-	// bonus is valid when distance is lower than original distance and when threshold weight reached
-	weightBalanceBonus = sdk.ZeroDec()
-	if initialWeightDistance.GT(p.PoolParams.ThresholdWeightDifference) && distanceDiff.IsNegative() {
-		weightBalanceBonus = p.PoolParams.WeightBreakingFeeMultiplier.Mul(distanceDiff).Abs()
-	}
 	tokenAmountOutInt := outAmountAfterSlippage.
 		Mul(sdk.OneDec().Sub(weightBreakingFee)).
 		Mul(sdk.OneDec().Sub(swapFee)).TruncateInt()
@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WeightBreakingFeeAndBonus calculates the weight breaking fee charged when the swap moves
+// the pool away from its target weights, and the weight balance bonus rewarded when the swap
+// moves an unbalanced pool towards its target weights.
+func (p Pool) WeightBreakingFeeAndBonus(
+	ctx sdk.Context,
+	oracleKeeper OracleKeeper,
+	tokensIn sdk.Coins,
+	tokensOut sdk.Coins,
+) (weightBreakingFee sdk.Dec, weightBalanceBonus sdk.Dec, err error) {
+	initialWeightDistance := p.WeightDistanceFromTarget(ctx, oracleKeeper, p.PoolAssets)
+
+	// calculate weight distance difference to calculate bonus/cut on the operation
+	newAssetPools, err := p.NewPoolAssetsAfterSwap(tokensIn, tokensOut)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+	weightDistance := p.WeightDistanceFromTarget(ctx, oracleKeeper, newAssetPools)
+	distanceDiff := weightDistance.Sub(initialWeightDistance)
+
+	// cut is valid when distance higher than original distance
+	weightBreakingFee = sdk.ZeroDec()
+	if distanceDiff.IsPositive() {
+		weightBreakingFee = p.PoolParams.WeightBreakingFeeMultiplier.Mul(distanceDiff)
+	}
+
+	// bonus is valid when distance is lower than original distance and when threshold weight reached
+	weightBalanceBonus = sdk.ZeroDec()
+	if initialWeightDistance.GT(p.PoolParams.ThresholdWeightDifference) && distanceDiff.IsNegative() {
+		weightBalanceBonus = p.PoolParams.WeightBreakingFeeMultiplier.Mul(distanceDiff).Abs()
+	}
+	return weightBreakingFee, weightBalanceBonus, nil
+}
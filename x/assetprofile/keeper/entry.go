@@ -34,6 +34,21 @@ func (k Keeper) GetEntry(
 	return val, true
 }
 
+// GetEntryByDenom returns the entry of a denom, looking it up by base denom first
+func (k Keeper) GetEntryByDenom(ctx sdk.Context, denom string) (val types.Entry, found bool) {
+	val, found = k.GetEntry(ctx, denom)
+	if found {
+		return val, true
+	}
+
+	for _, entry := range k.GetAllEntry(ctx) {
+		if entry.Denom == denom {
+			return entry, true
+		}
+	}
+	return val, false
+}
+
 // RemoveEntry removes a entry from the store
 func (k Keeper) RemoveEntry(
 	ctx sdk.Context,
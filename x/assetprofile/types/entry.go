@@ -0,0 +1,14 @@
+package types
+
+// PermissionPoolCreation allows the asset to be used in new amm pools
+const PermissionPoolCreation = "pool_creation"
+
+// HasPermission returns true when the entry grants the permission
+func (e Entry) HasPermission(permission string) bool {
+	for _, p := range e.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -14,6 +14,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set all the assetInfo
 	for _, commitment := range genState.Commitments {
 		k.SetCommitments(ctx, *commitment)
+		for _, lockup := range commitment.Lockups {
+			k.SetUnlockQueueEntry(ctx, lockup.UnlockTimestamp, commitment.Creator)
+		}
 	}
 }
 
@@ -94,7 +94,7 @@ func (k Keeper) DeductCommitments(ctx sdk.Context, creator string, denom string,
 
 		committedToken, found := commitments.GetCommittedTokensForDenom(denom)
 		if found {
-			if committedToken.Amount.GTE(difference) {
+			if commitments.GetUnlockedCommittedAmountForDenom(denom).GTE(difference) {
 				// Uncommit the required committed tokens
 				committedToken.Amount = committedToken.Amount.Sub(difference)
 				requestedAmount = requestedAmount.Sub(difference)
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/commitment/types"
+)
+
+// LockCommittedTokens locks amount of the creator committed denom until unlockTimestamp.
+// Locked tokens can't be uncommitted, boost is the reward multiplier of the locked amount.
+func (k Keeper) LockCommittedTokens(ctx sdk.Context, creator string, denom string, amount sdk.Int, unlockTimestamp uint64, boost sdk.Dec) error {
+	if !amount.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidAmount, "amount: %s", amount)
+	}
+
+	if unlockTimestamp <= uint64(ctx.BlockTime().Unix()) {
+		return sdkerrors.Wrapf(types.ErrInvalidAmount, "unlock timestamp %d is not in the future", unlockTimestamp)
+	}
+
+	commitments, found := k.GetCommitments(ctx, creator)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCommitmentsNotFound, "creator: %s", creator)
+	}
+
+	if commitments.GetUnlockedCommittedAmountForDenom(denom).LT(amount) {
+		return sdkerrors.Wrapf(types.ErrInsufficientCommittedTokens, "creator: %s, denom: %s", creator, denom)
+	}
+
+	commitments.Lockups = append(commitments.Lockups, &types.Lockup{
+		Denom:           denom,
+		Amount:          amount,
+		UnlockTimestamp: unlockTimestamp,
+		Boost:           boost,
+	})
+	k.SetCommitments(ctx, commitments)
+	k.SetUnlockQueueEntry(ctx, unlockTimestamp, creator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokensLocked,
+			sdk.NewAttribute(types.AttributeCreator, creator),
+			sdk.NewAttribute(types.AttributeAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeDenom, denom),
+			sdk.NewAttribute(types.AttributeUnlock, strconv.FormatUint(unlockTimestamp, 10)),
+			sdk.NewAttribute(types.AttributeBoost, boost.String()),
+		),
+	)
+	return nil
+}
+
+// SetUnlockQueueEntry records that creator has lockups ending at unlockTimestamp
+func (k Keeper) SetUnlockQueueEntry(ctx sdk.Context, unlockTimestamp uint64, creator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UnlockQueueKeyPrefix))
+	store.Set(types.UnlockQueueKey(unlockTimestamp, creator), []byte(creator))
+}
+
+// ProcessUnlockQueue releases the lockups that ended at or before the block time
+func (k Keeper) ProcessUnlockQueue(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UnlockQueueKeyPrefix))
+	now := uint64(ctx.BlockTime().Unix())
+
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(now+1))
+	defer iterator.Close()
+
+	matured := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		matured = append(matured, iterator.Key())
+		k.releaseLockups(ctx, string(iterator.Value()), now)
+	}
+
+	for _, key := range matured {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) releaseLockups(ctx sdk.Context, creator string, now uint64) {
+	commitments, found := k.GetCommitments(ctx, creator)
+	if !found {
+		return
+	}
+
+	lockups := []*types.Lockup{}
+	for _, lockup := range commitments.Lockups {
+		if lockup.UnlockTimestamp > now {
+			lockups = append(lockups, lockup)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTokensUnlocked,
+				sdk.NewAttribute(types.AttributeCreator, creator),
+				sdk.NewAttribute(types.AttributeAmount, lockup.Amount.String()),
+				sdk.NewAttribute(types.AttributeDenom, lockup.Denom),
+			),
+		)
+	}
+	commitments.Lockups = lockups
+	k.SetCommitments(ctx, commitments)
+}
@@ -35,6 +35,11 @@ func (k msgServer) UncommitTokens(goCtx context.Context, msg *types.MsgUncommitT
 		return nil, sdkerrors.Wrapf(types.ErrInsufficientCommittedTokens, "creator: %s, denom: %s", msg.Creator, msg.Denom)
 	}
 
+	// Locked tokens stay committed until their lockup ends
+	if commitments.GetUnlockedCommittedAmountForDenom(msg.Denom).LT(msg.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrCommittedTokensLocked, "creator: %s, denom: %s", msg.Creator, msg.Denom)
+	}
+
 	// Update the committed tokens amount
 	committedToken.Amount = committedToken.Amount.Sub(msg.Amount)
 
@@ -39,3 +39,34 @@ func (c *Commitments) GetCommittedAmountForDenom(denom string) sdk.Int {
 	}
 	return sdk.NewInt(0)
 }
+
+// GetLockedAmountForDenom returns the committed amount of denom under lockup
+func (c *Commitments) GetLockedAmountForDenom(denom string) sdk.Int {
+	locked := sdk.ZeroInt()
+	for _, lockup := range c.Lockups {
+		if lockup.Denom == denom {
+			locked = locked.Add(lockup.Amount)
+		}
+	}
+	return locked
+}
+
+// GetUnlockedCommittedAmountForDenom returns the committed amount of denom that can be uncommitted
+func (c *Commitments) GetUnlockedCommittedAmountForDenom(denom string) sdk.Int {
+	unlocked := c.GetCommittedAmountForDenom(denom).Sub(c.GetLockedAmountForDenom(denom))
+	if unlocked.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return unlocked
+}
+
+// GetLockBoostedAmountForDenom returns the committed amount of denom where locked tokens count boost times
+func (c *Commitments) GetLockBoostedAmountForDenom(denom string) sdk.Int {
+	boosted := sdk.NewDecFromInt(c.GetCommittedAmountForDenom(denom))
+	for _, lockup := range c.Lockups {
+		if lockup.Denom == denom && lockup.Boost.GT(sdk.OneDec()) {
+			boosted = boosted.Add(sdk.NewDecFromInt(lockup.Amount).Mul(lockup.Boost.Sub(sdk.OneDec())))
+		}
+	}
+	return boosted.TruncateInt()
+}
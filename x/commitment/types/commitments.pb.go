@@ -32,6 +32,7 @@ type Commitments struct {
 	CommittedTokens   []*CommittedTokens   `protobuf:"bytes,2,rep,name=committed_tokens,json=committedTokens,proto3" json:"committed_tokens,omitempty"`
 	UncommittedTokens []*UncommittedTokens `protobuf:"bytes,3,rep,name=uncommitted_tokens,json=uncommittedTokens,proto3" json:"uncommitted_tokens,omitempty"`
 	VestingTokens     []*VestingTokens     `protobuf:"bytes,4,rep,name=vesting_tokens,json=vestingTokens,proto3" json:"vesting_tokens,omitempty"`
+	Lockups           []*Lockup            `protobuf:"bytes,5,rep,name=lockups,proto3" json:"lockups,omitempty"`
 }
 
 func (m *Commitments) Reset()         { *m = Commitments{} }
@@ -95,6 +96,13 @@ func (m *Commitments) GetVestingTokens() []*VestingTokens {
 	return nil
 }
 
+func (m *Commitments) GetLockups() []*Lockup {
+	if m != nil {
+		return m.Lockups
+	}
+	return nil
+}
+
 type CommittedTokens struct {
 	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
@@ -255,47 +263,108 @@ func (m *VestingTokens) GetCurrentEpoch() int64 {
 	return 0
 }
 
+// Lockup locks committed tokens until unlock_timestamp, locked tokens
+// can't be uncommitted and may earn boosted rewards
+type Lockup struct {
+	Denom           string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	UnlockTimestamp uint64                                 `protobuf:"varint,3,opt,name=unlock_timestamp,json=unlockTimestamp,proto3" json:"unlock_timestamp,omitempty"`
+	Boost           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=boost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"boost"`
+}
+
+func (m *Lockup) Reset()         { *m = Lockup{} }
+func (m *Lockup) String() string { return proto.CompactTextString(m) }
+func (*Lockup) ProtoMessage()    {}
+func (*Lockup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47379c930fe66ed6, []int{4}
+}
+func (m *Lockup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lockup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lockup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Lockup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lockup.Merge(m, src)
+}
+func (m *Lockup) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lockup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lockup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lockup proto.InternalMessageInfo
+
+func (m *Lockup) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Lockup) GetUnlockTimestamp() uint64 {
+	if m != nil {
+		return m.UnlockTimestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Commitments)(nil), "elys.commitment.Commitments")
 	proto.RegisterType((*CommittedTokens)(nil), "elys.commitment.CommittedTokens")
 	proto.RegisterType((*UncommittedTokens)(nil), "elys.commitment.UncommittedTokens")
 	proto.RegisterType((*VestingTokens)(nil), "elys.commitment.VestingTokens")
+	proto.RegisterType((*Lockup)(nil), "elys.commitment.Lockup")
 }
 
 func init() { proto.RegisterFile("elys/commitment/commitments.proto", fileDescriptor_47379c930fe66ed6) }
 
 var fileDescriptor_47379c930fe66ed6 = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0xa4, 0x2d, 0xea, 0xa6, 0xa9, 0xdb, 0x55, 0x0f, 0x56, 0x05, 0x4e, 0x08, 0x12,
-	0x0a, 0x87, 0xda, 0x12, 0x3c, 0x01, 0xad, 0x8a, 0x14, 0x71, 0xaa, 0xc5, 0x87, 0xc4, 0x25, 0x72,
-	0xec, 0xad, 0x6b, 0x25, 0xbb, 0x63, 0x76, 0x67, 0x0b, 0x7d, 0x09, 0xc4, 0x23, 0x71, 0xec, 0xb1,
-	0x47, 0xc4, 0xa1, 0x42, 0xc9, 0x8b, 0x20, 0xaf, 0xd7, 0x34, 0x75, 0xc4, 0x05, 0xc4, 0xc9, 0x3b,
-	0xff, 0xfd, 0xcf, 0x6f, 0x3e, 0xe4, 0x25, 0x8f, 0xd9, 0xfc, 0x4a, 0x85, 0x09, 0x70, 0x9e, 0x23,
-	0x67, 0x02, 0x57, 0x8e, 0x2a, 0x28, 0x24, 0x20, 0x50, 0xb7, 0xb4, 0x04, 0x77, 0xfa, 0x61, 0x3f,
-	0x03, 0xc8, 0xe6, 0x2c, 0x34, 0xd7, 0x53, 0x7d, 0x1e, 0x62, 0xce, 0x99, 0xc2, 0x98, 0x17, 0x55,
-	0xc6, 0xa1, 0xdf, 0x34, 0xa4, 0x5a, 0xc6, 0x98, 0x83, 0xb0, 0xf7, 0x07, 0x19, 0x64, 0x60, 0x8e,
-	0x61, 0x79, 0xb2, 0xea, 0xc3, 0x66, 0x2b, 0x45, 0x2c, 0x63, 0x6e, 0xbb, 0x18, 0x7e, 0x69, 0x93,
-	0xee, 0xc9, 0x5d, 0x6f, 0xd4, 0x23, 0x0f, 0x12, 0xc9, 0x62, 0x04, 0xe9, 0x39, 0x03, 0x67, 0xb4,
-	0x1d, 0xd5, 0x21, 0x7d, 0x4d, 0xf6, 0x2a, 0x08, 0xb2, 0x74, 0x82, 0x30, 0x63, 0x42, 0x79, 0xed,
-	0x41, 0x67, 0xd4, 0x7d, 0x3e, 0x08, 0x1a, 0xa3, 0x04, 0x27, 0xb5, 0xf1, 0x8d, 0xf1, 0x45, 0x6e,
-	0x72, 0x5f, 0xa0, 0x67, 0x84, 0x6a, 0xb1, 0x86, 0xeb, 0x18, 0xdc, 0x70, 0x0d, 0xf7, 0x56, 0x34,
-	0xf2, 0xa3, 0x7d, 0xdd, 0x94, 0xe8, 0x29, 0xd9, 0xbd, 0x64, 0x0a, 0x73, 0x91, 0xd5, 0xb8, 0x0d,
-	0x83, 0xf3, 0xd7, 0x70, 0xef, 0x2a, 0x9b, 0x45, 0xf5, 0x2e, 0x57, 0xc3, 0x21, 0x10, 0xb7, 0xd1,
-	0x3d, 0x3d, 0x20, 0x9b, 0x29, 0x13, 0xc0, 0xed, 0x46, 0xaa, 0x80, 0xbe, 0x22, 0x5b, 0x31, 0x07,
-	0x2d, 0xd0, 0x6b, 0x97, 0xf2, 0x71, 0x70, 0x7d, 0xdb, 0x6f, 0xfd, 0xb8, 0xed, 0x3f, 0xcd, 0x72,
-	0xbc, 0xd0, 0xd3, 0xb2, 0x60, 0x98, 0x80, 0xe2, 0xa0, 0xec, 0xe7, 0x48, 0xa5, 0xb3, 0x10, 0xaf,
-	0x0a, 0xa6, 0x82, 0xb1, 0xc0, 0xc8, 0x66, 0x0f, 0x3f, 0x92, 0xfd, 0xb5, 0xf9, 0xfe, 0x73, 0xc9,
-	0x6f, 0x6d, 0xd2, 0xbb, 0xb7, 0x84, 0x3f, 0xd4, 0x3b, 0x23, 0x3b, 0x08, 0x18, 0xcf, 0x27, 0xff,
-	0x54, 0xb5, 0x6b, 0x18, 0x2f, 0x0d, 0x82, 0xbe, 0x27, 0xae, 0x16, 0xe5, 0xc6, 0x59, 0x5a, 0x53,
-	0x3b, 0x7f, 0x45, 0xdd, 0xad, 0x31, 0x16, 0xfc, 0x8c, 0xec, 0xb1, 0x02, 0x92, 0x8b, 0x49, 0x9e,
-	0x32, 0x81, 0xf9, 0x79, 0xce, 0xa4, 0xb7, 0x61, 0x86, 0x71, 0x8d, 0x3e, 0xfe, 0x2d, 0xd3, 0x47,
-	0x84, 0x08, 0xcd, 0x27, 0x46, 0x56, 0xde, 0xe6, 0xc0, 0x19, 0x75, 0xa2, 0x6d, 0xa1, 0xf9, 0xa9,
-	0x11, 0xe8, 0x13, 0xd2, 0x4b, 0xb4, 0x94, 0x4c, 0x60, 0x65, 0xf1, 0xb6, 0x8c, 0x63, 0xc7, 0x8a,
-	0xc6, 0x75, 0x3c, 0xbe, 0x5e, 0xf8, 0xce, 0xcd, 0xc2, 0x77, 0x7e, 0x2e, 0x7c, 0xe7, 0xeb, 0xd2,
-	0x6f, 0xdd, 0x2c, 0xfd, 0xd6, 0xf7, 0xa5, 0xdf, 0xfa, 0x10, 0xae, 0x0c, 0x50, 0xfe, 0x79, 0x47,
-	0x82, 0xe1, 0x27, 0x90, 0x33, 0x13, 0x84, 0x9f, 0x57, 0x5f, 0xa2, 0x99, 0x66, 0xba, 0x65, 0x5e,
-	0xe2, 0x8b, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x23, 0x9d, 0x32, 0xff, 0x34, 0x04, 0x00, 0x00,
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0xfc, 0xab, 0xba, 0x69, 0xea, 0x76, 0x55, 0xe9, 0x67, 0x55, 0x3f, 0x9c, 0x10,
+	0x24, 0x14, 0x0e, 0xb5, 0x05, 0x3c, 0x01, 0xfd, 0x83, 0x14, 0xc1, 0xa5, 0x56, 0x01, 0x89, 0x4b,
+	0xe4, 0xd8, 0x5b, 0xd7, 0x4a, 0x76, 0xc7, 0x78, 0x67, 0x0b, 0x7d, 0x0b, 0x1e, 0x09, 0x6e, 0x3d,
+	0xf6, 0x06, 0xe2, 0x50, 0xa1, 0xe4, 0x45, 0x90, 0xd7, 0x76, 0x9b, 0x3a, 0xe2, 0x00, 0xa8, 0xa7,
+	0xec, 0x7c, 0xf7, 0x3b, 0x9f, 0xd9, 0x19, 0x6f, 0x96, 0x3c, 0x64, 0xb3, 0x0b, 0xe9, 0x06, 0xc0,
+	0x79, 0x8c, 0x9c, 0x09, 0x5c, 0x5a, 0x4a, 0x27, 0x49, 0x01, 0x81, 0x9a, 0x99, 0xc5, 0xb9, 0xd5,
+	0x77, 0x7b, 0x11, 0x40, 0x34, 0x63, 0xae, 0xde, 0x9e, 0xa8, 0x53, 0x17, 0x63, 0xce, 0x24, 0xfa,
+	0x3c, 0xc9, 0x33, 0x76, 0xed, 0xaa, 0x21, 0x54, 0xa9, 0x8f, 0x31, 0x88, 0x62, 0x7f, 0x27, 0x82,
+	0x08, 0xf4, 0xd2, 0xcd, 0x56, 0x85, 0xfa, 0x7f, 0xf5, 0x28, 0x89, 0x9f, 0xfa, 0xbc, 0x38, 0xc5,
+	0xe0, 0x6b, 0x9d, 0x74, 0x0e, 0x6e, 0xcf, 0x46, 0x2d, 0xb2, 0x16, 0xa4, 0xcc, 0x47, 0x48, 0x2d,
+	0xa3, 0x6f, 0x0c, 0xd7, 0xbd, 0x32, 0xa4, 0xaf, 0xc8, 0x56, 0x0e, 0x41, 0x16, 0x8e, 0x11, 0xa6,
+	0x4c, 0x48, 0xab, 0xde, 0x6f, 0x0c, 0x3b, 0xcf, 0xfa, 0x4e, 0xa5, 0x15, 0xe7, 0xa0, 0x34, 0x9e,
+	0x68, 0x9f, 0x67, 0x06, 0x77, 0x05, 0x7a, 0x4c, 0xa8, 0x12, 0x2b, 0xb8, 0x86, 0xc6, 0x0d, 0x56,
+	0x70, 0x6f, 0x44, 0x25, 0xdf, 0xdb, 0x56, 0x55, 0x89, 0x1e, 0x91, 0xcd, 0x73, 0x26, 0x31, 0x16,
+	0x51, 0x89, 0x6b, 0x6a, 0x9c, 0xbd, 0x82, 0x7b, 0x9b, 0xdb, 0x0a, 0x54, 0xf7, 0x7c, 0x39, 0xa4,
+	0x4f, 0xc9, 0xda, 0x0c, 0x82, 0xa9, 0x4a, 0xa4, 0xd5, 0xd2, 0xf9, 0xff, 0xad, 0xe4, 0xbf, 0xd6,
+	0xfb, 0x5e, 0xe9, 0x1b, 0x00, 0x31, 0x2b, 0x0d, 0xd3, 0x1d, 0xd2, 0x0a, 0x99, 0x00, 0x5e, 0x0c,
+	0x31, 0x0f, 0xe8, 0x4b, 0xd2, 0xf6, 0x39, 0x28, 0x81, 0x56, 0x3d, 0x93, 0xf7, 0x9d, 0xcb, 0xeb,
+	0x5e, 0xed, 0xc7, 0x75, 0xef, 0x71, 0x14, 0xe3, 0x99, 0x9a, 0x64, 0x35, 0xdc, 0x00, 0x24, 0x07,
+	0x59, 0xfc, 0xec, 0xc9, 0x70, 0xea, 0xe2, 0x45, 0xc2, 0xa4, 0x33, 0x12, 0xe8, 0x15, 0xd9, 0x83,
+	0x0f, 0x64, 0x7b, 0x65, 0x24, 0xf7, 0x5c, 0xf2, 0x4b, 0x9d, 0x74, 0xef, 0xcc, 0xed, 0x37, 0xf5,
+	0x8e, 0xc9, 0x06, 0x02, 0xfa, 0xb3, 0xf1, 0x3f, 0x55, 0xed, 0x68, 0xc6, 0x0b, 0x8d, 0xa0, 0xef,
+	0x88, 0xa9, 0x44, 0xf6, 0x91, 0x58, 0x58, 0x52, 0x1b, 0x7f, 0x45, 0xdd, 0x2c, 0x31, 0x05, 0xf8,
+	0x09, 0xd9, 0x62, 0x09, 0x04, 0x67, 0xe3, 0x38, 0x64, 0x02, 0xe3, 0xd3, 0x98, 0xa5, 0x56, 0x53,
+	0x37, 0x63, 0x6a, 0x7d, 0x74, 0x23, 0xd3, 0x07, 0x84, 0x08, 0xc5, 0xc7, 0x5a, 0xce, 0x2e, 0x86,
+	0x31, 0x6c, 0x78, 0xeb, 0x42, 0xf1, 0x23, 0x2d, 0xd0, 0x47, 0xa4, 0x1b, 0xa8, 0x34, 0x65, 0x02,
+	0x73, 0x8b, 0xd5, 0xd6, 0x8e, 0x8d, 0x42, 0xd4, 0xae, 0xc1, 0x37, 0x83, 0xb4, 0xf3, 0xab, 0x73,
+	0xbf, 0xdf, 0x2a, 0xeb, 0x4b, 0x89, 0xec, 0x72, 0x8e, 0x6f, 0x5e, 0x10, 0x3d, 0xb1, 0xa6, 0x67,
+	0xe6, 0xfa, 0x49, 0x29, 0xd3, 0x43, 0xd2, 0x9a, 0x00, 0x48, 0xb4, 0x9a, 0x7f, 0x5c, 0xf1, 0x90,
+	0x05, 0x5e, 0x9e, 0xbc, 0x3f, 0xba, 0x9c, 0xdb, 0xc6, 0xd5, 0xdc, 0x36, 0x7e, 0xce, 0x6d, 0xe3,
+	0xf3, 0xc2, 0xae, 0x5d, 0x2d, 0xec, 0xda, 0xf7, 0x85, 0x5d, 0x7b, 0xef, 0x2e, 0x81, 0xb2, 0xbf,
+	0xd1, 0x9e, 0x60, 0xf8, 0x11, 0xd2, 0xa9, 0x0e, 0xdc, 0x4f, 0xcb, 0xcf, 0x92, 0xa6, 0x4e, 0xda,
+	0xfa, 0x59, 0x7a, 0xfe, 0x6b, 0x00, 0x28, 0xc0, 0x23, 0xb7, 0x41, 0x05, 0x00, 0x00,
 }
 
 func (m *Commitments) Marshal() (dAtA []byte, err error) {
@@ -318,6 +387,20 @@ func (m *Commitments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Lockups) > 0 {
+		for iNdEx := len(m.Lockups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lockups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommitments(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.VestingTokens) > 0 {
 		for iNdEx := len(m.VestingTokens) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -517,6 +600,61 @@ func (m *VestingTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Lockup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lockup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Lockup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Boost.Size()
+		i -= size
+		if _, err := m.Boost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCommitments(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.UnlockTimestamp != 0 {
+		i = encodeVarintCommitments(dAtA, i, uint64(m.UnlockTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCommitments(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCommitments(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCommitments(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCommitments(v)
 	base := offset
@@ -556,6 +694,12 @@ func (m *Commitments) Size() (n int) {
 			n += 1 + l + sovCommitments(uint64(l))
 		}
 	}
+	if len(m.Lockups) > 0 {
+		for _, e := range m.Lockups {
+			l = e.Size()
+			n += 1 + l + sovCommitments(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -616,6 +760,26 @@ func (m *VestingTokens) Size() (n int) {
 	return n
 }
 
+func (m *Lockup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCommitments(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCommitments(uint64(l))
+	if m.UnlockTimestamp != 0 {
+		n += 1 + sovCommitments(uint64(m.UnlockTimestamp))
+	}
+	l = m.Boost.Size()
+	n += 1 + l + sovCommitments(uint64(l))
+	return n
+}
+
 func sovCommitments(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -785,6 +949,40 @@ func (m *Commitments) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lockups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lockups = append(m.Lockups, &Lockup{})
+			if err := m.Lockups[len(m.Lockups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommitments(dAtA[iNdEx:])
@@ -1258,6 +1456,175 @@ func (m *VestingTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Lockup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommitments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lockup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lockup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTimestamp", wireType)
+			}
+			m.UnlockTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Boost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Boost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommitments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCommitments(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17,4 +17,5 @@ var (
 	ErrCommitDisabled                = sdkerrors.Register(ModuleName, 1007, "commitment disabled for denom")
 	ErrWithdrawDisabled              = sdkerrors.Register(ModuleName, 1008, "withdraw disabled for denom")
 	ErrExceedMaxVestings             = sdkerrors.Register(ModuleName, 1009, "exceed maximum allowed vestings")
+	ErrCommittedTokensLocked         = sdkerrors.Register(ModuleName, 1010, "committed tokens are locked")
 )
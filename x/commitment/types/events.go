@@ -3,8 +3,12 @@ package types
 // epochs events
 const (
 	EventTypeCommitmentChanged = "commitment_changed"
+	EventTypeTokensLocked      = "tokens_locked"
+	EventTypeTokensUnlocked    = "tokens_unlocked"
 
 	AttributeCreator = "creator"
 	AttributeAmount  = "token_amount"
 	AttributeDenom   = "token_denom"
+	AttributeUnlock  = "unlock_timestamp"
+	AttributeBoost   = "boost"
 )
@@ -1,12 +1,19 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 var _ binary.ByteOrder
 
 const (
 	// CommitmentsKeyPrefix is the prefix to retrieve all Commitments
 	CommitmentsKeyPrefix = "Commitments/value/"
+
+	// UnlockQueueKeyPrefix is the prefix of the lockups ordered by unlock time
+	UnlockQueueKeyPrefix = "UnlockQueue/value/"
 )
 
 // CommitmentsKey returns the store key to retrieve a Commitments from the index fields
@@ -21,3 +28,8 @@ func CommitmentsKey(
 
 	return key
 }
+
+// UnlockQueueKey returns the unlock queue key of the lockups of creator ending at unlockTimestamp
+func UnlockQueueKey(unlockTimestamp uint64, creator string) []byte {
+	return append(sdk.Uint64ToBigEndian(unlockTimestamp), CommitmentsKey(creator)...)
+}
@@ -20,6 +20,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdCommunityPool())
+	cmd.AddCommand(CmdGauge())
+	cmd.AddCommand(CmdPoolGauges())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
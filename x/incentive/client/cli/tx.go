@@ -45,6 +45,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdWithdrawRewardsCmd(),
 		CmdUpdatePoolInfoProposal(),
+		CmdCreateGauge(),
 	)
 
 	// this line is used by starport scaffolding # 1
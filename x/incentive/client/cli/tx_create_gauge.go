@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/incentive/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdCreateGauge returns a CLI command handler for creating a MsgCreateGauge transaction.
+func CmdCreateGauge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-gauge [pool-id] [coins] [start-time] [num-epochs]",
+		Short: "Fund a gauge streaming coins to the committed LP shares of a pool",
+		Long:  "start-time is a unix timestamp in seconds, 0 starts the gauge right away. e.g. create-gauge 1 1000000uatom 0 30",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid pool id")
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			startUnix, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid start time")
+			}
+			startTime := time.Time{}
+			if startUnix > 0 {
+				startTime = time.Unix(startUnix, 0).UTC()
+			}
+
+			numEpochs, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid num epochs")
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateGauge(clientCtx.GetFromAddress(), poolId, coins, startTime, numEpochs)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
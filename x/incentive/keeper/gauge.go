@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/incentive/types"
+)
+
+// GetGaugeCount returns the next gauge id
+func (k Keeper) GetGaugeCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefix(types.GaugeCountKey))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetGaugeCount sets the next gauge id
+func (k Keeper) SetGaugeCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.GaugeCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// SetGauge set a specific gauge in the store from its index
+func (k Keeper) SetGauge(ctx sdk.Context, gauge types.Gauge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GaugeKeyPrefix))
+	b := k.cdc.MustMarshal(&gauge)
+	store.Set(types.GaugeKey(gauge.Id), b)
+}
+
+// GetGauge returns a gauge from its index
+func (k Keeper) GetGauge(ctx sdk.Context, id uint64) (val types.Gauge, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GaugeKeyPrefix))
+
+	b := store.Get(types.GaugeKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveGauge removes a gauge from the store
+func (k Keeper) RemoveGauge(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GaugeKeyPrefix))
+	store.Delete(types.GaugeKey(id))
+}
+
+// GetAllGauges returns all gauges ordered by id
+func (k Keeper) GetAllGauges(ctx sdk.Context) (list []types.Gauge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GaugeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Gauge
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPoolGauges returns the gauges funding a pool
+func (k Keeper) GetPoolGauges(ctx sdk.Context, poolId uint64) (list []types.Gauge) {
+	for _, gauge := range k.GetAllGauges(ctx) {
+		if gauge.PoolId == poolId {
+			list = append(list, gauge)
+		}
+	}
+
+	return
+}
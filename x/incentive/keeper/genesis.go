@@ -10,6 +10,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetFeePool(ctx, data.FeePool)
 	k.SetParams(ctx, data.Params)
+
+	for _, gauge := range data.Gauges {
+		k.SetGauge(ctx, gauge)
+	}
+	k.SetGaugeCount(ctx, data.GaugeCount)
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -17,5 +22,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	feePool := k.GetFeePool(ctx)
 	params := k.GetParams(ctx)
 
-	return types.NewGenesisState(params, feePool)
+	genesis := types.NewGenesisState(params, feePool)
+	genesis.Gauges = k.GetAllGauges(ctx)
+	genesis.GaugeCount = k.GetGaugeCount(ctx)
+
+	return genesis
 }
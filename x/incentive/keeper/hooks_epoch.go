@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	epochstypes "github.com/elys-network/elys/x/epochs/types"
+	"github.com/elys-network/elys/x/incentive/types"
 )
 
 // BeforeEpochStart performs a no-op
@@ -12,6 +13,11 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 
 // AfterEpochEnd distributes vested tokens at the end of each epoch
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) {
+	// Stream externally funded gauges to LPs
+	if epochIdentifier == types.GaugeEpochIdentifier {
+		k.DistributeGauges(ctx)
+	}
+
 	// Find out incentive param using epochIdentifier and current block timestamp
 	foundIncentive, stakeIncentive, lpIncentive := k.GetProperIncentiveParam(ctx, epochIdentifier)
 
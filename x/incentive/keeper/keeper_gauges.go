@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	ctypes "github.com/elys-network/elys/x/commitment/types"
+	"github.com/elys-network/elys/x/incentive/types"
+)
+
+// CreateGauge charges the gauge creation fee to the owner and escrows coins from the owner into a new gauge for the pool
+func (k Keeper) CreateGauge(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, coins sdk.Coins, startTime time.Time, numEpochs int64) (uint64, error) {
+	if _, found := k.amm.GetPool(ctx, poolId); !found {
+		return 0, sdkerrors.Wrapf(ammtypes.ErrInvalidPoolId, "pool %d not found", poolId)
+	}
+
+	if maxEpochs := k.GetMaxGaugeEpochs(ctx); numEpochs > maxEpochs {
+		return 0, sdkerrors.Wrapf(types.ErrTooManyGaugeEpochs, "%d epochs exceeds the max of %d", numEpochs, maxEpochs)
+	}
+
+	if maxGauges := k.GetMaxGaugesPerPool(ctx); int64(len(k.GetPoolGauges(ctx, poolId))) >= maxGauges {
+		return 0, sdkerrors.Wrapf(types.ErrTooManyPoolGauges, "pool %d already has %d gauges", poolId, maxGauges)
+	}
+
+	// the creation fee is kept by the module and credited to the community pool
+	fee := k.GetGaugeCreationFee(ctx)
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, fee); err != nil {
+			return 0, err
+		}
+		feePool := k.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(fee...)...)
+		k.SetFeePool(ctx, feePool)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+
+	// a gauge without a future start time starts distributing right away
+	if startTime.Before(ctx.BlockTime()) {
+		startTime = ctx.BlockTime()
+	}
+
+	id := k.GetGaugeCount(ctx)
+	k.SetGauge(ctx, types.Gauge{
+		Id:               id,
+		Owner:            owner.String(),
+		PoolId:           poolId,
+		Coins:            coins,
+		DistributedCoins: sdk.Coins{},
+		StartTime:        startTime,
+		NumEpochs:        numEpochs,
+		FilledEpochs:     0,
+	})
+	k.SetGaugeCount(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGauge,
+			sdk.NewAttribute(types.AttributeKeyGaugeId, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyPoolId, fmt.Sprintf("%d", poolId)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	)
+
+	return id, nil
+}
+
+// DistributeGauges streams one epoch of every active gauge to the committed LP shares of its pool.
+// The epochs of all gauges of a pool are summed so each LP receives a single transfer per pool.
+// Coins not distributed in an epoch roll over, and whatever is left after the last epoch
+// is returned to the gauge owner.
+func (k Keeper) DistributeGauges(ctx sdk.Context) {
+	poolIds := []uint64{}
+	poolGauges := make(map[uint64][]types.Gauge)
+	for _, gauge := range k.GetAllGauges(ctx) {
+		if !gauge.IsActive(ctx) {
+			continue
+		}
+		if _, ok := poolGauges[gauge.PoolId]; !ok {
+			poolIds = append(poolIds, gauge.PoolId)
+		}
+		poolGauges[gauge.PoolId] = append(poolGauges[gauge.PoolId], gauge)
+	}
+
+	if len(poolIds) == 0 {
+		return
+	}
+
+	poolLps := make(map[uint64][]lpShare)
+	totalShares := make(map[uint64]sdk.Int)
+	for _, poolId := range poolIds {
+		totalShares[poolId] = sdk.ZeroInt()
+	}
+
+	k.cmk.IterateCommitments(ctx, func(commitments ctypes.Commitments) bool {
+		for _, poolId := range poolIds {
+			committed := commitments.GetCommittedAmountForDenom(ammtypes.GetPoolShareDenom(poolId))
+			if !committed.IsPositive() {
+				continue
+			}
+			poolLps[poolId] = append(poolLps[poolId], lpShare{address: commitments.Creator, shares: committed})
+			totalShares[poolId] = totalShares[poolId].Add(committed)
+		}
+		return false
+	})
+
+	for _, poolId := range poolIds {
+		cacheCtx, write := ctx.CacheContext()
+		err := k.distributePoolGauges(cacheCtx, poolGauges[poolId], poolLps[poolId], totalShares[poolId])
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to distribute gauges of pool %d: %s", poolId, err))
+			continue
+		}
+		write()
+	}
+}
+
+// lpShare is the committed pool share amount of an LP
+type lpShare struct {
+	address string
+	shares  sdk.Int
+}
+
+// distributePoolGauges pays one epoch of the gauges of a pool pro-rata to the given LPs, one transfer per LP
+func (k Keeper) distributePoolGauges(ctx sdk.Context, gauges []types.Gauge, lps []lpShare, totalShares sdk.Int) error {
+	epochCoins := make([]sdk.Coins, len(gauges))
+	distributed := make([]sdk.Coins, len(gauges))
+	for i, gauge := range gauges {
+		epochCoins[i] = gauge.EpochCoins()
+		distributed[i] = sdk.Coins{}
+	}
+
+	if totalShares.IsPositive() {
+		for _, lp := range lps {
+			reward := sdk.Coins{}
+			for i := range gauges {
+				for _, coin := range epochCoins[i] {
+					amount := coin.Amount.Mul(lp.shares).Quo(totalShares)
+					if !amount.IsPositive() {
+						continue
+					}
+					distributed[i] = distributed[i].Add(sdk.NewCoin(coin.Denom, amount))
+					reward = reward.Add(sdk.NewCoin(coin.Denom, amount))
+				}
+			}
+			if reward.IsZero() {
+				continue
+			}
+
+			addr, err := sdk.AccAddressFromBech32(lp.address)
+			if err != nil {
+				return err
+			}
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, reward)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, gauge := range gauges {
+		if err := k.fillGaugeEpoch(ctx, gauge, distributed[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fillGaugeEpoch records the coins distributed by a gauge in an epoch and closes the gauge after its last epoch
+func (k Keeper) fillGaugeEpoch(ctx sdk.Context, gauge types.Gauge, distributed sdk.Coins) error {
+	gauge.DistributedCoins = gauge.DistributedCoins.Add(distributed...)
+	gauge.FilledEpochs++
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGaugeDistribution,
+			sdk.NewAttribute(types.AttributeKeyGaugeId, fmt.Sprintf("%d", gauge.Id)),
+			sdk.NewAttribute(types.AttributeKeyPoolId, fmt.Sprintf("%d", gauge.PoolId)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, distributed.String()),
+		),
+	)
+
+	if gauge.FilledEpochs < gauge.NumEpochs {
+		k.SetGauge(ctx, gauge)
+		return nil
+	}
+
+	remaining := gauge.RemainingCoins()
+	if !remaining.IsZero() {
+		owner, err := sdk.AccAddressFromBech32(gauge.Owner)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, remaining)
+		if err != nil {
+			return err
+		}
+	}
+	k.RemoveGauge(ctx, gauge.Id)
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	simapp "github.com/elys-network/elys/app"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	ctypes "github.com/elys-network/elys/x/commitment/types"
+	"github.com/elys-network/elys/x/incentive/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGaugeDistribution(t *testing.T) {
+	app := simapp.InitElysTestApp(initChain)
+	ctx := app.BaseApp.NewContext(initChain, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	ik, bk, ck := app.IncentiveKeeper, app.BankKeeper, app.CommitmentKeeper
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000))
+	owner, lp1, lp2 := addr[0], addr[1], addr[2]
+
+	app.AmmKeeper.SetPool(ctx, ammtypes.Pool{PoolId: 1})
+
+	// lp1 holds a quarter of the committed shares and lp2 the rest
+	shareDenom := ammtypes.GetPoolShareDenom(1)
+	ck.SetCommitments(ctx, ctypes.Commitments{
+		Creator:         lp1.String(),
+		CommittedTokens: []*ctypes.CommittedTokens{{Denom: shareDenom, Amount: sdk.NewInt(100)}},
+	})
+	ck.SetCommitments(ctx, ctypes.Commitments{
+		Creator:         lp2.String(),
+		CommittedTokens: []*ctypes.CommittedTokens{{Denom: shareDenom, Amount: sdk.NewInt(300)}},
+	})
+
+	stakeDenom := app.StakingKeeper.BondDenom(ctx)
+	gaugeCoins := sdk.NewCoins(sdk.NewCoin(stakeDenom, sdk.NewInt(1000)))
+
+	params := ik.GetParams(ctx)
+	params.GaugeCreationFee = sdk.NewCoins(sdk.NewCoin(stakeDenom, sdk.NewInt(10)))
+	ik.SetParams(ctx, params)
+
+	// unknown pool
+	_, err := ik.CreateGauge(ctx, owner, 2, gaugeCoins, time.Time{}, 2)
+	require.Error(t, err)
+
+	// the creation fee goes to the community pool
+	communityPool := ik.GetFeePool(ctx).CommunityPool.AmountOf(stakeDenom)
+	id, err := ik.CreateGauge(ctx, owner, 1, gaugeCoins, time.Time{}, 2)
+	require.NoError(t, err)
+	require.Equal(t, bk.GetBalance(ctx, owner, stakeDenom).Amount, sdk.NewInt(998990))
+	require.Equal(t, ik.GetFeePool(ctx).CommunityPool.AmountOf(stakeDenom), communityPool.Add(sdk.NewDec(10)))
+	require.Len(t, ik.GetPoolGauges(ctx, 1), 1)
+
+	// first epoch streams half of the gauge
+	ik.AfterEpochEnd(ctx, types.GaugeEpochIdentifier, 1)
+	require.Equal(t, bk.GetBalance(ctx, lp1, stakeDenom).Amount, sdk.NewInt(1000125))
+	require.Equal(t, bk.GetBalance(ctx, lp2, stakeDenom).Amount, sdk.NewInt(1000375))
+
+	gauge, found := ik.GetGauge(ctx, id)
+	require.True(t, found)
+	require.Equal(t, gauge.FilledEpochs, int64(1))
+	require.Equal(t, gauge.DistributedCoins, sdk.NewCoins(sdk.NewCoin(stakeDenom, sdk.NewInt(500))))
+
+	// other epochs do not distribute
+	ik.AfterEpochEnd(ctx, "hour", 1)
+	gauge, _ = ik.GetGauge(ctx, id)
+	require.Equal(t, gauge.FilledEpochs, int64(1))
+
+	// last epoch streams the rest and closes the gauge
+	ik.AfterEpochEnd(ctx, types.GaugeEpochIdentifier, 2)
+	require.Equal(t, bk.GetBalance(ctx, lp1, stakeDenom).Amount, sdk.NewInt(1000250))
+	require.Equal(t, bk.GetBalance(ctx, lp2, stakeDenom).Amount, sdk.NewInt(1000750))
+	_, found = ik.GetGauge(ctx, id)
+	require.False(t, found)
+}
+
+func TestGaugeNotStarted(t *testing.T) {
+	app := simapp.InitElysTestApp(initChain)
+	ctx := app.BaseApp.NewContext(initChain, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	ik, bk := app.IncentiveKeeper, app.BankKeeper
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000))
+	app.AmmKeeper.SetPool(ctx, ammtypes.Pool{PoolId: 1})
+
+	stakeDenom := app.StakingKeeper.BondDenom(ctx)
+	gaugeCoins := sdk.NewCoins(sdk.NewCoin(stakeDenom, sdk.NewInt(1000)))
+
+	params := ik.GetParams(ctx)
+	params.GaugeCreationFee = sdk.Coins{}
+	ik.SetParams(ctx, params)
+
+	id, err := ik.CreateGauge(ctx, addr[0], 1, gaugeCoins, time.Unix(2000, 0), 1)
+	require.NoError(t, err)
+
+	ik.AfterEpochEnd(ctx, types.GaugeEpochIdentifier, 1)
+	gauge, found := ik.GetGauge(ctx, id)
+	require.True(t, found)
+	require.Equal(t, gauge.FilledEpochs, int64(0))
+
+	// without committed shares the whole gauge is returned to its owner
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	ik.AfterEpochEnd(ctx, types.GaugeEpochIdentifier, 2)
+	_, found = ik.GetGauge(ctx, id)
+	require.False(t, found)
+	require.Equal(t, bk.GetBalance(ctx, addr[0], stakeDenom).Amount, sdk.NewInt(1000000))
+}
+
+func TestGaugeLimits(t *testing.T) {
+	app := simapp.InitElysTestApp(initChain)
+	ctx := app.BaseApp.NewContext(initChain, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	ik := app.IncentiveKeeper
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000))
+	app.AmmKeeper.SetPool(ctx, ammtypes.Pool{PoolId: 1})
+
+	stakeDenom := app.StakingKeeper.BondDenom(ctx)
+	gaugeCoins := sdk.NewCoins(sdk.NewCoin(stakeDenom, sdk.NewInt(1000)))
+
+	params := ik.GetParams(ctx)
+	params.GaugeCreationFee = sdk.NewCoins(sdk.NewCoin(stakeDenom, sdk.NewInt(10)))
+	params.MaxGaugeEpochs = 2
+	params.MaxGaugesPerPool = 1
+	ik.SetParams(ctx, params)
+
+	_, err := ik.CreateGauge(ctx, addr[0], 1, gaugeCoins, time.Time{}, 3)
+	require.ErrorIs(t, err, types.ErrTooManyGaugeEpochs)
+
+	_, err = ik.CreateGauge(ctx, addr[0], 1, gaugeCoins, time.Time{}, 2)
+	require.NoError(t, err)
+	_, err = ik.CreateGauge(ctx, addr[0], 1, gaugeCoins, time.Time{}, 2)
+	require.ErrorIs(t, err, types.ErrTooManyPoolGauges)
+
+	// the fee is charged on top of the gauge coins
+	params.MaxGaugesPerPool = 2
+	ik.SetParams(ctx, params)
+	_, err = ik.CreateGauge(ctx, addr[0], 1, sdk.NewCoins(sdk.NewCoin(stakeDenom, sdk.NewInt(998990))), time.Time{}, 2)
+	require.Error(t, err)
+	require.Len(t, ik.GetPoolGauges(ctx, 1), 1)
+}
+
+func TestPoolGaugesPaidOnce(t *testing.T) {
+	app := simapp.InitElysTestApp(initChain)
+	ctx := app.BaseApp.NewContext(initChain, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	ik, bk, ck := app.IncentiveKeeper, app.BankKeeper, app.CommitmentKeeper
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000))
+	owner, lp1, lp2 := addr[0], addr[1], addr[2]
+
+	app.AmmKeeper.SetPool(ctx, ammtypes.Pool{PoolId: 1})
+
+	shareDenom := ammtypes.GetPoolShareDenom(1)
+	ck.SetCommitments(ctx, ctypes.Commitments{
+		Creator:         lp1.String(),
+		CommittedTokens: []*ctypes.CommittedTokens{{Denom: shareDenom, Amount: sdk.NewInt(100)}},
+	})
+	ck.SetCommitments(ctx, ctypes.Commitments{
+		Creator:         lp2.String(),
+		CommittedTokens: []*ctypes.CommittedTokens{{Denom: shareDenom, Amount: sdk.NewInt(300)}},
+	})
+
+	stakeDenom := app.StakingKeeper.BondDenom(ctx)
+	params := ik.GetParams(ctx)
+	params.GaugeCreationFee = sdk.Coins{}
+	ik.SetParams(ctx, params)
+
+	id1, err := ik.CreateGauge(ctx, owner, 1, sdk.NewCoins(sdk.NewCoin(stakeDenom, sdk.NewInt(1000))), time.Time{}, 1)
+	require.NoError(t, err)
+	id2, err := ik.CreateGauge(ctx, owner, 1, sdk.NewCoins(sdk.NewCoin(stakeDenom, sdk.NewInt(3000))), time.Time{}, 2)
+	require.NoError(t, err)
+
+	// both gauges are paid in a single transfer per LP
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ik.AfterEpochEnd(ctx, types.GaugeEpochIdentifier, 1)
+	require.Equal(t, bk.GetBalance(ctx, lp1, stakeDenom).Amount, sdk.NewInt(1000625))
+	require.Equal(t, bk.GetBalance(ctx, lp2, stakeDenom).Amount, sdk.NewInt(1001875))
+
+	transfers := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != banktypes.EventTypeTransfer {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == banktypes.AttributeKeyRecipient && attr.Value == lp1.String() {
+				transfers++
+			}
+		}
+	}
+	require.Equal(t, transfers, 1)
+
+	// each gauge records its own share of the distribution
+	_, found := ik.GetGauge(ctx, id1)
+	require.False(t, found)
+	gauge, found := ik.GetGauge(ctx, id2)
+	require.True(t, found)
+	require.Equal(t, gauge.FilledEpochs, int64(1))
+	require.Equal(t, gauge.DistributedCoins, sdk.NewCoins(sdk.NewCoin(stakeDenom, sdk.NewInt(1500))))
+}
@@ -60,8 +60,16 @@ func (k Keeper) CalculateRewardsForLPs(ctx sdk.Context, totalProxyTVL sdk.Dec, c
 		// Calculalte lp token share of the pool
 		lpShare := sdk.NewDecFromInt(commmittedLpToken).QuoInt(totalCommittedLpToken)
 
+		// Locked lp tokens count by their boost in the Eden share of the pool
+		edenLpShare := lpShare
+		totalBoostedLpToken, ok := k.tci.TotalLpTokensBoosted[lpToken]
+		if ok && totalBoostedLpToken.IsPositive() {
+			boostedLpToken := commitments.GetLockBoostedAmountForDenom(lpToken)
+			edenLpShare = sdk.NewDecFromInt(boostedLpToken).QuoInt(totalBoostedLpToken)
+		}
+
 		// Calculate new Eden allocated per LP
-		newEdenAllocated := lpShare.Mul(newEdenAllocatedForPool).TruncateInt()
+		newEdenAllocated := edenLpShare.Mul(newEdenAllocatedForPool).TruncateInt()
 
 		// Sum the total amount
 		totalNewEdenAllocated = totalNewEdenAllocated.Add(newEdenAllocated)
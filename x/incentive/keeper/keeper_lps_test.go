@@ -154,6 +154,8 @@ func TestCalculateRewardsForLPs(t *testing.T) {
 		FeeDenom:                    "",
 	}
 
+	simapp.AddTestPoolCreationPermissions(app, ctx, ptypes.Elys, ptypes.BaseCurrency)
+
 	// Create a Elys+USDC pool
 	msgServer := ammkeeper.NewMsgServerImpl(amm)
 	resp, err := msgServer.CreatePool(
@@ -198,3 +200,75 @@ func TestCalculateRewardsForLPs(t *testing.T) {
 	require.Equal(t, newUncommittedEdenTokensLp, sdk.NewInt(1000000))
 	require.Equal(t, dexRewardsLp, sdk.NewInt(1000))
 }
+
+func TestCalculateRewardsForLPsWithLockedShares(t *testing.T) {
+	app := simapp.InitElysTestApp(initChain)
+	ctx := app.BaseApp.NewContext(initChain, tmproto.Header{})
+
+	ik, amm, oracle, bk, ck := app.IncentiveKeeper, app.AmmKeeper, app.OracleKeeper, app.BankKeeper, app.CommitmentKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	// Generate 2 random accounts with 1000stake balanced
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(100010))
+
+	usdcToken := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)))
+	err := bk.MintCoins(ctx, ammtypes.ModuleName, usdcToken)
+	require.NoError(t, err)
+	err = bk.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], usdcToken)
+	require.NoError(t, err)
+
+	simapp.AddTestPoolCreationPermissions(app, ctx, ptypes.Elys, ptypes.BaseCurrency)
+
+	// Create a Elys+USDC pool
+	msgServer := ammkeeper.NewMsgServerImpl(amm)
+	_, err = msgServer.CreatePool(
+		sdk.WrapSDKContext(ctx),
+		&ammtypes.MsgCreatePool{
+			Sender: addr[0].String(),
+			PoolParams: &ammtypes.PoolParams{
+				SwapFee:                     sdk.ZeroDec(),
+				ExitFee:                     sdk.ZeroDec(),
+				WeightBreakingFeeMultiplier: sdk.ZeroDec(),
+				ExternalLiquidityRatio:      sdk.OneDec(),
+				LpFeePortion:                sdk.ZeroDec(),
+				StakingFeePortion:           sdk.ZeroDec(),
+				WeightRecoveryFeePortion:    sdk.ZeroDec(),
+				ThresholdWeightDifference:   sdk.ZeroDec(),
+			},
+			PoolAssets: []ammtypes.PoolAsset{
+				{Weight: sdk.NewInt(50), Token: sdk.NewCoin(ptypes.Elys, sdk.NewInt(1000))},
+				{Weight: sdk.NewInt(50), Token: sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100))},
+			},
+		})
+	require.NoError(t, err)
+
+	// second LP holds as many shares as the pool creator, half of them locked with a 3x boost
+	commitments0, found := ck.GetCommitments(ctx, addr[0].String())
+	require.True(t, found)
+	shares := commitments0.GetCommittedAmountForDenom("amm/pool/1")
+	commitments1 := ctypes.Commitments{
+		Creator:         addr[1].String(),
+		CommittedTokens: []*ctypes.CommittedTokens{{Denom: "amm/pool/1", Amount: shares}},
+		Lockups: []*ctypes.Lockup{{
+			Denom:           "amm/pool/1",
+			Amount:          shares.QuoRaw(2),
+			UnlockTimestamp: uint64(ctx.BlockTime().Unix()) + 86400,
+			Boost:           sdk.NewDec(3),
+		}},
+	}
+	ck.SetCommitments(ctx, commitments1)
+
+	edenAmountPerEpochLp := sdk.NewInt(900000)
+	totalProxyTVL := ik.CalculateProxyTVL(ctx)
+	ik.UpdateTotalCommitmentInfo(ctx)
+
+	// boosted amounts are 1x and 2x the shares, dex rewards follow the plain shares
+	gasFeesLPsAmt := sdk.NewDec(1000)
+	eden0, dex0 := ik.CalculateRewardsForLPs(ctx, totalProxyTVL, commitments0, edenAmountPerEpochLp, gasFeesLPsAmt)
+	eden1, dex1 := ik.CalculateRewardsForLPs(ctx, totalProxyTVL, commitments1, edenAmountPerEpochLp, gasFeesLPsAmt)
+	require.Equal(t, sdk.NewInt(299999), eden0) // truncated 1/3 share
+	require.Equal(t, sdk.NewInt(599999), eden1) // truncated 2/3 share
+	require.Equal(t, dex0, dex1)
+}
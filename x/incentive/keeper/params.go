@@ -21,6 +21,32 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
 
+// SetParamIfMissing sets a single param when it is not in the store yet
+func (k Keeper) SetParamIfMissing(ctx sdk.Context, key []byte, value interface{}) {
+	if k.paramstore.Has(ctx, key) {
+		return
+	}
+	k.paramstore.Set(ctx, key, value)
+}
+
+// GetGaugeCreationFee returns the fee paid to the community pool to create a gauge
+func (k Keeper) GetGaugeCreationFee(ctx sdk.Context) (fee sdk.Coins) {
+	k.paramstore.Get(ctx, types.ParamStoreKeyGaugeCreationFee, &fee)
+	return fee
+}
+
+// GetMaxGaugeEpochs returns the maximum number of epochs of a gauge
+func (k Keeper) GetMaxGaugeEpochs(ctx sdk.Context) (maxEpochs int64) {
+	k.paramstore.Get(ctx, types.ParamStoreKeyMaxGaugeEpochs, &maxEpochs)
+	return maxEpochs
+}
+
+// GetMaxGaugesPerPool returns the maximum number of gauges funding a pool
+func (k Keeper) GetMaxGaugesPerPool(ctx sdk.Context) (maxGauges int64) {
+	k.paramstore.Get(ctx, types.ParamStoreKeyMaxGaugesPerPool, &maxGauges)
+	return maxGauges
+}
+
 // GetCommunityTax returns the current distribution community tax.
 func (k Keeper) GetCommunityTax(ctx sdk.Context) (percent sdk.Dec) {
 	k.paramstore.Get(ctx, types.ParamStoreKeyCommunityTax, &percent)
@@ -184,6 +210,7 @@ func (k Keeper) UpdateTotalCommitmentInfo(ctx sdk.Context) {
 	k.tci.TotalFeesCollected = sdk.Coins{}
 	// Initialize Lp tokens amount
 	k.tci.TotalLpTokensCommitted = make(map[string]sdk.Int)
+	k.tci.TotalLpTokensBoosted = make(map[string]sdk.Int)
 	// ReInitialize Pool revenue tracker
 	k.tci.PoolRevenueTrack = make(map[string]sdk.Dec)
 
@@ -210,6 +237,14 @@ func (k Keeper) UpdateTotalCommitmentInfo(ctx sdk.Context) {
 			} else {
 				k.tci.TotalLpTokensCommitted[lpToken] = amt.Add(committedLpToken)
 			}
+
+			boostedLpToken := commitments.GetLockBoostedAmountForDenom(lpToken)
+			boosted, ok := k.tci.TotalLpTokensBoosted[lpToken]
+			if !ok {
+				k.tci.TotalLpTokensBoosted[lpToken] = boostedLpToken
+			} else {
+				k.tci.TotalLpTokensBoosted[lpToken] = boosted.Add(boostedLpToken)
+			}
 			return false
 		})
 		return false
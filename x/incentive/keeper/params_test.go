@@ -72,6 +72,8 @@ func TestUpdatePoolMultiplierInfo(t *testing.T) {
 		FeeDenom:                    "",
 	}
 
+	simapp.AddTestPoolCreationPermissions(app, ctx, ptypes.Elys, ptypes.BaseCurrency)
+
 	// Create a Elys+USDC pool
 	msgServer := ammkeeper.NewMsgServerImpl(amm)
 	resp, err := msgServer.CreatePool(
@@ -28,3 +28,26 @@ func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*t
 
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
+
+func (k Keeper) Gauge(goCtx context.Context, req *types.QueryGaugeRequest) (*types.QueryGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	gauge, found := k.GetGauge(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "gauge not found")
+	}
+
+	return &types.QueryGaugeResponse{Gauge: gauge}, nil
+}
+
+func (k Keeper) PoolGauges(goCtx context.Context, req *types.QueryPoolGaugesRequest) (*types.QueryPoolGaugesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPoolGaugesResponse{Gauges: k.GetPoolGauges(ctx, req.PoolId)}, nil
+}
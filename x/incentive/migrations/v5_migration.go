@@ -0,0 +1,15 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/incentive/types"
+)
+
+// V5Migration sets the gauge params added in v5 without resetting the existing ones
+func (m Migrator) V5Migration(ctx sdk.Context) error {
+	params := types.NewParams()
+	m.keeper.SetParamIfMissing(ctx, types.ParamStoreKeyGaugeCreationFee, params.GaugeCreationFee)
+	m.keeper.SetParamIfMissing(ctx, types.ParamStoreKeyMaxGaugeEpochs, params.MaxGaugeEpochs)
+	m.keeper.SetParamIfMissing(ctx, types.ParamStoreKeyMaxGaugesPerPool, params.MaxGaugesPerPool)
+	return nil
+}
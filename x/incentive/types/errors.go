@@ -20,4 +20,7 @@ var (
 	ErrEmptyProposalRecipient  = sdkerrors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
 	ErrNoValidatorExists       = sdkerrors.Register(ModuleName, 12, "validator does not exist")
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
+	ErrGaugeNotFound           = sdkerrors.Register(ModuleName, 14, "gauge not found")
+	ErrTooManyGaugeEpochs      = sdkerrors.Register(ModuleName, 15, "too many gauge epochs")
+	ErrTooManyPoolGauges       = sdkerrors.Register(ModuleName, 16, "too many gauges for pool")
 )
@@ -8,9 +8,13 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeCreateGauge        = "create_gauge"
+	EventTypeGaugeDistribution  = "gauge_distribution"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyGaugeId         = "gauge_id"
+	AttributeKeyPoolId          = "pool_id"
 
 	AttributeValueCategory = ModuleName
 )
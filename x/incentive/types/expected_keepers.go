@@ -110,6 +110,7 @@ type AmmKeeper interface {
 type OracleKeeper interface {
 	GetAssetPrice(ctx sdk.Context, asset string) (oracletypes.Price, bool)
 	GetAssetPriceFromDenom(ctx sdk.Context, denom string) sdk.Dec
+	GetAssetPriceHistoryFromDenom(ctx sdk.Context, denom string, since uint64, limit int) []sdk.Dec
 	GetPriceFeeder(ctx sdk.Context, feeder string) (val oracletypes.PriceFeeder, found bool)
 }
 
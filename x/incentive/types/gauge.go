@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/elys-network/elys/x/epochs/types"
+)
+
+// GaugeEpochIdentifier is the epoch on which gauges distribute
+const GaugeEpochIdentifier = epochstypes.DayEpochID
+
+// IsActive returns true if the gauge has started and still has epochs to fill
+func (g Gauge) IsActive(ctx sdk.Context) bool {
+	return !ctx.BlockTime().Before(g.StartTime) && g.FilledEpochs < g.NumEpochs
+}
+
+// RemainingCoins returns the coins not yet distributed
+func (g Gauge) RemainingCoins() sdk.Coins {
+	return g.Coins.Sub(g.DistributedCoins...)
+}
+
+// EpochCoins returns the coins to distribute in the next epoch
+func (g Gauge) EpochCoins() sdk.Coins {
+	remainingEpochs := sdk.NewInt(g.NumEpochs - g.FilledEpochs)
+	epochCoins := sdk.Coins{}
+	for _, coin := range g.RemainingCoins() {
+		epochCoins = epochCoins.Add(sdk.NewCoin(coin.Denom, coin.Amount.Quo(remainingEpochs)))
+	}
+	return epochCoins
+}
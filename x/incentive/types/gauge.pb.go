@@ -0,0 +1,658 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: elys/incentive/gauge.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Gauge streams externally funded coins to the committed LP shares of a pool
+type Gauge struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// account that funded the gauge
+	Owner  string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// total coins escrowed for distribution
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// coins distributed so far
+	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// start_time of the distribution
+	StartTime time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// distribution duration
+	NumEpochs    int64 `protobuf:"varint,7,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
+	FilledEpochs int64 `protobuf:"varint,8,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
+}
+
+func (m *Gauge) Reset()         { *m = Gauge{} }
+func (m *Gauge) String() string { return proto.CompactTextString(m) }
+func (*Gauge) ProtoMessage()    {}
+func (*Gauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7d45c95029e26da, []int{0}
+}
+func (m *Gauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Gauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Gauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Gauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gauge.Merge(m, src)
+}
+func (m *Gauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Gauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gauge proto.InternalMessageInfo
+
+func (m *Gauge) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Gauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Gauge) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *Gauge) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DistributedCoins
+	}
+	return nil
+}
+
+func (m *Gauge) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Gauge) GetNumEpochs() int64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
+}
+
+func (m *Gauge) GetFilledEpochs() int64 {
+	if m != nil {
+		return m.FilledEpochs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Gauge)(nil), "elys.incentive.Gauge")
+}
+
+func init() { proto.RegisterFile("elys/incentive/gauge.proto", fileDescriptor_c7d45c95029e26da) }
+
+var fileDescriptor_c7d45c95029e26da = []byte{
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x4f, 0x6e, 0xd4, 0x30,
+	0x18, 0xc5, 0xc7, 0x33, 0xcd, 0xb4, 0xe3, 0x42, 0x05, 0x56, 0x25, 0x42, 0x24, 0x92, 0x08, 0x36,
+	0xd9, 0xd4, 0xa6, 0xe5, 0x06, 0x53, 0x21, 0x60, 0x1b, 0xb1, 0x62, 0x13, 0x25, 0xb1, 0x9b, 0x5a,
+	0x4d, 0xfc, 0x45, 0xb1, 0xd3, 0x3f, 0xb7, 0xe8, 0x39, 0x10, 0x07, 0xe9, 0xb2, 0x4b, 0x56, 0x14,
+	0xcd, 0x5c, 0x04, 0xd9, 0x9e, 0x41, 0x73, 0x80, 0xae, 0x92, 0xf7, 0xbd, 0x67, 0xff, 0xec, 0x27,
+	0xe3, 0x48, 0xb4, 0x77, 0x9a, 0x49, 0x55, 0x0b, 0x65, 0xe4, 0xb5, 0x60, 0x4d, 0x39, 0x36, 0x82,
+	0xf6, 0x03, 0x18, 0x20, 0x47, 0xd6, 0xa3, 0xff, 0xbd, 0xe8, 0xb8, 0x81, 0x06, 0x9c, 0xc5, 0xec,
+	0x9f, 0x4f, 0x45, 0x49, 0x03, 0xd0, 0xb4, 0x82, 0x39, 0x55, 0x8d, 0x17, 0xcc, 0xc8, 0x4e, 0x68,
+	0x53, 0x76, 0xfd, 0x26, 0x10, 0xd7, 0xa0, 0x3b, 0xd0, 0xac, 0x2a, 0xb5, 0x60, 0xd7, 0xa7, 0x95,
+	0x30, 0xe5, 0x29, 0xab, 0x41, 0x2a, 0xef, 0xbf, 0xff, 0x35, 0xc3, 0xc1, 0x17, 0x8b, 0x25, 0x47,
+	0x78, 0x2a, 0x79, 0x88, 0x52, 0x94, 0xed, 0xe5, 0x53, 0xc9, 0xc9, 0x31, 0x0e, 0xe0, 0x46, 0x89,
+	0x21, 0x9c, 0xa6, 0x28, 0x5b, 0xe4, 0x5e, 0x90, 0x37, 0x78, 0xbf, 0x07, 0x68, 0x0b, 0xc9, 0xc3,
+	0x99, 0x8b, 0xce, 0xad, 0xfc, 0xc6, 0x49, 0x89, 0x03, 0xbb, 0xad, 0x0e, 0xf7, 0xd2, 0x59, 0x76,
+	0x78, 0xf6, 0x96, 0x7a, 0x30, 0xb5, 0x60, 0xba, 0x01, 0xd3, 0x73, 0x90, 0x6a, 0xf9, 0xf1, 0xe1,
+	0x4f, 0x32, 0xf9, 0xf9, 0x94, 0x64, 0x8d, 0x34, 0x97, 0x63, 0x45, 0x6b, 0xe8, 0xd8, 0xe6, 0x94,
+	0xfe, 0x73, 0xa2, 0xf9, 0x15, 0x33, 0x77, 0xbd, 0xd0, 0x6e, 0x81, 0xce, 0xfd, 0xce, 0xe4, 0x16,
+	0xbf, 0xe6, 0x52, 0x9b, 0x41, 0x56, 0xa3, 0x11, 0xbc, 0xf0, 0xb8, 0xe0, 0xf9, 0x71, 0xaf, 0x76,
+	0x28, 0x6e, 0x42, 0xce, 0x31, 0xd6, 0xa6, 0x1c, 0x4c, 0x61, 0xeb, 0x0d, 0xe7, 0x29, 0xca, 0x0e,
+	0xcf, 0x22, 0xea, 0xbb, 0xa7, 0xdb, 0xee, 0xe9, 0xf7, 0x6d, 0xf7, 0xcb, 0x03, 0xcb, 0xbc, 0x7f,
+	0x4a, 0x50, 0xbe, 0x70, 0xeb, 0xac, 0x43, 0xde, 0x61, 0xac, 0xc6, 0xae, 0x10, 0x3d, 0xd4, 0x97,
+	0x3a, 0xdc, 0x4f, 0x51, 0x36, 0xcb, 0x17, 0x6a, 0xec, 0x3e, 0xbb, 0x01, 0xf9, 0x80, 0x5f, 0x5e,
+	0xc8, 0xb6, 0x15, 0x7c, 0x9b, 0x38, 0x70, 0x89, 0x17, 0x7e, 0xe8, 0x43, 0xcb, 0xaf, 0x0f, 0xab,
+	0x18, 0x3d, 0xae, 0x62, 0xf4, 0x77, 0x15, 0xa3, 0xfb, 0x75, 0x3c, 0x79, 0x5c, 0xc7, 0x93, 0xdf,
+	0xeb, 0x78, 0xf2, 0x83, 0xee, 0x5c, 0xcf, 0x3e, 0x9d, 0x13, 0x25, 0xcc, 0x0d, 0x0c, 0x57, 0x4e,
+	0xb0, 0xdb, 0x9d, 0x57, 0xe6, 0xae, 0x5a, 0xcd, 0xdd, 0xb1, 0x3f, 0xfd, 0x1b, 0x00, 0x19, 0xa1,
+	0x7f, 0x6f, 0x84, 0x02, 0x00, 0x00,
+}
+
+func (m *Gauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FilledEpochs != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.FilledEpochs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NumEpochs != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x38
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGauge(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.DistributedCoins) > 0 {
+		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGauge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGauge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Gauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGauge(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGauge(uint64(m.PoolId))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	if len(m.DistributedCoins) > 0 {
+		for _, e := range m.DistributedCoins {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovGauge(uint64(l))
+	if m.NumEpochs != 0 {
+		n += 1 + sovGauge(uint64(m.NumEpochs))
+	}
+	if m.FilledEpochs != 0 {
+		n += 1 + sovGauge(uint64(m.FilledEpochs))
+	}
+	return n
+}
+
+func sovGauge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGauge(x uint64) (n int) {
+	return sovGauge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledEpochs", wireType)
+			}
+			m.FilledEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilledEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGauge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGauge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGauge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGauge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGauge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGauge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGauge = fmt.Errorf("proto: unexpected end of group")
+)
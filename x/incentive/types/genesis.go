@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
@@ -30,5 +32,16 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	gaugeIds := make(map[uint64]bool)
+	for _, gauge := range gs.Gauges {
+		if gaugeIds[gauge.Id] {
+			return fmt.Errorf("duplicated id for gauge")
+		}
+		if gauge.Id >= gs.GaugeCount {
+			return fmt.Errorf("gauge id should be lower than gauge count")
+		}
+		gaugeIds[gauge.Id] = true
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
@@ -28,7 +28,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// fee_pool defines the fee pool at genesis.
-	FeePool FeePool `protobuf:"bytes,2,opt,name=fee_pool,json=feePool,proto3" json:"fee_pool"`
+	FeePool    FeePool `protobuf:"bytes,2,opt,name=fee_pool,json=feePool,proto3" json:"fee_pool"`
+	Gauges     []Gauge `protobuf:"bytes,3,rep,name=gauges,proto3" json:"gauges"`
+	GaugeCount uint64  `protobuf:"varint,4,opt,name=gauge_count,json=gaugeCount,proto3" json:"gauge_count,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +80,20 @@ func (m *GenesisState) GetFeePool() FeePool {
 	return FeePool{}
 }
 
+func (m *GenesisState) GetGauges() []Gauge {
+	if m != nil {
+		return m.Gauges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGaugeCount() uint64 {
+	if m != nil {
+		return m.GaugeCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "elys.incentive.GenesisState")
 }
@@ -85,24 +101,27 @@ func init() {
 func init() { proto.RegisterFile("elys/incentive/genesis.proto", fileDescriptor_83b8e7899b41b162) }
 
 var fileDescriptor_83b8e7899b41b162 = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x8f, 0x3f, 0x4f, 0xc3, 0x30,
-	0x14, 0xc4, 0x13, 0x84, 0x0a, 0x0a, 0x88, 0x21, 0x42, 0x50, 0x05, 0x64, 0xfe, 0x4c, 0x2c, 0xd8,
-	0x2a, 0x30, 0x30, 0x33, 0x00, 0x63, 0x05, 0x1b, 0x0b, 0xb2, 0xc3, 0x6b, 0xb0, 0x48, 0xfc, 0xa2,
-	0xf8, 0xb5, 0xd0, 0x85, 0xcf, 0xc0, 0xc7, 0xea, 0xd8, 0x91, 0x09, 0xa1, 0xe4, 0x8b, 0xa0, 0x38,
-	0x16, 0x82, 0x6c, 0x7e, 0xfa, 0xdd, 0x9d, 0xef, 0xa2, 0x7d, 0xc8, 0xe7, 0x56, 0x68, 0x93, 0x82,
-	0x21, 0x3d, 0x03, 0x91, 0x81, 0x01, 0xab, 0x2d, 0x2f, 0x2b, 0x24, 0x8c, 0xb7, 0x5a, 0xca, 0x7f,
-	0x69, 0xb2, 0x9d, 0x61, 0x86, 0x0e, 0x89, 0xf6, 0xd5, 0xa9, 0x92, 0xbd, 0x5e, 0x46, 0x29, 0x2b,
-	0x59, 0xf8, 0x88, 0xe4, 0xa8, 0x07, 0x9f, 0xb4, 0xa5, 0x4a, 0xab, 0x29, 0x69, 0x34, 0x5e, 0xc2,
-	0x52, 0xb4, 0x05, 0x5a, 0xa1, 0xa4, 0x05, 0x31, 0x1b, 0x29, 0x20, 0x39, 0x12, 0x29, 0x6a, 0xcf,
-	0x8f, 0xdf, 0xa3, 0xcd, 0x9b, 0xae, 0xd6, 0x3d, 0x49, 0x82, 0xf8, 0x22, 0x1a, 0x74, 0x5f, 0x0c,
-	0xc3, 0xc3, 0xf0, 0x64, 0xe3, 0x6c, 0x87, 0xff, 0xaf, 0xc9, 0xc7, 0x8e, 0x5e, 0xad, 0x2e, 0xbe,
-	0x0e, 0x82, 0x3b, 0xaf, 0x8d, 0x2f, 0xa3, 0xf5, 0x09, 0xc0, 0x63, 0x89, 0x98, 0x0f, 0x57, 0x9c,
-	0x6f, 0xb7, 0xef, 0xbb, 0x06, 0x18, 0x23, 0xe6, 0xde, 0xb8, 0x36, 0xf1, 0xe7, 0xed, 0xa2, 0x66,
-	0xe1, 0xb2, 0x66, 0xe1, 0x77, 0xcd, 0xc2, 0x8f, 0x86, 0x05, 0xcb, 0x86, 0x05, 0x9f, 0x0d, 0x0b,
-	0x1e, 0x78, 0xa6, 0xe9, 0x79, 0xaa, 0x78, 0x8a, 0x85, 0x68, 0xb3, 0x4e, 0x0d, 0xd0, 0x2b, 0x56,
-	0x2f, 0xee, 0x10, 0x6f, 0x7f, 0x66, 0xd3, 0xbc, 0x04, 0xab, 0x06, 0x6e, 0xd0, 0xf9, 0x4f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xc7, 0xb6, 0xb7, 0xf0, 0x76, 0x01, 0x00, 0x00,
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0x63, 0x5a, 0x15, 0xe4, 0x22, 0x86, 0x88, 0x3f, 0x51, 0x40, 0x6e, 0x61, 0xea, 0x42,
+	0xac, 0xb6, 0x0c, 0xcc, 0x45, 0xa2, 0x8c, 0x55, 0xd9, 0x58, 0xaa, 0x24, 0x5c, 0x83, 0x45, 0xeb,
+	0x8b, 0x62, 0xa7, 0xd0, 0xb7, 0xe0, 0xb1, 0x3a, 0x76, 0x60, 0x60, 0x42, 0xa8, 0x7d, 0x11, 0x14,
+	0xc7, 0x20, 0xc8, 0xe6, 0xbb, 0xdf, 0xf7, 0xdd, 0x7d, 0x3e, 0x7a, 0x06, 0xb3, 0xa5, 0xe2, 0x42,
+	0xc6, 0x20, 0xb5, 0x58, 0x00, 0x4f, 0x40, 0x82, 0x12, 0x2a, 0x48, 0x33, 0xd4, 0xe8, 0x1e, 0x14,
+	0x34, 0xf8, 0xa5, 0xfe, 0x61, 0x82, 0x09, 0x1a, 0xc4, 0x8b, 0x57, 0xa9, 0xf2, 0x4f, 0x2b, 0x33,
+	0xd2, 0x30, 0x0b, 0xe7, 0x76, 0x84, 0x7f, 0x5e, 0x81, 0x8f, 0x42, 0xe9, 0x4c, 0x44, 0xb9, 0x16,
+	0x28, 0xad, 0xc4, 0xaf, 0x66, 0x08, 0xf3, 0x04, 0x2c, 0x63, 0x31, 0xaa, 0x39, 0x2a, 0x1e, 0x85,
+	0x0a, 0xf8, 0xa2, 0x1b, 0x81, 0x0e, 0xbb, 0x3c, 0x46, 0x61, 0xbd, 0x17, 0xef, 0x84, 0xee, 0x0f,
+	0xcb, 0xcc, 0xf7, 0x3a, 0xd4, 0xe0, 0x5e, 0xd1, 0x46, 0xb9, 0xdf, 0x23, 0x6d, 0xd2, 0x69, 0xf6,
+	0x8e, 0x83, 0xff, 0x7f, 0x08, 0x46, 0x86, 0x0e, 0xea, 0xab, 0xcf, 0x96, 0x33, 0xb6, 0x5a, 0xf7,
+	0x9a, 0xee, 0x4d, 0x01, 0x26, 0x29, 0xe2, 0xcc, 0xdb, 0x31, 0xbe, 0x93, 0xaa, 0xef, 0x16, 0x60,
+	0x84, 0x38, 0xb3, 0xc6, 0xdd, 0x69, 0x59, 0xba, 0x7d, 0xda, 0x30, 0x79, 0x95, 0x57, 0x6b, 0xd7,
+	0x3a, 0xcd, 0xde, 0x51, 0xd5, 0x37, 0x2c, 0xe8, 0xcf, 0xba, 0x52, 0xea, 0xb6, 0x68, 0xd3, 0xbc,
+	0x26, 0x31, 0xe6, 0x52, 0x7b, 0xf5, 0x36, 0xe9, 0xd4, 0xc7, 0xd4, 0xb4, 0x6e, 0x8a, 0xce, 0xe0,
+	0x6e, 0xb5, 0x61, 0x64, 0xbd, 0x61, 0xe4, 0x6b, 0xc3, 0xc8, 0xdb, 0x96, 0x39, 0xeb, 0x2d, 0x73,
+	0x3e, 0xb6, 0xcc, 0x79, 0x08, 0x12, 0xa1, 0x9f, 0xf2, 0x28, 0x88, 0x71, 0xce, 0x8b, 0x4d, 0x97,
+	0x12, 0xf4, 0x0b, 0x66, 0xcf, 0xa6, 0xe0, 0xaf, 0x7f, 0xce, 0xa8, 0x97, 0x29, 0xa8, 0xa8, 0x61,
+	0xee, 0xd4, 0xff, 0x1e, 0x00, 0xc9, 0x56, 0x81, 0x1e, 0xe9, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +144,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GaugeCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GaugeCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.FeePool.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -169,6 +207,15 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.FeePool.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.GaugeCount != 0 {
+		n += 1 + sovGenesis(uint64(m.GaugeCount))
+	}
 	return n
 }
 
@@ -273,6 +320,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeCount", wireType)
+			}
+			m.GaugeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -18,6 +18,26 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "duplicated gauge",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				FeePool:    types.InitialFeePool(),
+				Gauges:     []types.Gauge{{Id: 0}, {Id: 0}},
+				GaugeCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid gauge count",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				FeePool:    types.InitialFeePool(),
+				Gauges:     []types.Gauge{{Id: 1}},
+				GaugeCount: 1,
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "incentive"
@@ -14,6 +16,9 @@ const (
 	MemStoreKey = "mem_incentive"
 
 	ElysStakedKeyPrefix = "ElysStaked/value/"
+
+	GaugeKeyPrefix = "Gauge/value/"
+	GaugeCountKey  = "Gauge/count/"
 )
 
 func KeyPrefix(p string) []byte {
@@ -36,3 +41,8 @@ func ElysStakedKey(
 
 	return key
 }
+
+// GaugeKey returns the store key to retrieve a Gauge from the id field
+func GaugeKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateGauge = "create_gauge"
+
+var _ sdk.Msg = &MsgCreateGauge{}
+
+func NewMsgCreateGauge(owner sdk.AccAddress, poolId uint64, coins sdk.Coins, startTime time.Time, numEpochs int64) *MsgCreateGauge {
+	return &MsgCreateGauge{
+		Owner:     owner.String(),
+		PoolId:    poolId,
+		Coins:     coins,
+		StartTime: startTime,
+		NumEpochs: numEpochs,
+	}
+}
+
+func (msg MsgCreateGauge) Route() string { return ModuleName }
+func (msg MsgCreateGauge) Type() string  { return TypeMsgCreateGauge }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgCreateGauge) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgCreateGauge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgCreateGauge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	if !msg.Coins.IsValid() || msg.Coins.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid gauge coins: %s", msg.Coins)
+	}
+	if msg.NumEpochs <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("num epochs must be positive: %d", msg.NumEpochs)
+	}
+	return nil
+}
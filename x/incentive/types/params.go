@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	etypes "github.com/elys-network/elys/x/epochs/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
 	"gopkg.in/yaml.v2"
 )
 
@@ -20,6 +21,9 @@ var (
 	ParamStoreKeyStkIncentives         = []byte("stkincentives")
 	ParamStoreKeyPoolInfos             = []byte("poolinfos")
 	ParamStoreKeyElysStakeTrackingRate = []byte("elysstaketrackingrate")
+	ParamStoreKeyGaugeCreationFee      = []byte("gaugecreationfee")
+	ParamStoreKeyMaxGaugeEpochs        = []byte("maxgaugeepochs")
+	ParamStoreKeyMaxGaugesPerPool      = []byte("maxgaugesperpool")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -37,6 +41,9 @@ func NewParams() Params {
 		RewardPortionForLps:   sdk.NewDecWithPrec(65, 2),
 		PoolInfos:             []PoolInfo(nil),
 		ElysStakeTrackingRate: 10,
+		GaugeCreationFee:      sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(10000000))),
+		MaxGaugeEpochs:        365,
+		MaxGaugesPerPool:      10,
 	}
 }
 
@@ -55,6 +62,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyStkIncentives, &p.StakeIncentives, validateStakeIncentives),
 		paramtypes.NewParamSetPair(ParamStoreKeyPoolInfos, &p.PoolInfos, validatePoolInfos),
 		paramtypes.NewParamSetPair(ParamStoreKeyElysStakeTrackingRate, &p.ElysStakeTrackingRate, validateElysStakeTrakcingRate),
+		paramtypes.NewParamSetPair(ParamStoreKeyGaugeCreationFee, &p.GaugeCreationFee, validateGaugeCreationFee),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxGaugeEpochs, &p.MaxGaugeEpochs, validateMaxGaugeEpochs),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxGaugesPerPool, &p.MaxGaugesPerPool, validateMaxGaugesPerPool),
 	}
 }
 
@@ -92,6 +102,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateGaugeCreationFee(p.GaugeCreationFee); err != nil {
+		return err
+	}
+
+	if err := validateMaxGaugeEpochs(p.MaxGaugeEpochs); err != nil {
+		return err
+	}
+
+	if err := validateMaxGaugesPerPool(p.MaxGaugesPerPool); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -248,3 +270,42 @@ func validateElysStakeTrakcingRate(i interface{}) error {
 
 	return nil
 }
+
+func validateGaugeCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid gauge creation fee: %s", err)
+	}
+
+	return nil
+}
+
+func validateMaxGaugeEpochs(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 1 {
+		return fmt.Errorf("max gauge epochs must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxGaugesPerPool(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 1 {
+		return fmt.Errorf("max gauges per pool must be positive: %d", v)
+	}
+
+	return nil
+}
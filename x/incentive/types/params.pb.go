@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -36,6 +37,12 @@ type Params struct {
 	// poolId, reward wallet, mulitplier
 	PoolInfos             []PoolInfo `protobuf:"bytes,6,rep,name=pool_infos,json=poolInfos,proto3" json:"pool_infos"`
 	ElysStakeTrackingRate int64      `protobuf:"varint,7,opt,name=elys_stake_tracking_rate,json=elysStakeTrackingRate,proto3" json:"elys_stake_tracking_rate,omitempty"`
+	// fee paid to the community pool to create a gauge
+	GaugeCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=gauge_creation_fee,json=gaugeCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"gauge_creation_fee"`
+	// maximum number of epochs a gauge distributes over
+	MaxGaugeEpochs int64 `protobuf:"varint,9,opt,name=max_gauge_epochs,json=maxGaugeEpochs,proto3" json:"max_gauge_epochs,omitempty"`
+	// maximum number of gauges funding a pool at the same time
+	MaxGaugesPerPool int64 `protobuf:"varint,10,opt,name=max_gauges_per_pool,json=maxGaugesPerPool,proto3" json:"max_gauges_per_pool,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -105,6 +112,27 @@ func (m *Params) GetElysStakeTrackingRate() int64 {
 	return 0
 }
 
+func (m *Params) GetGaugeCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.GaugeCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxGaugeEpochs() int64 {
+	if m != nil {
+		return m.MaxGaugeEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGaugesPerPool() int64 {
+	if m != nil {
+		return m.MaxGaugesPerPool
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "elys.incentive.Params")
 }
@@ -112,35 +140,42 @@ func init() {
 func init() { proto.RegisterFile("elys/incentive/params.proto", fileDescriptor_3bca0267cb466fec) }
 
 var fileDescriptor_3bca0267cb466fec = []byte{
-	// 435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x13, 0x5a, 0x0a, 0x33, 0x1b, 0xa0, 0x8c, 0x21, 0x33, 0x44, 0x5a, 0x71, 0x40, 0xbd,
-	0xcc, 0x91, 0xc6, 0x01, 0x09, 0x89, 0x03, 0x15, 0xa0, 0x4d, 0x42, 0xa8, 0xca, 0x76, 0xe2, 0x62,
-	0xb9, 0xb1, 0xdb, 0x59, 0x4d, 0xfc, 0x59, 0xb6, 0x47, 0xdb, 0x97, 0x40, 0x1c, 0x39, 0xf2, 0x38,
-	0x3b, 0xee, 0x88, 0x38, 0x4c, 0xa8, 0x7d, 0x11, 0xe4, 0xa4, 0x8d, 0x42, 0x4f, 0x88, 0x53, 0x3e,
-	0xe7, 0xf7, 0xf7, 0xcf, 0xf6, 0x67, 0xa3, 0xa7, 0x22, 0x5f, 0xd8, 0x44, 0xaa, 0x4c, 0x28, 0x27,
-	0xbf, 0x88, 0x44, 0x33, 0xc3, 0x0a, 0x4b, 0xb4, 0x01, 0x07, 0xd1, 0x7d, 0x0f, 0x49, 0x0d, 0x0f,
-	0x1f, 0x4d, 0x60, 0x02, 0x25, 0x4a, 0x7c, 0x55, 0xa5, 0x0e, 0xe3, 0x2d, 0x45, 0x5d, 0xad, 0xf9,
-	0x93, 0xed, 0x25, 0x00, 0xf2, 0x0a, 0x3d, 0xff, 0xda, 0x46, 0x9d, 0x61, 0xb9, 0x62, 0x74, 0x82,
-	0xf6, 0x72, 0x4d, 0xeb, 0x94, 0xc5, 0x61, 0xaf, 0xd5, 0xbf, 0x77, 0xfc, 0x8c, 0xfc, 0xbd, 0x07,
-	0x72, 0xba, 0xa9, 0x4e, 0xd5, 0x18, 0x06, 0xed, 0xab, 0x9b, 0x6e, 0x90, 0xee, 0xe6, 0xba, 0xfe,
-	0x6d, 0xa3, 0x4f, 0xe8, 0xa1, 0x75, 0x6c, 0x2a, 0x9a, 0xb2, 0x5b, 0xff, 0x2e, 0x7b, 0x50, 0x4e,
-	0x6e, 0xf8, 0xce, 0xd0, 0x5e, 0x06, 0x45, 0x71, 0xa9, 0xa4, 0x5b, 0x50, 0xc7, 0xe6, 0xb8, 0xd5,
-	0x0b, 0xfb, 0x3b, 0x03, 0xe2, 0xd3, 0xbf, 0x6e, 0xba, 0x2f, 0x26, 0xd2, 0x5d, 0x5c, 0x8e, 0x48,
-	0x06, 0x45, 0x92, 0x81, 0x2d, 0xc0, 0xae, 0x3f, 0x47, 0x96, 0x4f, 0x13, 0xb7, 0xd0, 0xc2, 0x92,
-	0x77, 0x22, 0x4b, 0x77, 0x6b, 0xc9, 0x39, 0x9b, 0x47, 0xc7, 0xe8, 0x60, 0x26, 0xdd, 0x05, 0x37,
-	0x6c, 0x46, 0x19, 0xe7, 0x86, 0x0a, 0xc5, 0x46, 0xb9, 0xe0, 0xb8, 0xdd, 0x0b, 0xfb, 0x77, 0xd3,
-	0xfd, 0x0d, 0x7c, 0xcb, 0xb9, 0x79, 0x5f, 0xa1, 0x28, 0x43, 0x8f, 0x8d, 0x98, 0x31, 0xc3, 0xa9,
-	0x06, 0xe3, 0x24, 0x28, 0x3a, 0x06, 0x43, 0x73, 0x6d, 0xf1, 0xed, 0xff, 0xda, 0xd1, 0x7e, 0x65,
-	0x1b, 0x56, 0xb2, 0x0f, 0x60, 0x3e, 0x6a, 0x1b, 0xbd, 0x41, 0xc8, 0x5f, 0x10, 0x95, 0x6a, 0x0c,
-	0x16, 0x77, 0xca, 0xbe, 0xe1, 0xed, 0xbe, 0x0d, 0x01, 0xf2, 0x46, 0xcb, 0x76, 0xf4, 0x7a, 0x6c,
-	0xa3, 0x57, 0x08, 0xfb, 0x2c, 0xad, 0x6e, 0xc0, 0x19, 0x96, 0x4d, 0xa5, 0x9a, 0x50, 0xc3, 0x9c,
-	0xc0, 0x77, 0x7a, 0x61, 0xbf, 0x95, 0x1e, 0x78, 0x7e, 0xe6, 0xf1, 0xf9, 0x9a, 0xa6, 0xcc, 0x89,
-	0xd7, 0xed, 0xef, 0x3f, 0xba, 0xc1, 0xe0, 0xe4, 0x6a, 0x19, 0x87, 0xd7, 0xcb, 0x38, 0xfc, 0xbd,
-	0x8c, 0xc3, 0x6f, 0xab, 0x38, 0xb8, 0x5e, 0xc5, 0xc1, 0xcf, 0x55, 0x1c, 0x7c, 0x26, 0x8d, 0x43,
-	0x79, 0xc3, 0x91, 0x12, 0x6e, 0x06, 0x66, 0x5a, 0x0e, 0x92, 0x79, 0xe3, 0x7d, 0x95, 0x07, 0x1c,
-	0x75, 0xca, 0x17, 0xf6, 0xf2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcb, 0xb6, 0x05, 0x1f, 0xe1,
-	0x02, 0x00, 0x00,
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x6d, 0x68, 0x57, 0x56, 0xb3, 0x8d, 0x2a, 0x65, 0x28, 0x1b, 0x22, 0xad, 0x38, 0xa0, 0x5c,
+	0xea, 0xb0, 0x71, 0x40, 0x42, 0xe2, 0x40, 0xc7, 0xc6, 0x26, 0x21, 0x54, 0x65, 0x3b, 0x71, 0xb1,
+	0xdc, 0xe4, 0x6b, 0x1a, 0x35, 0x89, 0x23, 0xdb, 0x5d, 0xdb, 0x7f, 0xc1, 0x91, 0x23, 0x67, 0x7e,
+	0xc9, 0x8e, 0x3b, 0x22, 0x0e, 0x03, 0xb5, 0x7f, 0x04, 0xd9, 0x49, 0xa3, 0xd2, 0xd3, 0xc4, 0x29,
+	0xce, 0xf7, 0x9e, 0xdf, 0xf7, 0xbe, 0x67, 0x1b, 0x3d, 0x83, 0x78, 0x2e, 0xdc, 0x28, 0xf5, 0x21,
+	0x95, 0xd1, 0x35, 0xb8, 0x19, 0xe5, 0x34, 0x11, 0x38, 0xe3, 0x4c, 0x32, 0x73, 0x4f, 0x81, 0xb8,
+	0x04, 0x0f, 0x9f, 0x84, 0x2c, 0x64, 0x1a, 0x72, 0xd5, 0x2a, 0x67, 0x1d, 0xda, 0x3e, 0x13, 0x09,
+	0x13, 0xee, 0x80, 0x0a, 0x70, 0xaf, 0x8f, 0x06, 0x20, 0xe9, 0x91, 0xeb, 0xb3, 0x28, 0x5d, 0xe1,
+	0x1b, 0x2d, 0xca, 0x55, 0x81, 0x1f, 0x6c, 0x5a, 0x60, 0x2c, 0xce, 0xa1, 0x17, 0x8b, 0x2d, 0x54,
+	0xef, 0x6b, 0x47, 0xe6, 0x39, 0xda, 0x8d, 0x33, 0x52, 0xb2, 0x84, 0x65, 0x74, 0xaa, 0xce, 0xa3,
+	0xe3, 0xe7, 0xf8, 0x5f, 0x8f, 0xf8, 0x62, 0xb5, 0xba, 0x48, 0x87, 0xac, 0x57, 0xbb, 0xb9, 0x6b,
+	0x57, 0xbc, 0x9d, 0x38, 0x2b, 0xcb, 0xc2, 0xfc, 0x8c, 0x9a, 0x42, 0xd2, 0x31, 0xac, 0x8b, 0x3d,
+	0xb8, 0xbf, 0xd8, 0x63, 0xbd, 0x79, 0x4d, 0xef, 0x12, 0xed, 0xfa, 0x2c, 0x49, 0x26, 0x69, 0x24,
+	0xe7, 0x44, 0xd2, 0x99, 0x55, 0xed, 0x18, 0x4e, 0xa3, 0x87, 0x15, 0xfb, 0xd7, 0x5d, 0xfb, 0x65,
+	0x18, 0xc9, 0xd1, 0x64, 0x80, 0x7d, 0x96, 0xb8, 0x45, 0x52, 0xf9, 0xa7, 0x2b, 0x82, 0xb1, 0x2b,
+	0xe7, 0x19, 0x08, 0xfc, 0x01, 0x7c, 0x6f, 0xa7, 0x14, 0xb9, 0xa2, 0x33, 0xf3, 0x18, 0xed, 0x4f,
+	0x23, 0x39, 0x0a, 0x38, 0x9d, 0x12, 0x1a, 0x04, 0x9c, 0x40, 0x4a, 0x07, 0x31, 0x04, 0x56, 0xad,
+	0x63, 0x38, 0xdb, 0x5e, 0x6b, 0x05, 0xbe, 0x0f, 0x02, 0x7e, 0x9a, 0x43, 0xa6, 0x8f, 0x9e, 0x72,
+	0x98, 0x52, 0x1e, 0x90, 0x8c, 0x71, 0x19, 0xb1, 0x94, 0x0c, 0x19, 0x27, 0x71, 0x26, 0xac, 0xad,
+	0xff, 0x72, 0xd4, 0xca, 0xd5, 0xfa, 0xb9, 0xd8, 0x19, 0xe3, 0x9f, 0x32, 0x61, 0xbe, 0x43, 0x48,
+	0x1d, 0x10, 0x89, 0xd2, 0x21, 0x13, 0x56, 0x5d, 0xe7, 0x66, 0x6d, 0xe6, 0xd6, 0x67, 0x2c, 0x5e,
+	0x8b, 0xac, 0x91, 0x15, 0xff, 0xc2, 0x7c, 0x83, 0x2c, 0xc5, 0x25, 0xf9, 0x09, 0x48, 0x4e, 0xfd,
+	0x71, 0x94, 0x86, 0x84, 0x53, 0x09, 0xd6, 0xc3, 0x8e, 0xe1, 0x54, 0xbd, 0x7d, 0x85, 0x5f, 0x2a,
+	0xf8, 0xaa, 0x40, 0x3d, 0x2a, 0xc1, 0x9c, 0x23, 0x33, 0xa4, 0x93, 0x10, 0x88, 0xcf, 0x81, 0xe6,
+	0xc3, 0x01, 0x58, 0xdb, 0xba, 0xff, 0x01, 0xce, 0xfd, 0x63, 0x75, 0x05, 0x71, 0x71, 0x05, 0xf1,
+	0x09, 0x8b, 0xd2, 0xde, 0x2b, 0x65, 0xe0, 0xc7, 0xef, 0xb6, 0x73, 0x8f, 0x99, 0xd5, 0x06, 0xe1,
+	0x35, 0x75, 0x9b, 0x93, 0xa2, 0xcb, 0x19, 0x80, 0xe9, 0xa0, 0x66, 0x42, 0x67, 0x24, 0x6f, 0x0f,
+	0x19, 0xf3, 0x47, 0xc2, 0x6a, 0x68, 0xaf, 0x7b, 0x09, 0x9d, 0x7d, 0x54, 0xe5, 0x53, 0x5d, 0x35,
+	0xbb, 0xa8, 0x55, 0x32, 0x05, 0xc9, 0x80, 0x13, 0x35, 0xb9, 0x85, 0x34, 0xb9, 0xb9, 0x22, 0x8b,
+	0x3e, 0x70, 0x95, 0xd0, 0xdb, 0xda, 0xb7, 0xef, 0xed, 0x4a, 0xef, 0xfc, 0x66, 0x61, 0x1b, 0xb7,
+	0x0b, 0xdb, 0xf8, 0xb3, 0xb0, 0x8d, 0xaf, 0x4b, 0xbb, 0x72, 0xbb, 0xb4, 0x2b, 0x3f, 0x97, 0x76,
+	0xe5, 0x0b, 0x5e, 0x33, 0xad, 0x52, 0xe9, 0xa6, 0x20, 0xa7, 0x8c, 0x8f, 0xf5, 0x8f, 0x3b, 0x5b,
+	0x7b, 0x33, 0x7a, 0x80, 0x41, 0x5d, 0xbf, 0x9a, 0xd7, 0x7f, 0x07, 0x00, 0x4e, 0x8c, 0x7f, 0x5c,
+	0xd5, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -163,6 +198,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxGaugesPerPool != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGaugesPerPool))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxGaugeEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGaugeEpochs))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.GaugeCreationFee) > 0 {
+		for iNdEx := len(m.GaugeCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaugeCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.ElysStakeTrackingRate != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.ElysStakeTrackingRate))
 		i--
@@ -288,6 +347,18 @@ func (m *Params) Size() (n int) {
 	if m.ElysStakeTrackingRate != 0 {
 		n += 1 + sovParams(uint64(m.ElysStakeTrackingRate))
 	}
+	if len(m.GaugeCreationFee) > 0 {
+		for _, e := range m.GaugeCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxGaugeEpochs != 0 {
+		n += 1 + sovParams(uint64(m.MaxGaugeEpochs))
+	}
+	if m.MaxGaugesPerPool != 0 {
+		n += 1 + sovParams(uint64(m.MaxGaugesPerPool))
+	}
 	return n
 }
 
@@ -535,6 +606,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GaugeCreationFee = append(m.GaugeCreationFee, types.Coin{})
+			if err := m.GaugeCreationFee[len(m.GaugeCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGaugeEpochs", wireType)
+			}
+			m.MaxGaugeEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGaugeEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGaugesPerPool", wireType)
+			}
+			m.MaxGaugesPerPool = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGaugesPerPool |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
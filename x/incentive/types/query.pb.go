@@ -196,45 +196,236 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+type QueryGaugeRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGaugeRequest) Reset()         { *m = QueryGaugeRequest{} }
+func (m *QueryGaugeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugeRequest) ProtoMessage()    {}
+func (*QueryGaugeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29b04b3fcad26af2, []int{4}
+}
+func (m *QueryGaugeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGaugeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugeRequest.Merge(m, src)
+}
+func (m *QueryGaugeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGaugeRequest proto.InternalMessageInfo
+
+func (m *QueryGaugeRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGaugeResponse struct {
+	Gauge Gauge `protobuf:"bytes,1,opt,name=gauge,proto3" json:"gauge"`
+}
+
+func (m *QueryGaugeResponse) Reset()         { *m = QueryGaugeResponse{} }
+func (m *QueryGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugeResponse) ProtoMessage()    {}
+func (*QueryGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29b04b3fcad26af2, []int{5}
+}
+func (m *QueryGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugeResponse.Merge(m, src)
+}
+func (m *QueryGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGaugeResponse proto.InternalMessageInfo
+
+func (m *QueryGaugeResponse) GetGauge() Gauge {
+	if m != nil {
+		return m.Gauge
+	}
+	return Gauge{}
+}
+
+type QueryPoolGaugesRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *QueryPoolGaugesRequest) Reset()         { *m = QueryPoolGaugesRequest{} }
+func (m *QueryPoolGaugesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolGaugesRequest) ProtoMessage()    {}
+func (*QueryPoolGaugesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29b04b3fcad26af2, []int{6}
+}
+func (m *QueryPoolGaugesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolGaugesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolGaugesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolGaugesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolGaugesRequest.Merge(m, src)
+}
+func (m *QueryPoolGaugesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolGaugesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolGaugesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolGaugesRequest proto.InternalMessageInfo
+
+func (m *QueryPoolGaugesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryPoolGaugesResponse struct {
+	Gauges []Gauge `protobuf:"bytes,1,rep,name=gauges,proto3" json:"gauges"`
+}
+
+func (m *QueryPoolGaugesResponse) Reset()         { *m = QueryPoolGaugesResponse{} }
+func (m *QueryPoolGaugesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolGaugesResponse) ProtoMessage()    {}
+func (*QueryPoolGaugesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29b04b3fcad26af2, []int{7}
+}
+func (m *QueryPoolGaugesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolGaugesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolGaugesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolGaugesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolGaugesResponse.Merge(m, src)
+}
+func (m *QueryPoolGaugesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolGaugesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolGaugesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolGaugesResponse proto.InternalMessageInfo
+
+func (m *QueryPoolGaugesResponse) GetGauges() []Gauge {
+	if m != nil {
+		return m.Gauges
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "elys.incentive.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "elys.incentive.QueryParamsResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "elys.incentive.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "elys.incentive.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryGaugeRequest)(nil), "elys.incentive.QueryGaugeRequest")
+	proto.RegisterType((*QueryGaugeResponse)(nil), "elys.incentive.QueryGaugeResponse")
+	proto.RegisterType((*QueryPoolGaugesRequest)(nil), "elys.incentive.QueryPoolGaugesRequest")
+	proto.RegisterType((*QueryPoolGaugesResponse)(nil), "elys.incentive.QueryPoolGaugesResponse")
 }
 
 func init() { proto.RegisterFile("elys/incentive/query.proto", fileDescriptor_29b04b3fcad26af2) }
 
 var fileDescriptor_29b04b3fcad26af2 = []byte{
-	// 445 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbf, 0x6e, 0xd4, 0x30,
-	0x18, 0x8f, 0x4b, 0xb9, 0xc1, 0x15, 0x0c, 0xa6, 0x42, 0x25, 0xad, 0xd2, 0x92, 0x0a, 0xa9, 0xf4,
-	0x74, 0xb6, 0x7a, 0xc7, 0x13, 0x5c, 0x19, 0x90, 0x58, 0xca, 0x8d, 0x2c, 0xc8, 0x49, 0xad, 0x60,
-	0x35, 0xf1, 0x97, 0xc6, 0x4e, 0x21, 0x0b, 0x03, 0xbc, 0x00, 0x12, 0x23, 0x33, 0x0b, 0x4f, 0xd2,
-	0xb1, 0x12, 0x0b, 0x13, 0xa0, 0x3b, 0x1e, 0x04, 0xd9, 0x49, 0xca, 0xdd, 0x35, 0x3a, 0x75, 0x8a,
-	0xe5, 0xef, 0xf7, 0xfd, 0xfe, 0xc5, 0xd8, 0x17, 0x69, 0xa5, 0x99, 0x54, 0xb1, 0x50, 0x46, 0x5e,
-	0x08, 0x76, 0x5e, 0x8a, 0xa2, 0xa2, 0x79, 0x01, 0x06, 0xc8, 0x7d, 0x3b, 0xa3, 0xd7, 0x33, 0x7f,
-	0x7b, 0x09, 0x9b, 0xf3, 0x82, 0x67, 0xba, 0x06, 0xfb, 0x8f, 0x97, 0x86, 0xa7, 0x52, 0x9b, 0x42,
-	0x46, 0xa5, 0x91, 0xa0, 0x1a, 0xc8, 0x66, 0x02, 0x09, 0xb8, 0x23, 0xb3, 0xa7, 0xe6, 0x76, 0x27,
-	0x01, 0x48, 0x52, 0xc1, 0x78, 0x2e, 0x19, 0x57, 0x0a, 0x0c, 0xb7, 0x2b, 0x2d, 0x6d, 0x10, 0x83,
-	0xce, 0x40, 0xb3, 0x88, 0x6b, 0xc1, 0x2e, 0x8e, 0x22, 0x61, 0xf8, 0x11, 0x8b, 0x41, 0xb6, 0x9c,
-	0x87, 0xf3, 0x73, 0x67, 0xfe, 0x1a, 0x95, 0xf3, 0x44, 0x2a, 0xfe, 0x5f, 0x3f, 0xdc, 0xc4, 0xe4,
-	0x95, 0x45, 0x9c, 0x38, 0xdf, 0x13, 0x71, 0x5e, 0x0a, 0x6d, 0xc2, 0x97, 0xf8, 0xc1, 0xc2, 0xad,
-	0xce, 0x41, 0x69, 0x41, 0x9e, 0xe1, 0x5e, 0x9d, 0x6f, 0x0b, 0xed, 0xa1, 0x83, 0x8d, 0xe1, 0x43,
-	0xba, 0xd8, 0x06, 0xad, 0xf1, 0xe3, 0xf5, 0xcb, 0x5f, 0xbb, 0xde, 0xa4, 0xc1, 0x86, 0xdb, 0xf8,
-	0x91, 0x23, 0x3b, 0x86, 0x2c, 0x2b, 0x95, 0x34, 0xd5, 0x09, 0x40, 0xda, 0x2a, 0x7d, 0x42, 0xd8,
-	0xef, 0x9a, 0x36, 0x8a, 0x02, 0xaf, 0xe7, 0x00, 0xe9, 0x16, 0xda, 0xbb, 0x73, 0xb0, 0x31, 0xdc,
-	0xa1, 0x75, 0x32, 0x6a, 0x93, 0xd1, 0x26, 0x13, 0x7d, 0x2e, 0xe2, 0x63, 0x90, 0x6a, 0x3c, 0xb2,
-	0xaa, 0xdf, 0x7f, 0xef, 0xf6, 0x13, 0x69, 0xde, 0x96, 0x11, 0x8d, 0x21, 0x63, 0x4d, 0x13, 0xf5,
-	0x67, 0xa0, 0x4f, 0xcf, 0x98, 0xa9, 0x72, 0xa1, 0xdb, 0x1d, 0x3d, 0x71, 0xf4, 0xc3, 0x6f, 0x6b,
-	0xf8, 0xae, 0x73, 0x41, 0x3e, 0xe0, 0x5e, 0x1d, 0x82, 0x84, 0xcb, 0xe1, 0x6e, 0xf6, 0xe4, 0xef,
-	0xaf, 0xc4, 0xd4, 0x19, 0xc2, 0xfe, 0xc7, 0x1f, 0x7f, 0xbf, 0xac, 0x3d, 0x21, 0xfb, 0xcc, 0x82,
-	0x07, 0x4a, 0x98, 0x77, 0x50, 0x9c, 0xb1, 0xce, 0x87, 0x43, 0xbe, 0x22, 0x7c, 0x6f, 0xa1, 0x0a,
-	0xf2, 0xb4, 0x53, 0xa3, 0xab, 0x4c, 0xff, 0xf0, 0x36, 0xd0, 0xc6, 0xd5, 0xc8, 0xb9, 0x1a, 0x90,
-	0xfe, 0x4a, 0x57, 0x71, 0xbb, 0xfb, 0xc6, 0xf6, 0x34, 0x7e, 0x71, 0x39, 0x0d, 0xd0, 0xd5, 0x34,
-	0x40, 0x7f, 0xa6, 0x01, 0xfa, 0x3c, 0x0b, 0xbc, 0xab, 0x59, 0xe0, 0xfd, 0x9c, 0x05, 0xde, 0x6b,
-	0x3a, 0x57, 0xfa, 0x4d, 0xc2, 0xf7, 0x73, 0x94, 0xee, 0x07, 0x44, 0x3d, 0xf7, 0xfc, 0x46, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x07, 0xbf, 0x02, 0xe3, 0x6c, 0x03, 0x00, 0x00,
+	// 610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x43, 0x12, 0xa4, 0xad, 0xa8, 0xc4, 0x52, 0xda, 0xe2, 0x56, 0x6e, 0xeb, 0x08, 0xfa,
+	0x13, 0xc5, 0xab, 0x24, 0x3d, 0x71, 0x4c, 0x91, 0x0a, 0x42, 0x42, 0x25, 0x47, 0x2e, 0x95, 0x63,
+	0xaf, 0xcc, 0xaa, 0x89, 0xc7, 0xcd, 0xda, 0x85, 0xa8, 0x2a, 0x48, 0xf0, 0x02, 0x48, 0x9c, 0x10,
+	0x6f, 0xc0, 0x63, 0x70, 0xea, 0xb1, 0x12, 0x17, 0x4e, 0x80, 0x12, 0x1e, 0x04, 0xed, 0x7a, 0x9d,
+	0x26, 0x8e, 0x65, 0x7a, 0xca, 0x66, 0xe7, 0x9b, 0xf9, 0xbe, 0xf9, 0x66, 0xd6, 0x48, 0xa7, 0xbd,
+	0x21, 0x27, 0xcc, 0x77, 0xa8, 0x1f, 0xb2, 0x33, 0x4a, 0x4e, 0x23, 0x3a, 0x18, 0x5a, 0xc1, 0x00,
+	0x42, 0xc0, 0x8b, 0x22, 0x66, 0x4d, 0x62, 0xfa, 0x5a, 0x0a, 0x1b, 0xd8, 0x03, 0xbb, 0xcf, 0x63,
+	0xb0, 0xbe, 0x95, 0x0a, 0xba, 0x8c, 0x87, 0x03, 0xd6, 0x8d, 0x42, 0x06, 0xbe, 0x82, 0xa4, 0xb9,
+	0x3c, 0x3b, 0xf2, 0xa8, 0x8a, 0x2d, 0x79, 0xe0, 0x81, 0x3c, 0x12, 0x71, 0x52, 0xb7, 0xeb, 0x1e,
+	0x80, 0xd7, 0xa3, 0xc4, 0x0e, 0x18, 0xb1, 0x7d, 0x1f, 0x42, 0x5b, 0x94, 0x4b, 0x28, 0x0d, 0x07,
+	0x78, 0x1f, 0x38, 0xe9, 0xda, 0x9c, 0x92, 0xb3, 0x46, 0x97, 0x86, 0x76, 0x83, 0x38, 0xc0, 0x12,
+	0xbe, 0xbd, 0xe9, 0xb8, 0x6c, 0x6c, 0x82, 0x0a, 0x6c, 0x8f, 0xf9, 0xf6, 0xb5, 0x36, 0x73, 0x09,
+	0xe1, 0x97, 0x02, 0x71, 0x24, 0x7b, 0xea, 0xd0, 0xd3, 0x88, 0xf2, 0xd0, 0x7c, 0x8e, 0xee, 0xcd,
+	0xdc, 0xf2, 0x00, 0x7c, 0x4e, 0xf1, 0x3e, 0xaa, 0xc4, 0xbd, 0xaf, 0x6a, 0x9b, 0xda, 0xce, 0x42,
+	0x73, 0xd9, 0x9a, 0x75, 0xca, 0x8a, 0xf1, 0xed, 0xd2, 0xe5, 0xaf, 0x8d, 0x42, 0x47, 0x61, 0xcd,
+	0x35, 0xf4, 0x40, 0x16, 0x3b, 0x80, 0x7e, 0x3f, 0xf2, 0x59, 0x38, 0x3c, 0x02, 0xe8, 0x25, 0x4c,
+	0x1f, 0x35, 0xa4, 0x67, 0x45, 0x15, 0x23, 0x45, 0xa5, 0x00, 0xa0, 0xb7, 0xaa, 0x6d, 0xde, 0xda,
+	0x59, 0x68, 0xae, 0x5b, 0x71, 0x67, 0x96, 0xe8, 0xcc, 0x52, 0x3d, 0x59, 0x4f, 0xa8, 0x73, 0x00,
+	0xcc, 0x6f, 0xb7, 0x04, 0xeb, 0xb7, 0xdf, 0x1b, 0x35, 0x8f, 0x85, 0xaf, 0xa3, 0xae, 0xe5, 0x40,
+	0x9f, 0x28, 0x27, 0xe2, 0x9f, 0x3a, 0x77, 0x4f, 0x48, 0x38, 0x0c, 0x28, 0x4f, 0x72, 0x78, 0x47,
+	0x96, 0x37, 0xab, 0xe8, 0xae, 0x14, 0x71, 0x28, 0x26, 0xa3, 0xa4, 0xe1, 0x45, 0x54, 0x64, 0xae,
+	0xec, 0xb4, 0xd4, 0x29, 0x32, 0xd7, 0x3c, 0x44, 0x78, 0x1a, 0xa4, 0x14, 0x36, 0x50, 0x59, 0xce,
+	0x53, 0x59, 0x72, 0x3f, 0x6d, 0x89, 0x44, 0x2b, 0x47, 0x62, 0xa4, 0xd9, 0x40, 0xcb, 0xb1, 0xbb,
+	0x00, 0x3d, 0x19, 0x4e, 0x7c, 0xc7, 0x2b, 0xe8, 0xb6, 0xd0, 0x73, 0x3c, 0xe1, 0xad, 0x88, 0xbf,
+	0xcf, 0x5c, 0xf3, 0x05, 0x5a, 0x99, 0x4b, 0x51, 0x02, 0x5a, 0xa8, 0x22, 0xcb, 0x72, 0x65, 0x52,
+	0xae, 0x02, 0x05, 0x6d, 0x7e, 0x2f, 0xa1, 0xb2, 0x2c, 0x88, 0xdf, 0xa1, 0x4a, 0x3c, 0x35, 0x6c,
+	0xa6, 0x13, 0xe7, 0x17, 0x43, 0xaf, 0xe6, 0x62, 0x62, 0x45, 0x66, 0xed, 0xc3, 0x8f, 0xbf, 0x9f,
+	0x8b, 0x0f, 0x71, 0x95, 0x08, 0x70, 0xdd, 0xa7, 0xe1, 0x1b, 0x18, 0x9c, 0x90, 0xcc, 0x57, 0x84,
+	0xbf, 0x6a, 0xe8, 0xce, 0xcc, 0xec, 0xf1, 0x6e, 0x26, 0x47, 0xd6, 0xf6, 0xe8, 0x7b, 0x37, 0x81,
+	0x2a, 0x55, 0x2d, 0xa9, 0xaa, 0x8e, 0x6b, 0xb9, 0xaa, 0x9c, 0x24, 0xf7, 0x58, 0x38, 0x8f, 0xdf,
+	0xa3, 0xb2, 0xb4, 0x0f, 0x6f, 0x65, 0x32, 0x4d, 0xef, 0x8b, 0x6e, 0xe6, 0x41, 0x94, 0x08, 0x22,
+	0x45, 0xec, 0xe2, 0xed, 0x5c, 0x11, 0x72, 0x48, 0xe4, 0x9c, 0xb9, 0x17, 0xf8, 0x8b, 0x86, 0xd0,
+	0xf5, 0xd0, 0xf1, 0xa3, 0x6c, 0xff, 0xd3, 0x8b, 0xa4, 0x6f, 0xff, 0x17, 0xa7, 0x04, 0x3d, 0x96,
+	0x82, 0xf6, 0x71, 0x33, 0x7f, 0x56, 0x62, 0x29, 0xe3, 0xd5, 0x21, 0xe7, 0x6a, 0x43, 0x2f, 0xda,
+	0x4f, 0x2f, 0x47, 0x86, 0x76, 0x35, 0x32, 0xb4, 0x3f, 0x23, 0x43, 0xfb, 0x34, 0x36, 0x0a, 0x57,
+	0x63, 0xa3, 0xf0, 0x73, 0x6c, 0x14, 0x5e, 0x59, 0x53, 0x4f, 0x70, 0xbe, 0xee, 0xdb, 0xa9, 0xca,
+	0xf2, 0x39, 0x76, 0x2b, 0xf2, 0x63, 0xd4, 0xfa, 0x37, 0x00, 0x89, 0x8d, 0xce, 0x96, 0x96, 0x05,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -253,6 +444,10 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Queries a list of CommunityPool items.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// Queries a gauge by id.
+	Gauge(ctx context.Context, in *QueryGaugeRequest, opts ...grpc.CallOption) (*QueryGaugeResponse, error)
+	// Queries the gauges of a pool.
+	PoolGauges(ctx context.Context, in *QueryPoolGaugesRequest, opts ...grpc.CallOption) (*QueryPoolGaugesResponse, error)
 }
 
 type queryClient struct {
@@ -281,12 +476,34 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) Gauge(ctx context.Context, in *QueryGaugeRequest, opts ...grpc.CallOption) (*QueryGaugeResponse, error) {
+	out := new(QueryGaugeResponse)
+	err := c.cc.Invoke(ctx, "/elys.incentive.Query/Gauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolGauges(ctx context.Context, in *QueryPoolGaugesRequest, opts ...grpc.CallOption) (*QueryPoolGaugesResponse, error) {
+	out := new(QueryPoolGaugesResponse)
+	err := c.cc.Invoke(ctx, "/elys.incentive.Query/PoolGauges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Queries a list of CommunityPool items.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// Queries a gauge by id.
+	Gauge(context.Context, *QueryGaugeRequest) (*QueryGaugeResponse, error)
+	// Queries the gauges of a pool.
+	PoolGauges(context.Context, *QueryPoolGaugesRequest) (*QueryPoolGaugesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -299,6 +516,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) Gauge(ctx context.Context, req *QueryGaugeRequest) (*QueryGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gauge not implemented")
+}
+func (*UnimplementedQueryServer) PoolGauges(ctx context.Context, req *QueryPoolGaugesRequest) (*QueryPoolGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolGauges not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -340,6 +563,42 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Gauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Gauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.incentive.Query/Gauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Gauge(ctx, req.(*QueryGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolGaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolGauges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.incentive.Query/PoolGauges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolGauges(ctx, req.(*QueryPoolGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "elys.incentive.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -352,6 +611,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "Gauge",
+			Handler:    _Query_Gauge_Handler,
+		},
+		{
+			MethodName: "PoolGauges",
+			Handler:    _Query_PoolGauges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "elys/incentive/query.proto",
@@ -473,6 +740,132 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGaugeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolGaugesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolGaugesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -528,8 +921,58 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Gauge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolGaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -801,6 +1244,311 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGaugeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolGaugesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolGaugesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolGaugesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolGaugesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,21 +7,27 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -186,40 +192,179 @@ func (m *MsgWithdrawValidatorCommissionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawValidatorCommissionResponse proto.InternalMessageInfo
 
+// MsgCreateGauge funds a gauge distributing coins to a pool's LPs over num_epochs.
+type MsgCreateGauge struct {
+	Owner     string                                   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	PoolId    uint64                                   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Coins     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	StartTime time.Time                                `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	NumEpochs int64                                    `protobuf:"varint,5,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
+}
+
+func (m *MsgCreateGauge) Reset()         { *m = MsgCreateGauge{} }
+func (m *MsgCreateGauge) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGauge) ProtoMessage()    {}
+func (*MsgCreateGauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59dc3bedfb1cce84, []int{4}
+}
+func (m *MsgCreateGauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGauge.Merge(m, src)
+}
+func (m *MsgCreateGauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGauge proto.InternalMessageInfo
+
+func (m *MsgCreateGauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCreateGauge) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgCreateGauge) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *MsgCreateGauge) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreateGauge) GetNumEpochs() int64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
+}
+
+// MsgCreateGaugeResponse defines the Msg/CreateGauge response type.
+type MsgCreateGaugeResponse struct {
+	GaugeId uint64 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+}
+
+func (m *MsgCreateGaugeResponse) Reset()         { *m = MsgCreateGaugeResponse{} }
+func (m *MsgCreateGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGaugeResponse) ProtoMessage()    {}
+func (*MsgCreateGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59dc3bedfb1cce84, []int{5}
+}
+func (m *MsgCreateGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGaugeResponse.Merge(m, src)
+}
+func (m *MsgCreateGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGaugeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGaugeResponse proto.InternalMessageInfo
+
+func (m *MsgCreateGaugeResponse) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgWithdrawRewards)(nil), "elys.incentive.MsgWithdrawRewards")
 	proto.RegisterType((*MsgWithdrawRewardsResponse)(nil), "elys.incentive.MsgWithdrawRewardsResponse")
 	proto.RegisterType((*MsgWithdrawValidatorCommission)(nil), "elys.incentive.MsgWithdrawValidatorCommission")
 	proto.RegisterType((*MsgWithdrawValidatorCommissionResponse)(nil), "elys.incentive.MsgWithdrawValidatorCommissionResponse")
+	proto.RegisterType((*MsgCreateGauge)(nil), "elys.incentive.MsgCreateGauge")
+	proto.RegisterType((*MsgCreateGaugeResponse)(nil), "elys.incentive.MsgCreateGaugeResponse")
 }
 
 func init() { proto.RegisterFile("elys/incentive/tx.proto", fileDescriptor_59dc3bedfb1cce84) }
 
 var fileDescriptor_59dc3bedfb1cce84 = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0xcd, 0xa9, 0x2c,
-	0xd6, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0xd5, 0x2f, 0xa9, 0xd0, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x03, 0x49, 0xe8, 0xc1, 0x25, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c,
-	0x44, 0x02, 0xc2, 0x81, 0x48, 0x29, 0x55, 0x73, 0x09, 0xf9, 0x16, 0xa7, 0x87, 0x67, 0x96, 0x64,
-	0xa4, 0x14, 0x25, 0x96, 0x07, 0xa5, 0x96, 0x27, 0x16, 0xa5, 0x14, 0x0b, 0xb9, 0x72, 0x09, 0xa6,
-	0xa4, 0xe6, 0xa4, 0xa6, 0x27, 0x96, 0xe4, 0x17, 0xc5, 0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17,
-	0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2, 0x2b, 0x02, 0x35, 0xc2, 0x11,
-	0x22, 0x13, 0x5c, 0x52, 0x94, 0x99, 0x97, 0x1e, 0x24, 0x00, 0xd7, 0x02, 0x15, 0x17, 0x12, 0xe1,
-	0x62, 0x4d, 0x49, 0xcd, 0xcb, 0xcf, 0x95, 0x60, 0x02, 0x69, 0x0d, 0x82, 0x70, 0xac, 0x38, 0x3a,
-	0x16, 0xc8, 0x33, 0xbc, 0x58, 0x20, 0xcf, 0xa0, 0x24, 0xc3, 0x25, 0x85, 0x69, 0x79, 0x50, 0x6a,
-	0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0xd2, 0x39, 0x46, 0x2e, 0x39, 0x24, 0xe9, 0xb0, 0xc4, 0x9c,
-	0xcc, 0x14, 0x90, 0xe9, 0xce, 0xf9, 0xb9, 0xb9, 0x99, 0xc5, 0xc5, 0x99, 0xf9, 0x79, 0xd4, 0x72,
-	0xa7, 0x2b, 0x97, 0x60, 0x19, 0xcc, 0x74, 0xb8, 0x31, 0x4c, 0x84, 0x8c, 0x81, 0x6b, 0xc1, 0xf0,
-	0x2e, 0x33, 0x76, 0xef, 0x6a, 0x70, 0xa9, 0xe1, 0xf7, 0x0f, 0xcc, 0xeb, 0x46, 0x1f, 0x18, 0xb9,
-	0x98, 0x7d, 0x8b, 0xd3, 0x85, 0x12, 0xb9, 0xf8, 0xd1, 0xa3, 0x46, 0x49, 0x0f, 0x35, 0xca, 0xf5,
-	0x30, 0x43, 0x50, 0x4a, 0x8b, 0xb0, 0x1a, 0x98, 0x55, 0x42, 0xad, 0x8c, 0x5c, 0xd2, 0xf8, 0x82,
-	0x58, 0x0f, 0x8f, 0x59, 0x58, 0xd4, 0x4b, 0x99, 0x91, 0xa6, 0x1e, 0xe6, 0x0e, 0x27, 0x8f, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0x99, 0xad, 0x9b, 0x97, 0x5a, 0x52, 0x9e, 0x5f, 0x94, 0x0d,
-	0xe6, 0xe8, 0x57, 0x20, 0x67, 0x8b, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xca, 0x36, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x83, 0x55, 0xbc, 0xbf, 0x35, 0x03, 0x00, 0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xed, 0xa4, 0xe9, 0x9f, 0xab, 0x54, 0xa8, 0x55, 0x51, 0xd7, 0x80, 0x1d, 0x65, 0xa8,
+	0x2c, 0xa4, 0x9e, 0x69, 0x2a, 0x31, 0xb0, 0x91, 0x28, 0x82, 0x0e, 0x59, 0xcc, 0x3f, 0x89, 0x25,
+	0xba, 0xc4, 0xc7, 0xe5, 0xd4, 0xf8, 0xce, 0xf2, 0x5d, 0x92, 0x56, 0xcc, 0x48, 0x8c, 0xfd, 0x08,
+	0x99, 0x99, 0xf9, 0x0e, 0x74, 0x42, 0x15, 0x13, 0x13, 0x45, 0xc9, 0xc2, 0xc7, 0x40, 0x67, 0x3b,
+	0x56, 0x4a, 0xa2, 0x44, 0x48, 0x4c, 0xc9, 0x7b, 0xcf, 0xfb, 0x3e, 0xf7, 0x3e, 0xa7, 0x5f, 0x02,
+	0xf6, 0x71, 0xef, 0x42, 0x78, 0x94, 0x75, 0x30, 0x93, 0x74, 0x80, 0x3d, 0x79, 0x0e, 0xa3, 0x98,
+	0x4b, 0x6e, 0xec, 0x28, 0x01, 0xe6, 0x82, 0xb5, 0x47, 0x38, 0xe1, 0x89, 0xe4, 0xa9, 0x6f, 0x69,
+	0x97, 0x75, 0xd0, 0xe1, 0x22, 0xe4, 0xa2, 0x95, 0x0a, 0x69, 0x91, 0x49, 0x76, 0x5a, 0x79, 0x6d,
+	0x24, 0xb0, 0x37, 0x38, 0x6e, 0x63, 0x89, 0x8e, 0xbd, 0x0e, 0xa7, 0x2c, 0xd3, 0x1d, 0xc2, 0x39,
+	0xe9, 0x61, 0x2f, 0xa9, 0xda, 0xfd, 0xf7, 0x9e, 0xa4, 0x21, 0x16, 0x12, 0x85, 0x51, 0xda, 0x50,
+	0xf9, 0x00, 0x8c, 0xa6, 0x20, 0x6f, 0xa9, 0xec, 0x06, 0x31, 0x1a, 0xfa, 0x78, 0x88, 0xe2, 0x40,
+	0x18, 0x0d, 0xb0, 0x1b, 0xe0, 0x1e, 0x26, 0x48, 0xf2, 0xb8, 0x85, 0x82, 0x20, 0xc6, 0x42, 0x98,
+	0x7a, 0x59, 0x77, 0xb7, 0x6a, 0xe6, 0xf7, 0x2f, 0x47, 0x7b, 0xd9, 0x0e, 0xcf, 0x52, 0xe5, 0xa5,
+	0x8c, 0x29, 0x23, 0xfe, 0xdd, 0x7c, 0x24, 0x3b, 0x37, 0xf6, 0x40, 0x29, 0xc0, 0x8c, 0x87, 0x66,
+	0x41, 0x8d, 0xfa, 0x69, 0xf1, 0x74, 0xf3, 0xd3, 0xc8, 0xd1, 0x7e, 0x8f, 0x1c, 0xad, 0xf2, 0x00,
+	0x58, 0xf3, 0x97, 0xfb, 0x58, 0x44, 0x9c, 0x09, 0x5c, 0xf9, 0xa6, 0x03, 0x7b, 0x46, 0x7e, 0x83,
+	0x7a, 0x34, 0x50, 0xee, 0x75, 0x1e, 0x86, 0x54, 0x08, 0xca, 0xd9, 0xff, 0xda, 0xb3, 0x01, 0x76,
+	0x07, 0x53, 0xf7, 0xdc, 0xa6, 0xb0, 0xca, 0x26, 0x1f, 0x99, 0x8b, 0x5b, 0x5c, 0x1c, 0xd7, 0x05,
+	0x87, 0xcb, 0xf3, 0xe4, 0xd1, 0x47, 0x05, 0xb0, 0xd3, 0x14, 0xa4, 0x1e, 0x63, 0x24, 0xf1, 0x73,
+	0xd4, 0x27, 0xd8, 0x80, 0xa0, 0xc4, 0x87, 0x0c, 0xc7, 0x2b, 0xe3, 0xa5, 0x6d, 0xc6, 0x3e, 0xd8,
+	0x88, 0x38, 0xef, 0xb5, 0x68, 0x90, 0x24, 0x59, 0xf3, 0xd7, 0x55, 0x79, 0x1a, 0x18, 0x08, 0x94,
+	0x14, 0x20, 0xc2, 0x2c, 0x96, 0x8b, 0xee, 0x76, 0xf5, 0x00, 0x66, 0x2e, 0x0a, 0x21, 0x98, 0x21,
+	0x04, 0xeb, 0x9c, 0xb2, 0xda, 0xe3, 0xab, 0x9f, 0x8e, 0xf6, 0xf9, 0xc6, 0x71, 0x09, 0x95, 0xdd,
+	0x7e, 0x1b, 0x76, 0x78, 0x98, 0xd1, 0x97, 0x7d, 0x1c, 0x89, 0xe0, 0xcc, 0x93, 0x17, 0x11, 0x16,
+	0xc9, 0x80, 0xf0, 0x53, 0x67, 0xa3, 0x0e, 0x80, 0x90, 0x28, 0x96, 0x2d, 0x45, 0x9b, 0xb9, 0x56,
+	0xd6, 0xdd, 0xed, 0xaa, 0x05, 0x53, 0x14, 0xe1, 0x14, 0x45, 0xf8, 0x6a, 0x8a, 0x62, 0x6d, 0x53,
+	0x5d, 0x74, 0x79, 0xe3, 0xe8, 0xfe, 0x56, 0x32, 0xa7, 0x14, 0xe3, 0x21, 0x00, 0xac, 0x1f, 0xb6,
+	0x70, 0xc4, 0x3b, 0x5d, 0x61, 0x96, 0xca, 0xba, 0x5b, 0xf4, 0xb7, 0x58, 0x3f, 0x6c, 0x24, 0x07,
+	0x95, 0x13, 0x70, 0xef, 0xf6, 0x0b, 0x4d, 0x1f, 0xcf, 0x38, 0x00, 0x9b, 0x44, 0x1d, 0xa8, 0xe8,
+	0x7a, 0x12, 0x7d, 0x23, 0xa9, 0x4f, 0x83, 0xea, 0xd7, 0x02, 0x28, 0x36, 0x05, 0x31, 0x10, 0xb8,
+	0xf3, 0x37, 0xf2, 0x15, 0x78, 0xfb, 0xb7, 0x08, 0xe7, 0xc9, 0xb4, 0x1e, 0xad, 0xee, 0xc9, 0xb7,
+	0xf8, 0xa8, 0x83, 0xfb, 0xcb, 0xd0, 0x85, 0x4b, 0xbc, 0x16, 0xf4, 0x5b, 0x4f, 0xfe, 0xad, 0x3f,
+	0xdf, 0xe3, 0x35, 0xd8, 0x9e, 0xc5, 0xc8, 0x5e, 0x60, 0x33, 0xa3, 0x5b, 0x87, 0xcb, 0xf5, 0xa9,
+	0x6d, 0xed, 0xc5, 0xd5, 0xd8, 0xd6, 0xaf, 0xc7, 0xb6, 0xfe, 0x6b, 0x6c, 0xeb, 0x97, 0x13, 0x5b,
+	0xbb, 0x9e, 0xd8, 0xda, 0x8f, 0x89, 0xad, 0xbd, 0x83, 0x33, 0xb4, 0x28, 0xaf, 0x23, 0x86, 0xe5,
+	0x90, 0xc7, 0x67, 0x49, 0xe1, 0x9d, 0xcf, 0xfe, 0x0d, 0x2a, 0x72, 0xda, 0xeb, 0x09, 0x10, 0x27,
+	0x7f, 0x06, 0x00, 0x08, 0x1f, 0xc8, 0xaa, 0x25, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -240,6 +385,8 @@ type MsgClient interface {
 	// WithdrawValidatorCommission defines a method to withdraw the
 	// full commission to the validator address.
 	WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error)
+	// CreateGauge escrows coins to be streamed to the committed LP shares of a pool.
+	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
 }
 
 type msgClient struct {
@@ -268,6 +415,15 @@ func (c *msgClient) WithdrawValidatorCommission(ctx context.Context, in *MsgWith
 	return out, nil
 }
 
+func (c *msgClient) CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error) {
+	out := new(MsgCreateGaugeResponse)
+	err := c.cc.Invoke(ctx, "/elys.incentive.Msg/CreateGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
@@ -276,6 +432,8 @@ type MsgServer interface {
 	// WithdrawValidatorCommission defines a method to withdraw the
 	// full commission to the validator address.
 	WithdrawValidatorCommission(context.Context, *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error)
+	// CreateGauge escrows coins to be streamed to the committed LP shares of a pool.
+	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -288,6 +446,9 @@ func (*UnimplementedMsgServer) WithdrawRewards(ctx context.Context, req *MsgWith
 func (*UnimplementedMsgServer) WithdrawValidatorCommission(ctx context.Context, req *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawValidatorCommission not implemented")
 }
+func (*UnimplementedMsgServer) CreateGauge(ctx context.Context, req *MsgCreateGauge) (*MsgCreateGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGauge not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -329,6 +490,24 @@ func _Msg_WithdrawValidatorCommission_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGauge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.incentive.Msg/CreateGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGauge(ctx, req.(*MsgCreateGauge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "elys.incentive.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -341,6 +520,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawValidatorCommission",
 			Handler:    _Msg_WithdrawValidatorCommission_Handler,
 		},
+		{
+			MethodName: "CreateGauge",
+			Handler:    _Msg_CreateGauge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "elys/incentive/tx.proto",
@@ -473,6 +656,96 @@ func (m *MsgWithdrawValidatorCommissionResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCreateGauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -540,6 +813,45 @@ func (m *MsgWithdrawValidatorCommissionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.NumEpochs != 0 {
+		n += 1 + sovTx(uint64(m.NumEpochs))
+	}
+	return n
+}
+
+func (m *MsgCreateGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovTx(uint64(m.GaugeId))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -906,6 +1218,262 @@ func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
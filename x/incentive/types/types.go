@@ -17,6 +17,8 @@ type TotalCommitmentInfo struct {
 	TotalFeesCollected sdk.Coins
 	// Total Lp Token committed
 	TotalLpTokensCommitted map[string]sdk.Int
+	// Total Lp Token committed with locked shares counted by their boost
+	TotalLpTokensBoosted map[string]sdk.Int
 	// Revenue tracking per pool, key => (poolId)
 	PoolRevenueTrack map[string]sdk.Dec
 }
@@ -36,6 +36,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdatePools())
 	cmd.AddCommand(CmdWhitelist())
 	cmd.AddCommand(CmdDewhitelist())
+	cmd.AddCommand(CmdLiquidate())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/elys-network/elys/x/leveragelp/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdLiquidate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "liquidate [address] [position-id] [flags]",
+		Short:   "Liquidate a leveragelp position whose health is at or below the safety factor",
+		Example: `elysd tx leveragelp liquidate elys1x8wdz9ehfrw9s2y5t8z4fd9avj3ft5ljx3l0kd 1 --from=treasury --keyring-backend=test --chain-id=elystestnet-1 --yes --gas=1000000`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			signer := clientCtx.GetFromAddress()
+			if signer == nil {
+				return errors.New("signer address is missing")
+			}
+
+			argPositionId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errors.New("invalid position id")
+			}
+
+			msg := types.NewMsgLiquidate(
+				signer.String(),
+				args[0],
+				argPositionId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/leveragelp/types"
+)
+
+func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*types.MsgLiquidateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return k.Keeper.Liquidate(ctx, msg)
+}
+
+// Liquidate lets any account close a position whose health is at or below the safety factor
+func (k Keeper) Liquidate(ctx sdk.Context, msg *types.MsgLiquidate) (*types.MsgLiquidateResponse, error) {
+	position, err := k.GetPosition(ctx, msg.Address, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, found := k.GetPool(ctx, position.AmmPoolId)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrInvalidBorrowingAsset, "invalid pool id")
+	}
+
+	ammPool, err := k.GetAmmPool(ctx, position.AmmPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	position.PositionHealth, err = k.GetPositionHealth(ctx, position, ammPool)
+	if err != nil {
+		return nil, err
+	}
+
+	safetyFactor := k.GetSafetyFactor(ctx)
+	if position.PositionHealth.GT(safetyFactor) {
+		return nil, sdkerrors.Wrapf(types.ErrPositionHealthy, "health %s is above safety factor %s", position.PositionHealth, safetyFactor)
+	}
+
+	liquidator, err := sdk.AccAddressFromBech32(msg.Liquidator)
+	if err != nil {
+		return nil, err
+	}
+
+	repayAmount, err := k.ForceCloseLong(ctx, position, pool, liquidator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventLiquidate,
+		sdk.NewAttribute("id", strconv.FormatInt(int64(position.Id), 10)),
+		sdk.NewAttribute("address", position.Address),
+		sdk.NewAttribute("liquidator", msg.Liquidator),
+		sdk.NewAttribute("collateral", position.Collateral.String()),
+		sdk.NewAttribute("repay_amount", repayAmount.String()),
+		sdk.NewAttribute("liabilities", position.Liabilities.String()),
+		sdk.NewAttribute("health", position.PositionHealth.String()),
+	))
+
+	return &types.MsgLiquidateResponse{}, nil
+}
@@ -54,6 +54,10 @@ func (k Keeper) GetSafetyFactor(ctx sdk.Context) sdk.Dec {
 	return k.GetParams(ctx).SafetyFactor
 }
 
+func (k Keeper) GetLiquidatorBonus(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).LiquidatorBonus
+}
+
 func (k Keeper) GetSqModifier(ctx sdk.Context) sdk.Dec {
 	return k.GetParams(ctx).SqModifier
 }
@@ -6,7 +6,9 @@ import (
 	"github.com/elys-network/elys/x/leveragelp/types"
 )
 
-func (k Keeper) ForceCloseLong(ctx sdk.Context, position types.Position, pool types.Pool) (sdk.Int, error) {
+// ForceCloseLong closes the position and returns what is left after repaying the debt to the owner.
+// When liquidator is set, the liquidator bonus is carved out of the returned amount.
+func (k Keeper) ForceCloseLong(ctx sdk.Context, position types.Position, pool types.Pool, liquidator sdk.AccAddress) (sdk.Int, error) {
 	// Exit liquidity with collateral token
 	exitCoins, err := k.amm.ExitPool(ctx, position.GetPositionAddress(), position.AmmPoolId, position.LeveragedLpAmount, sdk.Coins{}, position.Collateral.Denom)
 	if err != nil {
@@ -26,10 +28,23 @@ func (k Keeper) ForceCloseLong(ctx sdk.Context, position types.Position, pool ty
 	}
 
 	userAmount := exitCoins[0].Amount.Sub(repayAmount)
-	positionOwner := sdk.MustAccAddressFromBech32(position.Address)
-	err = k.bankKeeper.SendCoins(ctx, positionOwner, position.GetPositionAddress(), sdk.Coins{sdk.NewCoin(position.Collateral.Denom, userAmount)})
-	if err != nil {
-		return sdk.ZeroInt(), err
+	if userAmount.IsPositive() {
+		if liquidator != nil {
+			bonusAmount := k.GetLiquidatorBonus(ctx).MulInt(userAmount).TruncateInt()
+			if bonusAmount.IsPositive() {
+				err = k.bankKeeper.SendCoins(ctx, position.GetPositionAddress(), liquidator, sdk.Coins{sdk.NewCoin(position.Collateral.Denom, bonusAmount)})
+				if err != nil {
+					return sdk.ZeroInt(), err
+				}
+				userAmount = userAmount.Sub(bonusAmount)
+			}
+		}
+
+		positionOwner := sdk.MustAccAddressFromBech32(position.Address)
+		err = k.bankKeeper.SendCoins(ctx, position.GetPositionAddress(), positionOwner, sdk.Coins{sdk.NewCoin(position.Collateral.Denom, userAmount)})
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
 	}
 
 	err = k.DestroyPosition(ctx, position.Address, position.Id)
@@ -60,6 +75,6 @@ func (k Keeper) CloseLong(ctx sdk.Context, msg *types.MsgClose) (*types.Position
 		return nil, sdk.ZeroInt(), sdkerrors.Wrap(types.ErrInvalidBorrowingAsset, "invalid pool id")
 	}
 
-	repayAmount, err := k.ForceCloseLong(ctx, position, pool)
+	repayAmount, err := k.ForceCloseLong(ctx, position, pool, nil)
 	return &position, repayAmount, err
 }
@@ -13,7 +13,7 @@ import (
 	stablestaketypes "github.com/elys-network/elys/x/stablestake/types"
 )
 
-func (suite KeeperTestSuite) OpenPosition(addr sdk.AccAddress) (*types.Position, types.Pool) {
+func (suite *KeeperTestSuite) OpenPosition(addr sdk.AccAddress) (*types.Position, types.Pool) {
 	k := suite.app.LeveragelpKeeper
 	SetupStableCoinPrices(suite.ctx, suite.app.OracleKeeper)
 	poolAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
@@ -97,7 +97,7 @@ func (suite KeeperTestSuite) OpenPosition(addr sdk.AccAddress) (*types.Position,
 	return position, pool
 }
 
-func (suite KeeperTestSuite) TestCloseLong() {
+func (suite *KeeperTestSuite) TestCloseLong() {
 	k := suite.app.LeveragelpKeeper
 	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 
@@ -114,18 +114,23 @@ func (suite KeeperTestSuite) TestCloseLong() {
 	suite.Require().Equal(repayAmount.String(), repayAmountOut.String())
 }
 
-func (suite KeeperTestSuite) TestForceCloseLong() {
+func (suite *KeeperTestSuite) TestForceCloseLong() {
 	k := suite.app.LeveragelpKeeper
 	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 	position, pool := suite.OpenPosition(addr)
 	repayAmount := math.NewInt(4000)
 
-	repayAmountOut, err := k.ForceCloseLong(suite.ctx, *position, pool)
+	ownerBalance := suite.app.BankKeeper.GetBalance(suite.ctx, addr, "uusdc")
+	repayAmountOut, err := k.ForceCloseLong(suite.ctx, *position, pool, nil)
 	suite.Require().NoError(err)
 	suite.Require().Equal(repayAmount.String(), repayAmountOut.String())
+
+	// what is left after repaying the debt goes from the position account to the owner
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, addr, "uusdc").Amount.GT(ownerBalance.Amount))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, position.GetPositionAddress(), "uusdc").IsZero())
 }
 
-func (suite KeeperTestSuite) TestHealthDecreaseForInterest() {
+func (suite *KeeperTestSuite) TestHealthDecreaseForInterest() {
 	k := suite.app.LeveragelpKeeper
 	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 	position, _ := suite.OpenPosition(addr)
@@ -141,3 +146,33 @@ func (suite KeeperTestSuite) TestHealthDecreaseForInterest() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(health.String(), "0.610500000000000000")
 }
+
+func (suite *KeeperTestSuite) TestLiquidate() {
+	k := suite.app.LeveragelpKeeper
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	liquidator := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	position, _ := suite.OpenPosition(addr)
+	msg := types.NewMsgLiquidate(liquidator.String(), position.Address, position.Id)
+
+	// healthy positions cannot be liquidated
+	_, err := k.Liquidate(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrPositionHealthy)
+
+	params := k.GetParams(suite.ctx)
+	params.SafetyFactor = sdk.NewDec(2)
+	suite.Require().NoError(k.SetParams(suite.ctx, &params))
+
+	ownerBalance := suite.app.BankKeeper.GetBalance(suite.ctx, addr, "uusdc")
+	_, err = k.Liquidate(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	_, err = k.GetPosition(suite.ctx, position.Address, position.Id)
+	suite.Require().ErrorIs(err, types.ErrPositionDoesNotExist)
+
+	// the liquidator bonus is carved out of the amount returned to the owner
+	bonus := suite.app.BankKeeper.GetBalance(suite.ctx, liquidator, "uusdc")
+	suite.Require().True(bonus.IsPositive())
+	returned := suite.app.BankKeeper.GetBalance(suite.ctx, addr, "uusdc").Sub(ownerBalance)
+	suite.Require().True(returned.IsPositive())
+	suite.Require().Equal(params.LiquidatorBonus.MulInt(bonus.Amount.Add(returned.Amount)).TruncateInt(), bonus.Amount)
+}
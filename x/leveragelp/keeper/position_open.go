@@ -38,6 +38,10 @@ func (k Keeper) OpenConsolidate(ctx sdk.Context, position *types.Position, msg *
 		return nil, sdkerrors.Wrap(types.ErrPositionDisabled, fmt.Sprintf("poolId: %d", poolId))
 	}
 
+	if k.amm.IsPoolPaused(ctx, poolId) {
+		return nil, sdkerrors.Wrapf(types.ErrAmmPoolPaused, "poolId: %d", poolId)
+	}
+
 	ammPool, err := k.GetAmmPool(ctx, poolId)
 	if err != nil {
 		return nil, err
@@ -37,6 +37,10 @@ func (k Keeper) CheckPoolHealth(ctx sdk.Context, poolId uint64) error {
 		return sdkerrors.Wrap(types.ErrPositionDisabled, "pool is disabled or closed")
 	}
 
+	if k.amm.IsPoolPaused(ctx, poolId) {
+		return sdkerrors.Wrapf(types.ErrAmmPoolPaused, "pool %d", poolId)
+	}
+
 	if !pool.Health.IsNil() && pool.Health.LTE(k.GetPoolOpenThreshold(ctx)) {
 		return sdkerrors.Wrap(types.ErrInvalidPosition, "pool health too low to open new positions")
 	}
@@ -85,6 +85,11 @@ func (suite KeeperTestSuite) TestCheckPoolHealth() {
 	})
 	err = k.CheckPoolHealth(suite.ctx, poolId)
 	suite.Require().NoError(err)
+
+	// AmmPoolPaused
+	suite.app.AmmKeeper.SetPausedPool(suite.ctx, ammtypes.PausedPool{PoolId: poolId})
+	err = k.CheckPoolHealth(suite.ctx, poolId)
+	suite.Require().True(errors.Is(err, types.ErrAmmPoolPaused))
 }
 
 func (suite KeeperTestSuite) TestCheckMaxOpenPositions() {
@@ -0,0 +1,15 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/leveragelp/types"
+)
+
+// V2Migration sets the params added since v1 to their defaults
+func (m Migrator) V2Migration(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.LiquidatorBonus.IsNil() {
+		params.LiquidatorBonus = types.DefaultParams().LiquidatorBonus
+	}
+	return m.keeper.SetParams(ctx, &params)
+}
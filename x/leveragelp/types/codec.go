@@ -14,6 +14,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdatePools{}, "leveragelp/UpdatePools", nil)
 	cdc.RegisterConcrete(&MsgWhitelist{}, "leveragelp/Whitelist", nil)
 	cdc.RegisterConcrete(&MsgDewhitelist{}, "leveragelp/Dewhitelist", nil)
+	cdc.RegisterConcrete(&MsgLiquidate{}, "leveragelp/Liquidate", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -25,6 +26,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdatePools{},
 		&MsgWhitelist{},
 		&MsgDewhitelist{},
+		&MsgLiquidate{},
 	)
 
 	// this line is used by starport scaffolding # 3
@@ -26,4 +26,6 @@ var (
 	ErrLeveragelpDisabled      = sdkerrors.Register(ModuleName, 33, "leveragelp disabled pool")
 	ErrAmmPoolNotFound         = sdkerrors.Register(ModuleName, 34, "amm pool not found")
 	ErrOnlyBaseCurrencyAllowed = sdkerrors.Register(ModuleName, 35, "only base currency is allowed for leverage lp")
+	ErrAmmPoolPaused           = sdkerrors.Register(ModuleName, 36, "amm pool is paused")
+	ErrPositionHealthy         = sdkerrors.Register(ModuleName, 37, "position health above safety factor")
 )
@@ -2,5 +2,6 @@ package types
 
 const EventOpen = "leveragelp/position_open"
 const EventClose = "leveragelp/position_close"
+const EventLiquidate = "leveragelp/position_liquidate"
 const EventIncrementalPayFund = "leveragelp/incremental_pay_fund"
 const EventRepayFund = "leveragelp/repay_fund"
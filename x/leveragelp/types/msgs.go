@@ -12,6 +12,7 @@ const (
 	TypeMsgWhitelist    = "whitelist"
 	TypeMsgUpdatePools  = "update_pools"
 	TypeMsgDewhitelist  = "dewhitelist"
+	TypeMsgLiquidate    = "liquidate"
 )
 
 var (
@@ -21,6 +22,7 @@ var (
 	_ sdk.Msg = &MsgWhitelist{}
 	_ sdk.Msg = &MsgUpdatePools{}
 	_ sdk.Msg = &MsgDewhitelist{}
+	_ sdk.Msg = &MsgLiquidate{}
 )
 
 func NewMsgClose(creator string, id uint64) *MsgClose {
@@ -241,3 +243,44 @@ func (msg *MsgDewhitelist) ValidateBasic() error {
 	}
 	return nil
 }
+
+func NewMsgLiquidate(liquidator string, address string, id uint64) *MsgLiquidate {
+	return &MsgLiquidate{
+		Liquidator: liquidator,
+		Address:    address,
+		Id:         id,
+	}
+}
+
+func (msg *MsgLiquidate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgLiquidate) Type() string {
+	return TypeMsgLiquidate
+}
+
+func (msg *MsgLiquidate) GetSigners() []sdk.AccAddress {
+	liquidator, err := sdk.AccAddressFromBech32(msg.Liquidator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{liquidator}
+}
+
+func (msg *MsgLiquidate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgLiquidate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Liquidator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid liquidator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid position address (%s)", err)
+	}
+	return nil
+}
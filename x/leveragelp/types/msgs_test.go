@@ -193,3 +193,44 @@ func TestMsgDewhitelist_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgLiquidate_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgLiquidate
+		err  error
+	}{
+		{
+			name: "invalid liquidator",
+			msg: MsgLiquidate{
+				Liquidator: "invalid_address",
+				Address:    sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid position address",
+			msg: MsgLiquidate{
+				Liquidator: sample.AccAddress(),
+				Address:    "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg: MsgLiquidate{
+				Liquidator: sample.AccAddress(),
+				Address:    sample.AccAddress(),
+				Id:         1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
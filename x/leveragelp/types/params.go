@@ -23,6 +23,7 @@ var (
 	KeySafetyFactor             = []byte("SafetyFactor")
 	KeyWhitelistingEnabled      = []byte("WhitelistingEnabled")
 	KeyInvariantCheckEpoch      = []byte("InvariantCheckEpoch")
+	KeyLiquidatorBonus          = []byte("LiquidatorBonus")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -44,6 +45,7 @@ func NewParams() Params {
 		SafetyFactor:             sdk.NewDec(1),
 		WhitelistingEnabled:      false,
 		InvariantCheckEpoch:      epochtypes.DayEpochID,
+		LiquidatorBonus:          sdk.NewDecWithPrec(5, 2),
 	}
 }
 
@@ -66,6 +68,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySafetyFactor, &p.SafetyFactor, validateSafetyFactor),
 		paramtypes.NewParamSetPair(KeyWhitelistingEnabled, &p.WhitelistingEnabled, validateWhitelistingEnabled),
 		paramtypes.NewParamSetPair(KeyInvariantCheckEpoch, &p.InvariantCheckEpoch, validateInvariantCheckEpoch),
+		paramtypes.NewParamSetPair(KeyLiquidatorBonus, &p.LiquidatorBonus, validateLiquidatorBonus),
 	}
 }
 
@@ -104,6 +107,9 @@ func (p Params) Validate() error {
 	if err := validateInvariantCheckEpoch(p.InvariantCheckEpoch); err != nil {
 		return err
 	}
+	if err := validateLiquidatorBonus(p.LiquidatorBonus); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -278,3 +284,19 @@ func validateInvariantCheckEpoch(i interface{}) error {
 
 	return nil
 }
+
+func validateLiquidatorBonus(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquidator bonus must be not nil")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquidator bonus must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
@@ -37,6 +37,8 @@ type Params struct {
 	WhitelistingEnabled      bool                                   `protobuf:"varint,9,opt,name=whitelisting_enabled,json=whitelistingEnabled,proto3" json:"whitelisting_enabled,omitempty"`
 	InvariantCheckEpoch      string                                 `protobuf:"bytes,10,opt,name=invariant_check_epoch,json=invariantCheckEpoch,proto3" json:"invariant_check_epoch,omitempty"`
 	EpochLength              int64                                  `protobuf:"varint,11,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// share of the collateral returned on liquidation that goes to the liquidator
+	LiquidatorBonus github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=liquidator_bonus,json=liquidatorBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidator_bonus"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -113,39 +115,40 @@ func init() {
 func init() { proto.RegisterFile("elys/leveragelp/params.proto", fileDescriptor_36c27f46b597fbee) }
 
 var fileDescriptor_36c27f46b597fbee = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x80, 0x63, 0x5a, 0x42, 0xbb, 0x29, 0x02, 0x36, 0x8d, 0x58, 0x01, 0x72, 0x02, 0x07, 0x94,
-	0x03, 0x8d, 0x05, 0x1c, 0x90, 0xb8, 0xd1, 0xd2, 0x4a, 0x48, 0x54, 0x4d, 0x03, 0x27, 0x0e, 0xac,
-	0x36, 0xf6, 0xf8, 0x47, 0xb1, 0x77, 0x9c, 0xdd, 0x4d, 0x9a, 0xbc, 0x05, 0x47, 0x8e, 0xbc, 0x01,
-	0xaf, 0xd1, 0x63, 0x8f, 0x88, 0x43, 0x85, 0x92, 0x17, 0x41, 0xde, 0x24, 0x4d, 0x10, 0x37, 0x9f,
-	0x6c, 0xcf, 0x37, 0xfb, 0xcd, 0x8c, 0xb5, 0x43, 0x9e, 0x40, 0x3a, 0xd5, 0x5e, 0x0a, 0x63, 0x50,
-	0x22, 0x82, 0x34, 0xf7, 0x72, 0xa1, 0x44, 0xa6, 0x3b, 0xb9, 0x42, 0x83, 0xf4, 0x5e, 0x41, 0x3b,
-	0x6b, 0xfa, 0x68, 0x3f, 0xc2, 0x08, 0x2d, 0xf3, 0x8a, 0xb7, 0x45, 0xda, 0xb3, 0x9f, 0x55, 0x52,
-	0xed, 0xda, 0x73, 0xf4, 0x9c, 0xec, 0xad, 0xd2, 0x79, 0x26, 0x26, 0xcc, 0x69, 0x39, 0xed, 0xdd,
-	0xc3, 0xce, 0xe5, 0x75, 0xb3, 0xf2, 0xfb, 0xba, 0xf9, 0x3c, 0x4a, 0x4c, 0x3c, 0xea, 0x77, 0x7c,
-	0xcc, 0x3c, 0x1f, 0x75, 0x86, 0x7a, 0xf9, 0x38, 0xd0, 0xc1, 0xc0, 0x33, 0xd3, 0x1c, 0x74, 0xe7,
-	0x3d, 0xf8, 0xbd, 0xda, 0xca, 0x71, 0x2a, 0x26, 0x34, 0x24, 0x0f, 0x15, 0x64, 0x38, 0x16, 0x29,
-	0x1f, 0x8e, 0x60, 0x04, 0xdc, 0xc4, 0x0a, 0x74, 0x8c, 0x69, 0xc0, 0x6e, 0x95, 0xb2, 0x37, 0x96,
-	0xba, 0xf3, 0xc2, 0xf6, 0x79, 0x25, 0xa3, 0x2f, 0x08, 0xcd, 0xc4, 0x84, 0x63, 0x0e, 0x92, 0xe7,
-	0xa8, 0x13, 0x93, 0xa0, 0xd4, 0x6c, 0xab, 0xe5, 0xb4, 0xb7, 0x7a, 0xf7, 0x33, 0x31, 0x39, 0xcb,
-	0x41, 0x76, 0x57, 0x71, 0xfa, 0x95, 0xd4, 0x73, 0xc4, 0x74, 0x91, 0xbe, 0xee, 0x68, 0xbb, 0x54,
-	0x47, 0x0f, 0x0a, 0x55, 0xe1, 0x5f, 0x77, 0x93, 0x91, 0xc7, 0x21, 0x2a, 0x1f, 0xb8, 0x9f, 0xa2,
-	0x06, 0x1e, 0x8e, 0x64, 0xc0, 0x73, 0x50, 0x3e, 0x48, 0x23, 0x22, 0x60, 0xb7, 0x4b, 0xd5, 0x61,
-	0x56, 0x79, 0x54, 0x18, 0x4f, 0x46, 0x32, 0xe8, 0xde, 0xf8, 0xe8, 0x1b, 0xc2, 0xfe, 0x2b, 0x27,
-	0x82, 0x40, 0x81, 0xd6, 0xac, 0x5a, 0xd4, 0xea, 0x35, 0xfe, 0x3d, 0xfb, 0x6e, 0x01, 0xe9, 0x19,
-	0xa9, 0xe9, 0x21, 0xcf, 0x30, 0x48, 0xc2, 0x04, 0x14, 0xbb, 0x53, 0xaa, 0x2f, 0xa2, 0x87, 0xa7,
-	0x4b, 0x03, 0xfd, 0x44, 0xee, 0x6a, 0x11, 0x82, 0x99, 0xf2, 0x50, 0xf8, 0x06, 0x15, 0xdb, 0x29,
-	0xa5, 0xdc, 0x5b, 0x48, 0x4e, 0xac, 0x83, 0xbe, 0x24, 0xfb, 0x17, 0x71, 0x62, 0x20, 0x4d, 0xb4,
-	0x49, 0x64, 0xc4, 0x41, 0x8a, 0x7e, 0x0a, 0x01, 0xdb, 0x6d, 0x39, 0xed, 0x9d, 0x5e, 0x7d, 0x93,
-	0x1d, 0x2f, 0x10, 0x7d, 0x45, 0x1a, 0x89, 0x1c, 0x0b, 0x95, 0x08, 0x69, 0xb8, 0x1f, 0x83, 0x3f,
-	0xe0, 0x90, 0xa3, 0x1f, 0x33, 0x62, 0x7f, 0x47, 0xfd, 0x06, 0x1e, 0x15, 0xec, 0xb8, 0x40, 0xf4,
-	0x29, 0xd9, 0xb3, 0x39, 0x3c, 0x05, 0x19, 0x99, 0x98, 0xd5, 0xec, 0xe5, 0xa9, 0xd9, 0xd8, 0x47,
-	0x1b, 0x7a, 0xbb, 0xfd, 0xfd, 0x47, 0xb3, 0x72, 0xf8, 0xe1, 0x72, 0xe6, 0x3a, 0x57, 0x33, 0xd7,
-	0xf9, 0x33, 0x73, 0x9d, 0x6f, 0x73, 0xb7, 0x72, 0x35, 0x77, 0x2b, 0xbf, 0xe6, 0x6e, 0xe5, 0x8b,
-	0xb7, 0x31, 0x5f, 0xb1, 0x7d, 0x07, 0x12, 0xcc, 0x05, 0xaa, 0x81, 0xfd, 0xf0, 0x26, 0x9b, 0xab,
-	0x6a, 0x87, 0xed, 0x57, 0xed, 0x0e, 0xbe, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xef, 0x62, 0x12,
-	0x18, 0xca, 0x03, 0x00, 0x00,
+	// 522 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0x87, 0x1b, 0x36, 0xf6, 0xc7, 0x2d, 0xda, 0xf0, 0x56, 0x61, 0x01, 0x4a, 0x0b, 0x07, 0xd4,
+	0x03, 0x6b, 0x04, 0x1c, 0x90, 0xb8, 0xd1, 0xb1, 0x49, 0x48, 0x4c, 0xeb, 0x0a, 0x17, 0x38, 0x60,
+	0xb9, 0xc9, 0x9b, 0xc4, 0x6a, 0x62, 0xa7, 0xb6, 0xd3, 0xb5, 0x5f, 0x81, 0x13, 0x47, 0x8e, 0x7c,
+	0x9c, 0x1d, 0x77, 0x44, 0x1c, 0x26, 0xd4, 0x7e, 0x11, 0x14, 0xb7, 0x5d, 0x8b, 0xb8, 0xe5, 0x94,
+	0xe4, 0x7d, 0x5e, 0x3f, 0xef, 0x2f, 0x51, 0x6c, 0xf4, 0x18, 0x92, 0x89, 0xf6, 0x12, 0x18, 0x81,
+	0x62, 0x11, 0x24, 0x99, 0x97, 0x31, 0xc5, 0x52, 0xdd, 0xce, 0x94, 0x34, 0x12, 0xef, 0x15, 0xb4,
+	0xbd, 0xa2, 0x0f, 0x0f, 0x23, 0x19, 0x49, 0xcb, 0xbc, 0xe2, 0x6e, 0xde, 0xf6, 0xf4, 0xdb, 0x36,
+	0xda, 0xea, 0xda, 0x75, 0xf8, 0x02, 0xd5, 0x96, 0xed, 0x34, 0x65, 0x63, 0xe2, 0x34, 0x9d, 0xd6,
+	0x6e, 0xa7, 0x7d, 0x75, 0xd3, 0xa8, 0xfc, 0xbe, 0x69, 0x3c, 0x8b, 0xb8, 0x89, 0xf3, 0x7e, 0xdb,
+	0x97, 0xa9, 0xe7, 0x4b, 0x9d, 0x4a, 0xbd, 0xb8, 0x1c, 0xe9, 0x60, 0xe0, 0x99, 0x49, 0x06, 0xba,
+	0xfd, 0x0e, 0xfc, 0x5e, 0x75, 0xe9, 0x38, 0x63, 0x63, 0x1c, 0xa2, 0x07, 0x0a, 0x52, 0x39, 0x62,
+	0x09, 0x1d, 0xe6, 0x90, 0x03, 0x35, 0xb1, 0x02, 0x1d, 0xcb, 0x24, 0x20, 0x77, 0x4a, 0xd9, 0xeb,
+	0x0b, 0xdd, 0x45, 0x61, 0xfb, 0xb4, 0x94, 0xe1, 0xe7, 0x08, 0xa7, 0x6c, 0x4c, 0x65, 0x06, 0x82,
+	0x66, 0x52, 0x73, 0xc3, 0xa5, 0xd0, 0x64, 0xa3, 0xe9, 0xb4, 0x36, 0x7a, 0xfb, 0x29, 0x1b, 0x9f,
+	0x67, 0x20, 0xba, 0xcb, 0x3a, 0xfe, 0x8a, 0x0e, 0x32, 0x29, 0x93, 0x79, 0xfb, 0x2a, 0xd1, 0x66,
+	0xa9, 0x44, 0xf7, 0x0b, 0x55, 0xe1, 0x5f, 0xa5, 0x49, 0xd1, 0xa3, 0x50, 0x2a, 0x1f, 0xa8, 0x9f,
+	0x48, 0x0d, 0x34, 0xcc, 0x45, 0x40, 0x33, 0x50, 0x3e, 0x08, 0xc3, 0x22, 0x20, 0x77, 0x4b, 0xcd,
+	0x21, 0x56, 0x79, 0x5c, 0x18, 0x4f, 0x73, 0x11, 0x74, 0x6f, 0x7d, 0xf8, 0x35, 0x22, 0xff, 0x8d,
+	0x63, 0x41, 0xa0, 0x40, 0x6b, 0xb2, 0x55, 0xcc, 0xea, 0xd5, 0xff, 0x5d, 0xfb, 0x76, 0x0e, 0xf1,
+	0x39, 0xaa, 0xea, 0x21, 0x4d, 0x65, 0xc0, 0x43, 0x0e, 0x8a, 0x6c, 0x97, 0xca, 0x85, 0xf4, 0xf0,
+	0x6c, 0x61, 0xc0, 0x1f, 0xd1, 0x3d, 0xcd, 0x42, 0x30, 0x13, 0x1a, 0x32, 0xdf, 0x48, 0x45, 0x76,
+	0x4a, 0x29, 0x6b, 0x73, 0xc9, 0xa9, 0x75, 0xe0, 0x17, 0xe8, 0xf0, 0x32, 0xe6, 0x06, 0x12, 0xae,
+	0x0d, 0x17, 0x11, 0x05, 0xc1, 0xfa, 0x09, 0x04, 0x64, 0xb7, 0xe9, 0xb4, 0x76, 0x7a, 0x07, 0xeb,
+	0xec, 0x64, 0x8e, 0xf0, 0x4b, 0x54, 0xe7, 0x62, 0xc4, 0x14, 0x67, 0xc2, 0x50, 0x3f, 0x06, 0x7f,
+	0x40, 0x21, 0x93, 0x7e, 0x4c, 0x90, 0xfd, 0x1c, 0x07, 0xb7, 0xf0, 0xb8, 0x60, 0x27, 0x05, 0xc2,
+	0x4f, 0x50, 0xcd, 0xf6, 0xd0, 0x04, 0x44, 0x64, 0x62, 0x52, 0xb5, 0x3f, 0x4f, 0xd5, 0xd6, 0x3e,
+	0xd8, 0x12, 0xfe, 0x8c, 0xf6, 0x13, 0x3e, 0xcc, 0x79, 0xc0, 0x8c, 0x54, 0xb4, 0x2f, 0x45, 0xae,
+	0x49, 0xad, 0xd4, 0x1b, 0xee, 0xad, 0x3c, 0x9d, 0x42, 0xf3, 0x66, 0xf3, 0xc7, 0xcf, 0x46, 0xa5,
+	0xf3, 0xfe, 0x6a, 0xea, 0x3a, 0xd7, 0x53, 0xd7, 0xf9, 0x33, 0x75, 0x9d, 0xef, 0x33, 0xb7, 0x72,
+	0x3d, 0x73, 0x2b, 0xbf, 0x66, 0x6e, 0xe5, 0x8b, 0xb7, 0x26, 0x2e, 0x36, 0xf6, 0x91, 0x00, 0x73,
+	0x29, 0xd5, 0xc0, 0x3e, 0x78, 0xe3, 0xf5, 0x53, 0xc0, 0x4e, 0xe9, 0x6f, 0xd9, 0xed, 0xfd, 0xea,
+	0xef, 0x00, 0xd5, 0x80, 0x5d, 0x0a, 0x25, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -168,6 +171,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LiquidatorBonus.Size()
+		i -= size
+		if _, err := m.LiquidatorBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	if m.EpochLength != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.EpochLength))
 		i--
@@ -311,6 +324,8 @@ func (m *Params) Size() (n int) {
 	if m.EpochLength != 0 {
 		n += 1 + sovParams(uint64(m.EpochLength))
 	}
+	l = m.LiquidatorBonus.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -675,6 +690,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidatorBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -571,6 +571,103 @@ func (m *MsgDewhitelistResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDewhitelistResponse proto.InternalMessageInfo
 
+type MsgLiquidate struct {
+	Liquidator string `protobuf:"bytes,1,opt,name=liquidator,proto3" json:"liquidator,omitempty"`
+	// owner of the position
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Id      uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgLiquidate) Reset()         { *m = MsgLiquidate{} }
+func (m *MsgLiquidate) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidate) ProtoMessage()    {}
+func (*MsgLiquidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307315ea7a77a411, []int{12}
+}
+func (m *MsgLiquidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiquidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiquidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLiquidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidate.Merge(m, src)
+}
+func (m *MsgLiquidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiquidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLiquidate proto.InternalMessageInfo
+
+func (m *MsgLiquidate) GetLiquidator() string {
+	if m != nil {
+		return m.Liquidator
+	}
+	return ""
+}
+
+func (m *MsgLiquidate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgLiquidate) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgLiquidateResponse struct {
+}
+
+func (m *MsgLiquidateResponse) Reset()         { *m = MsgLiquidateResponse{} }
+func (m *MsgLiquidateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidateResponse) ProtoMessage()    {}
+func (*MsgLiquidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307315ea7a77a411, []int{13}
+}
+func (m *MsgLiquidateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiquidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiquidateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLiquidateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidateResponse.Merge(m, src)
+}
+func (m *MsgLiquidateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiquidateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLiquidateResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgOpen)(nil), "elys.leveragelp.MsgOpen")
 	proto.RegisterType((*MsgOpenResponse)(nil), "elys.leveragelp.MsgOpenResponse")
@@ -584,51 +681,55 @@ func init() {
 	proto.RegisterType((*MsgWhitelistResponse)(nil), "elys.leveragelp.MsgWhitelistResponse")
 	proto.RegisterType((*MsgDewhitelist)(nil), "elys.leveragelp.MsgDewhitelist")
 	proto.RegisterType((*MsgDewhitelistResponse)(nil), "elys.leveragelp.MsgDewhitelistResponse")
+	proto.RegisterType((*MsgLiquidate)(nil), "elys.leveragelp.MsgLiquidate")
+	proto.RegisterType((*MsgLiquidateResponse)(nil), "elys.leveragelp.MsgLiquidateResponse")
 }
 
 func init() { proto.RegisterFile("elys/leveragelp/tx.proto", fileDescriptor_307315ea7a77a411) }
 
 var fileDescriptor_307315ea7a77a411 = []byte{
-	// 612 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x93, 0xf4, 0xef, 0xb6, 0x6a, 0xfa, 0x8d, 0xfa, 0xb5, 0xae, 0x29, 0x6e, 0xb0, 0x04,
-	0x64, 0x53, 0x5b, 0x04, 0x5e, 0xa0, 0xa1, 0x2c, 0x82, 0x14, 0x11, 0x2c, 0xa1, 0xa2, 0x08, 0x21,
-	0x5c, 0x7b, 0x70, 0xac, 0x8c, 0x33, 0x96, 0x67, 0x42, 0x9a, 0xb7, 0xe0, 0xb1, 0xba, 0xec, 0x12,
-	0xb1, 0xa8, 0x50, 0xb2, 0x66, 0xc1, 0x1b, 0x20, 0xff, 0xc6, 0x8d, 0xdd, 0x44, 0x20, 0xb1, 0x4a,
-	0x7c, 0xcf, 0xb9, 0xe7, 0xdc, 0xb9, 0x3e, 0x99, 0x80, 0x88, 0xc9, 0x84, 0x69, 0x04, 0x7f, 0xc1,
-	0xbe, 0x61, 0x63, 0xe2, 0x69, 0xfc, 0x4a, 0xf5, 0x7c, 0xca, 0x29, 0xaa, 0x05, 0x88, 0x3a, 0x47,
-	0xa4, 0x7d, 0x9b, 0xda, 0x34, 0xc4, 0xb4, 0xe0, 0x5b, 0x44, 0x93, 0x8e, 0x17, 0x05, 0x3c, 0xc3,
-	0x37, 0x5c, 0x16, 0xa3, 0x0f, 0x72, 0xf2, 0x13, 0x0f, 0x27, 0xa0, 0x94, 0x6b, 0xa5, 0x94, 0x44,
-	0x98, 0xf2, 0xb3, 0x0c, 0x1b, 0x1d, 0x66, 0xbf, 0xf1, 0xf0, 0x10, 0x89, 0xb0, 0x61, 0xfa, 0xd8,
-	0xe0, 0xd4, 0x17, 0x85, 0xba, 0xd0, 0xd8, 0xd2, 0x93, 0x47, 0xd4, 0x80, 0x9a, 0x49, 0x09, 0x31,
-	0x38, 0xf6, 0x0d, 0x72, 0xc6, 0x18, 0xe6, 0x62, 0x39, 0x64, 0x2c, 0x96, 0x51, 0x0f, 0xf6, 0x32,
-	0x25, 0x97, 0x8e, 0x86, 0x5c, 0xac, 0x04, 0xd4, 0x96, 0x7a, 0x7d, 0x7b, 0x52, 0xfa, 0x7e, 0x7b,
-	0xf2, 0xc4, 0x76, 0x78, 0x7f, 0x74, 0xa9, 0x9a, 0xd4, 0xd5, 0x4c, 0xca, 0x5c, 0xca, 0xe2, 0x8f,
-	0x53, 0x66, 0x0d, 0xe2, 0xb9, 0xdb, 0x43, 0xae, 0xe7, 0x74, 0xd0, 0x31, 0x6c, 0x19, 0xae, 0xdb,
-	0xa5, 0x94, 0xb4, 0x2d, 0xb1, 0x5a, 0x17, 0x1a, 0x55, 0x7d, 0x5e, 0x40, 0xaf, 0x61, 0x33, 0x39,
-	0xa2, 0xb8, 0xf6, 0xc7, 0x8e, 0xe7, 0xd8, 0xd4, 0xd3, 0x7e, 0xf4, 0x1e, 0x6a, 0xdc, 0x18, 0xe0,
-	0xae, 0x4f, 0x3f, 0x3b, 0xbc, 0xeb, 0x3b, 0x26, 0x16, 0xd7, 0xff, 0x4a, 0x72, 0x51, 0x46, 0xf9,
-	0x0f, 0x6a, 0xf1, 0xba, 0x75, 0xcc, 0x3c, 0x3a, 0x64, 0x58, 0x79, 0x01, 0x9b, 0x1d, 0x66, 0xbf,
-	0x24, 0x94, 0xe1, 0x25, 0xaf, 0x60, 0x17, 0xca, 0x8e, 0x15, 0x6e, 0xbd, 0xaa, 0x97, 0x1d, 0x4b,
-	0x41, 0xb0, 0x97, 0x74, 0xa5, 0x4a, 0x9f, 0x42, 0xf1, 0x77, 0x9e, 0x65, 0x70, 0xdc, 0x0d, 0xe3,
-	0x11, 0xee, 0x6c, 0xc4, 0xfb, 0xd4, 0x77, 0xf8, 0x24, 0x96, 0x9c, 0x17, 0x90, 0x06, 0xeb, 0x51,
-	0x8c, 0x42, 0xe1, 0xed, 0xe6, 0xa1, 0xba, 0x10, 0x46, 0x35, 0x92, 0xd1, 0x63, 0x9a, 0x72, 0x04,
-	0x87, 0x0b, 0x0e, 0xa9, 0xb9, 0x01, 0xbb, 0x73, 0x88, 0x52, 0xb2, 0xca, 0xfb, 0x19, 0xac, 0x05,
-	0x39, 0x0c, 0xac, 0x2b, 0x8d, 0xed, 0xe6, 0xff, 0x79, 0x6b, 0x4a, 0x49, 0xab, 0x1a, 0x2c, 0x5c,
-	0x8f, 0x98, 0x8a, 0x08, 0x07, 0x77, 0x2d, 0x52, 0xf3, 0x0f, 0xb0, 0xd3, 0x61, 0xf6, 0x45, 0xdf,
-	0xe1, 0x98, 0x38, 0x8c, 0xaf, 0xb0, 0x56, 0x01, 0x8d, 0x13, 0x2a, 0xb6, 0xce, 0x2c, 0xcb, 0xc7,
-	0x8c, 0xc5, 0x89, 0x2e, 0x40, 0x94, 0x03, 0xd8, 0xcf, 0xaa, 0xa7, 0xae, 0x1f, 0xc3, 0x23, 0x9f,
-	0xe3, 0xf1, 0x3f, 0xf2, 0x8d, 0xce, 0x9b, 0xd1, 0x4f, 0x9c, 0x9b, 0xbf, 0x2a, 0x50, 0xe9, 0x30,
-	0x1b, 0xb5, 0xa0, 0x1a, 0xfd, 0x74, 0x73, 0xdb, 0x8b, 0x53, 0x26, 0xd5, 0xef, 0x43, 0x12, 0x2d,
-	0xf4, 0x0a, 0xd6, 0xa2, 0xf0, 0x1d, 0x15, 0x51, 0x43, 0x48, 0x7a, 0x74, 0x2f, 0x94, 0xca, 0xf4,
-	0x60, 0xe7, 0x4e, 0xf2, 0x0a, 0x8d, 0xb3, 0x0c, 0xa9, 0xb1, 0x8a, 0x91, 0x6a, 0x5f, 0xc0, 0x76,
-	0x36, 0x58, 0x27, 0x4b, 0x1a, 0x03, 0x82, 0xf4, 0x74, 0x05, 0x21, 0x15, 0x7e, 0x0b, 0x5b, 0xf3,
-	0xd0, 0x3c, 0x2c, 0xea, 0x4a, 0x61, 0xe9, 0xf1, 0x52, 0x38, 0x3b, 0x6b, 0x36, 0x11, 0x85, 0xb3,
-	0x66, 0x08, 0xc5, 0xb3, 0x16, 0xbc, 0xf3, 0x56, 0xfb, 0x7a, 0x2a, 0x0b, 0x37, 0x53, 0x59, 0xf8,
-	0x31, 0x95, 0x85, 0xaf, 0x33, 0xb9, 0x74, 0x33, 0x93, 0x4b, 0xdf, 0x66, 0x72, 0xa9, 0xa7, 0x65,
-	0x6e, 0xa3, 0x40, 0xec, 0x74, 0x88, 0xf9, 0x98, 0xfa, 0x83, 0xf0, 0x41, 0xbb, 0xca, 0xfd, 0x2f,
-	0x5c, 0xae, 0x87, 0x97, 0xff, 0xf3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xd8, 0xc1, 0xff,
-	0x96, 0x06, 0x00, 0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x5d, 0x4f, 0xd4, 0x40,
+	0x14, 0xdd, 0x2f, 0xbe, 0x2e, 0x84, 0xc5, 0x09, 0x42, 0xa9, 0x58, 0xb0, 0x09, 0xb2, 0x2f, 0xb4,
+	0x11, 0xfd, 0x03, 0xac, 0xf8, 0x80, 0x71, 0xe3, 0xda, 0xc4, 0x40, 0x88, 0x31, 0x96, 0xed, 0x58,
+	0x1a, 0xa6, 0x3b, 0xb5, 0x33, 0x2b, 0xf0, 0x2f, 0xfc, 0x51, 0x3e, 0xf0, 0xc8, 0xa3, 0xf1, 0x81,
+	0x18, 0x78, 0xf6, 0x3f, 0x98, 0x4e, 0xdb, 0x69, 0x77, 0x5b, 0x76, 0xa3, 0x89, 0x4f, 0xbb, 0x33,
+	0xe7, 0xdc, 0x73, 0xee, 0xbd, 0x3d, 0x69, 0x41, 0xc1, 0xe4, 0x92, 0x99, 0x04, 0x7f, 0xc5, 0xa1,
+	0xed, 0x62, 0x12, 0x98, 0xfc, 0xc2, 0x08, 0x42, 0xca, 0x29, 0x6a, 0x46, 0x88, 0x91, 0x21, 0xea,
+	0xb2, 0x4b, 0x5d, 0x2a, 0x30, 0x33, 0xfa, 0x17, 0xd3, 0xd4, 0xf5, 0x51, 0x81, 0xc0, 0x0e, 0x6d,
+	0x9f, 0x25, 0xe8, 0xa3, 0x82, 0xfc, 0x65, 0x80, 0x53, 0x50, 0x2d, 0x94, 0x52, 0x4a, 0x62, 0x4c,
+	0xff, 0x5d, 0x83, 0x99, 0x0e, 0x73, 0xdf, 0x06, 0xb8, 0x8f, 0x14, 0x98, 0xe9, 0x85, 0xd8, 0xe6,
+	0x34, 0x54, 0xaa, 0x9b, 0xd5, 0xd6, 0x9c, 0x95, 0x1e, 0x51, 0x0b, 0x9a, 0x3d, 0x4a, 0x88, 0xcd,
+	0x71, 0x68, 0x93, 0x3d, 0xc6, 0x30, 0x57, 0x6a, 0x82, 0x31, 0x7a, 0x8d, 0x8e, 0x61, 0x29, 0x77,
+	0xe5, 0xd3, 0x41, 0x9f, 0x2b, 0xf5, 0x88, 0xda, 0x36, 0xae, 0x6e, 0x36, 0x2a, 0x3f, 0x6f, 0x36,
+	0x9e, 0xba, 0x1e, 0x3f, 0x1d, 0x9c, 0x18, 0x3d, 0xea, 0x9b, 0x3d, 0xca, 0x7c, 0xca, 0x92, 0x9f,
+	0x1d, 0xe6, 0x9c, 0x25, 0x7d, 0x1f, 0xf4, 0xb9, 0x55, 0xd0, 0x41, 0xeb, 0x30, 0x67, 0xfb, 0x7e,
+	0x97, 0x52, 0x72, 0xe0, 0x28, 0x8d, 0xcd, 0x6a, 0xab, 0x61, 0x65, 0x17, 0xe8, 0x35, 0xcc, 0xa6,
+	0x23, 0x2a, 0x53, 0x7f, 0xed, 0xb8, 0x8f, 0x7b, 0x96, 0xac, 0x47, 0x47, 0xd0, 0xe4, 0xf6, 0x19,
+	0xee, 0x86, 0xf4, 0xb3, 0xc7, 0xbb, 0xa1, 0xd7, 0xc3, 0xca, 0xf4, 0x3f, 0x49, 0x8e, 0xca, 0xe8,
+	0x0f, 0xa0, 0x99, 0xac, 0xdb, 0xc2, 0x2c, 0xa0, 0x7d, 0x86, 0xf5, 0x17, 0x30, 0xdb, 0x61, 0xee,
+	0x4b, 0x42, 0x19, 0x1e, 0xf3, 0x08, 0x16, 0xa1, 0xe6, 0x39, 0x62, 0xeb, 0x0d, 0xab, 0xe6, 0x39,
+	0x3a, 0x82, 0xa5, 0xb4, 0x4a, 0x2a, 0x7d, 0x12, 0xe2, 0xef, 0x03, 0xc7, 0xe6, 0xb8, 0x2b, 0xe2,
+	0x21, 0x76, 0x36, 0xe0, 0xa7, 0x34, 0xf4, 0xf8, 0x65, 0x22, 0x99, 0x5d, 0x20, 0x13, 0xa6, 0xe3,
+	0x18, 0x09, 0xe1, 0xf9, 0xdd, 0x55, 0x63, 0x24, 0x8c, 0x46, 0x2c, 0x63, 0x25, 0x34, 0x7d, 0x0d,
+	0x56, 0x47, 0x1c, 0xa4, 0xb9, 0x0d, 0x8b, 0x19, 0x44, 0x29, 0x99, 0xe4, 0xfd, 0x0c, 0xa6, 0xa2,
+	0x1c, 0x46, 0xd6, 0xf5, 0xd6, 0xfc, 0xee, 0xc3, 0xa2, 0x35, 0xa5, 0xa4, 0xdd, 0x88, 0x16, 0x6e,
+	0xc5, 0x4c, 0x5d, 0x81, 0x95, 0x61, 0x0b, 0x69, 0xfe, 0x01, 0x16, 0x3a, 0xcc, 0x3d, 0x3c, 0xf5,
+	0x38, 0x26, 0x1e, 0xe3, 0x13, 0xac, 0x0d, 0x40, 0xe7, 0x29, 0x15, 0x3b, 0x7b, 0x8e, 0x13, 0x62,
+	0xc6, 0x92, 0x44, 0x97, 0x20, 0xfa, 0x0a, 0x2c, 0xe7, 0xd5, 0xa5, 0xeb, 0x47, 0x31, 0xf2, 0x3e,
+	0x3e, 0xff, 0x4f, 0xbe, 0xf1, 0xbc, 0x39, 0x7d, 0xe9, 0x7c, 0x24, 0xe6, 0x7d, 0xe3, 0x7d, 0x19,
+	0x78, 0xd1, 0x32, 0x90, 0x06, 0x40, 0x92, 0x83, 0x8c, 0x4e, 0xee, 0x26, 0xca, 0x95, 0x3d, 0x64,
+	0x97, 0x1e, 0x93, 0x5c, 0xd5, 0x65, 0xae, 0xe2, 0x59, 0xa5, 0x72, 0xea, 0xb8, 0xfb, 0xbd, 0x01,
+	0xf5, 0x0e, 0x73, 0x51, 0x1b, 0x1a, 0xf1, 0xcb, 0xa2, 0xf0, 0xbc, 0x92, 0x5c, 0xab, 0x9b, 0xf7,
+	0x21, 0xa9, 0x16, 0x7a, 0x05, 0x53, 0x71, 0xdc, 0xd7, 0xca, 0xa8, 0x02, 0x52, 0x9f, 0xdc, 0x0b,
+	0x49, 0x99, 0x63, 0x58, 0x18, 0xca, 0x7a, 0xa9, 0x71, 0x9e, 0xa1, 0xb6, 0x26, 0x31, 0xa4, 0xf6,
+	0x21, 0xcc, 0xe7, 0xa3, 0xbc, 0x31, 0xa6, 0x30, 0x22, 0xa8, 0xdb, 0x13, 0x08, 0x52, 0xf8, 0x1d,
+	0xcc, 0x65, 0x31, 0x7d, 0x5c, 0x56, 0x25, 0x61, 0x75, 0x6b, 0x2c, 0x9c, 0xef, 0x35, 0x9f, 0xc1,
+	0xd2, 0x5e, 0x73, 0x04, 0x75, 0x7b, 0x02, 0x21, 0xdf, 0x6b, 0x16, 0xb1, 0xd2, 0x5e, 0x25, 0xac,
+	0x6e, 0x8d, 0x85, 0x53, 0xc9, 0xf6, 0xc1, 0xd5, 0xad, 0x56, 0xbd, 0xbe, 0xd5, 0xaa, 0xbf, 0x6e,
+	0xb5, 0xea, 0xb7, 0x3b, 0xad, 0x72, 0x7d, 0xa7, 0x55, 0x7e, 0xdc, 0x69, 0x95, 0x63, 0x33, 0xf7,
+	0x4a, 0x8d, 0xa4, 0x76, 0xfa, 0x98, 0x9f, 0xd3, 0xf0, 0x4c, 0x1c, 0xcc, 0x8b, 0xc2, 0xc7, 0xed,
+	0x64, 0x5a, 0x7c, 0xc1, 0x9e, 0xff, 0x19, 0x00, 0x20, 0xb5, 0xc6, 0x9c, 0x5b, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -649,6 +750,7 @@ type MsgClient interface {
 	UpdatePools(ctx context.Context, in *MsgUpdatePools, opts ...grpc.CallOption) (*MsgUpdatePoolsResponse, error)
 	Whitelist(ctx context.Context, in *MsgWhitelist, opts ...grpc.CallOption) (*MsgWhitelistResponse, error)
 	Dewhitelist(ctx context.Context, in *MsgDewhitelist, opts ...grpc.CallOption) (*MsgDewhitelistResponse, error)
+	Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error)
 }
 
 type msgClient struct {
@@ -713,6 +815,15 @@ func (c *msgClient) Dewhitelist(ctx context.Context, in *MsgDewhitelist, opts ..
 	return out, nil
 }
 
+func (c *msgClient) Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error) {
+	out := new(MsgLiquidateResponse)
+	err := c.cc.Invoke(ctx, "/elys.leveragelp.Msg/Liquidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	Open(context.Context, *MsgOpen) (*MsgOpenResponse, error)
@@ -721,6 +832,7 @@ type MsgServer interface {
 	UpdatePools(context.Context, *MsgUpdatePools) (*MsgUpdatePoolsResponse, error)
 	Whitelist(context.Context, *MsgWhitelist) (*MsgWhitelistResponse, error)
 	Dewhitelist(context.Context, *MsgDewhitelist) (*MsgDewhitelistResponse, error)
+	Liquidate(context.Context, *MsgLiquidate) (*MsgLiquidateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -745,6 +857,9 @@ func (*UnimplementedMsgServer) Whitelist(ctx context.Context, req *MsgWhitelist)
 func (*UnimplementedMsgServer) Dewhitelist(ctx context.Context, req *MsgDewhitelist) (*MsgDewhitelistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Dewhitelist not implemented")
 }
+func (*UnimplementedMsgServer) Liquidate(ctx context.Context, req *MsgLiquidate) (*MsgLiquidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Liquidate not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -858,6 +973,24 @@ func _Msg_Dewhitelist_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Liquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Liquidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.leveragelp.Msg/Liquidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Liquidate(ctx, req.(*MsgLiquidate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "elys.leveragelp.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -886,6 +1019,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Dewhitelist",
 			Handler:    _Msg_Dewhitelist_Handler,
 		},
+		{
+			MethodName: "Liquidate",
+			Handler:    _Msg_Liquidate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "elys/leveragelp/tx.proto",
@@ -1296,6 +1433,71 @@ func (m *MsgDewhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgLiquidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Liquidator) > 0 {
+		i -= len(m.Liquidator)
+		copy(dAtA[i:], m.Liquidator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Liquidator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1473,6 +1675,35 @@ func (m *MsgDewhitelistResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgLiquidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Liquidator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgLiquidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2577,6 +2808,189 @@ func (m *MsgDewhitelistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
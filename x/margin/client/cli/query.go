@@ -34,6 +34,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListPool())
 	cmd.AddCommand(CmdShowPool())
 	cmd.AddCommand(CmdMtp())
+	cmd.AddCommand(CmdInsuranceFund())
+	cmd.AddCommand(CmdBadDebts())
+	cmd.AddCommand(CmdGetOrdersForAddress())
 
 	// this line is used by starport scaffolding # 1
 
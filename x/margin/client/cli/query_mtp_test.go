@@ -53,6 +53,8 @@ func networkWithMTPObjects(t *testing.T, n int) (*network.Network, []*types.MTP)
 			ConsolidateLeverage:       sdk.ZeroDec(),
 			SumCollateral:             sdk.ZeroInt(),
 			TakeProfitPrice:           sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+			StopLossPrice:             sdk.ZeroDec(),
+			UnitHealthPrice:           sdk.ZeroDec(),
 		}
 
 		mtps = append(mtps, &mtp)
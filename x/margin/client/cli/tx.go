@@ -36,6 +36,12 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdatePools())
 	cmd.AddCommand(CmdWhitelist())
 	cmd.AddCommand(CmdDewhitelist())
+	cmd.AddCommand(CmdUpdateStopLoss())
+	cmd.AddCommand(CmdAddCollateral())
+	cmd.AddCommand(CmdRemoveCollateral())
+	cmd.AddCommand(CmdLiquidate())
+	cmd.AddCommand(CmdPlaceMarginOrder())
+	cmd.AddCommand(CmdCancelMarginOrder())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/margin/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdAddCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-collateral [mtp-id] [collateral] [flags]",
+		Short:   "Add collateral to a margin position, repaying part of its liabilities",
+		Example: `elysd tx margin add-collateral 1 1000uusdc --from=treasury --keyring-backend=test --chain-id=elystestnet-1 --yes --gas=1000000`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			signer := clientCtx.GetFromAddress()
+			if signer == nil {
+				return errors.New("signer address is missing")
+			}
+
+			argMtpId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.New("invalid mtp id")
+			}
+
+			argCollateral, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddCollateral(
+				signer.String(),
+				argMtpId,
+				argCollateral,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
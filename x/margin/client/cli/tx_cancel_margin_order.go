@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/elys-network/elys/x/margin/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdCancelMarginOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-order [order-id] [flags]",
+		Short:   "Cancel a pending margin order and refund its collateral",
+		Example: `elysd tx margin cancel-order 1 --from=treasury --keyring-backend=test --chain-id=elystestnet-1 --yes --gas=1000000`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			signer := clientCtx.GetFromAddress()
+			if signer == nil {
+				return errors.New("signer address is missing")
+			}
+
+			argOrderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.New("invalid order id")
+			}
+
+			msg := types.NewMsgCancelMarginOrder(
+				signer.String(),
+				argOrderId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
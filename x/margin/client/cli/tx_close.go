@@ -7,18 +7,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/elys-network/elys/x/margin/types"
 	"github.com/spf13/cobra"
 )
 
 var _ = strconv.Itoa(0)
 
+const (
+	flagAmount = "amount"
+)
+
 func CmdClose() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "close [mtp-id] [flags]",
-		Short:   "Close margin position",
-		Example: `elysd tx margin close 1 --from=treasury --keyring-backend=test --chain-id=elystestnet-1 --yes --gas=1000000`,
-		Args:    cobra.ExactArgs(1),
+		Use:   "close [mtp-id] [flags]",
+		Short: "Close margin position",
+		Example: `elysd tx margin close 1 --from=treasury --keyring-backend=test --chain-id=elystestnet-1 --yes --gas=1000000
+Partial close:
+elysd tx margin close 1 --amount 5000 --from=treasury --keyring-backend=test --chain-id=elystestnet-1 --yes --gas=1000000`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -34,9 +41,20 @@ func CmdClose() *cobra.Command {
 				return errors.New("invalid mtp id")
 			}
 
+			amountStr, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return err
+			}
+
+			amount, valid := sdk.NewIntFromString(amountStr)
+			if !valid {
+				return errors.New("invalid close amount")
+			}
+
 			msg := types.NewMsgClose(
 				signer.String(),
 				argMtpId,
+				amount,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -45,6 +63,7 @@ func CmdClose() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagAmount, "0", "Optional custody amount to unwind, 0 closes the whole position")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
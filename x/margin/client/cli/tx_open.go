@@ -16,6 +16,7 @@ var _ = strconv.Itoa(0)
 
 const (
 	flagTakeProfitPrice = "take-profit"
+	flagStopLossPrice   = "stop-loss"
 )
 
 func CmdOpen() *cobra.Command {
@@ -72,6 +73,16 @@ elysd tx margin open short 5 uusdc 100000000 uatom --take-profit 100 --from=trea
 				}
 			}
 
+			stopLossPriceStr, err := cmd.Flags().GetString(flagStopLossPrice)
+			if err != nil {
+				return err
+			}
+
+			stopLossPrice, err := sdk.NewDecFromStr(stopLossPriceStr)
+			if err != nil {
+				return errors.New("invalid stop loss price")
+			}
+
 			msg := types.NewMsgOpen(
 				signer.String(),
 				argCollateralAsset,
@@ -80,6 +91,7 @@ elysd tx margin open short 5 uusdc 100000000 uatom --take-profit 100 --from=trea
 				argPosition,
 				argLeverage,
 				takeProfitPrice,
+				stopLossPrice,
 			)
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -90,6 +102,7 @@ elysd tx margin open short 5 uusdc 100000000 uatom --take-profit 100 --from=trea
 	}
 
 	cmd.Flags().String(flagTakeProfitPrice, types.InfinitePriceString, "Optional take profit price")
+	cmd.Flags().String(flagStopLossPrice, "0", "Optional stop loss price")
 
 	flags.AddTxFlagsToCmd(cmd)
 
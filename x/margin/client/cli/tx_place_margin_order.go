@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/margin/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagExpiry = "expiry"
+)
+
+func CmdPlaceMarginOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-order [position] [leverage] [collateral-asset] [collateral-amount] [borrow-asset] [trigger-price] [flags]",
+		Short: "Place an order opening a margin position when the trigger price is reached",
+		Example: `Long opened once the price falls to the trigger price:
+elysd tx margin place-order long 5 uusdc 100000000 uatom 9.5 --from=treasury --keyring-backend=test --chain-id=elystestnet-1 --yes --gas=1000000
+Short opened once the price rises to the trigger price, unless it expires first:
+elysd tx margin place-order short 5 uusdc 100000000 uatom 11 --expiry 2024-01-01T00:00:00Z --from=treasury --keyring-backend=test --chain-id=elystestnet-1 --yes --gas=1000000`,
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			if signer == nil {
+				return errors.New("signer address is missing")
+			}
+
+			argPosition := types.GetPositionFromString(args[0])
+
+			argLeverage, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			argCollateralAsset := args[2]
+
+			argCollateralAmount, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return errors.New("invalid collateral amount")
+			}
+
+			argBorrowAsset := args[4]
+
+			argTriggerPrice, err := sdk.NewDecFromStr(args[5])
+			if err != nil {
+				return errors.New("invalid trigger price")
+			}
+
+			takeProfitPriceStr, err := cmd.Flags().GetString(flagTakeProfitPrice)
+			if err != nil {
+				return err
+			}
+
+			var takeProfitPrice sdk.Dec
+			if takeProfitPriceStr != types.InfinitePriceString {
+				takeProfitPrice, err = sdk.NewDecFromStr(takeProfitPriceStr)
+				if err != nil {
+					return errors.New("invalid take profit price")
+				}
+			} else {
+				takeProfitPrice, err = sdk.NewDecFromStr(types.TakeProfitPriceDefault)
+				if err != nil {
+					return errors.New("failed to set default take profit price")
+				}
+			}
+
+			stopLossPriceStr, err := cmd.Flags().GetString(flagStopLossPrice)
+			if err != nil {
+				return err
+			}
+
+			stopLossPrice, err := sdk.NewDecFromStr(stopLossPriceStr)
+			if err != nil {
+				return errors.New("invalid stop loss price")
+			}
+
+			expiryStr, err := cmd.Flags().GetString(flagExpiry)
+			if err != nil {
+				return err
+			}
+
+			var expiry *time.Time
+			if expiryStr != "" {
+				expiryTime, err := time.Parse(time.RFC3339, expiryStr)
+				if err != nil {
+					return errors.New("invalid expiry")
+				}
+				expiry = &expiryTime
+			}
+
+			msg := types.NewMsgPlaceMarginOrder(
+				signer.String(),
+				argCollateralAsset,
+				argCollateralAmount,
+				argBorrowAsset,
+				argPosition,
+				argLeverage,
+				takeProfitPrice,
+				stopLossPrice,
+				argTriggerPrice,
+				expiry,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagTakeProfitPrice, types.InfinitePriceString, "Optional take profit price")
+	cmd.Flags().String(flagStopLossPrice, "0", "Optional stop loss price")
+	cmd.Flags().String(flagExpiry, "", "Block time (RFC3339) after which the order is refunded, within max_order_duration")
+	_ = cmd.MarkFlagRequired(flagExpiry)
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
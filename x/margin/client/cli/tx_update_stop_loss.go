@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/margin/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdUpdateStopLoss() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-stop-loss [mtp-id] [stop-loss-price] [flags]",
+		Short:   "Update the stop loss price of a margin position, 0 removes it",
+		Example: `elysd tx margin update-stop-loss 1 8.5 --from=treasury --keyring-backend=test --chain-id=elystestnet-1 --yes --gas=1000000`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			signer := clientCtx.GetFromAddress()
+			if signer == nil {
+				return errors.New("signer address is missing")
+			}
+
+			argMtpId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.New("invalid mtp id")
+			}
+
+			argStopLossPrice, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return errors.New("invalid stop loss price")
+			}
+
+			msg := types.NewMsgUpdateStopLoss(
+				signer.String(),
+				argMtpId,
+				argStopLossPrice,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -8,6 +8,9 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Params are needed to index mtps in the liquidation queue
+	k.SetParams(ctx, &genState.Params)
+
 	// Set all the pool
 	for _, elem := range genState.PoolList {
 		k.SetPool(ctx, elem)
@@ -28,8 +31,23 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.WhitelistAddress(ctx, elem)
 	}
 
+	// Set all the bad debts
+	for _, elem := range genState.BadDebtList {
+		k.SetBadDebt(ctx, elem)
+		if elem.Id > k.GetBadDebtCount(ctx) {
+			k.SetBadDebtCount(ctx, elem.Id)
+		}
+	}
+
+	// Set all the pending orders
+	for _, elem := range genState.OrderList {
+		k.SetOrder(ctx, elem)
+		if elem.Id > k.GetOrderCount(ctx) {
+			k.SetOrderCount(ctx, elem.Id)
+		}
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
-	k.SetParams(ctx, &genState.Params)
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -40,6 +58,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.PoolList = k.GetAllPools(ctx)
 	genesis.MtpList = k.GetAllMTPs(ctx)
 	genesis.AddressWhitelist = k.GetAllWhitelistedAddress(ctx)
+	genesis.BadDebtList = k.GetAllBadDebts(ctx)
+	genesis.OrderList = k.GetAllOrders(ctx)
 
 	return genesis
 }
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+func (k Keeper) AddCollateral(ctx sdk.Context, msg *types.MsgAddCollateral) (*types.MsgAddCollateralResponse, error) {
+	mtp, err := k.GetMTP(ctx, msg.Creator, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, found := k.GetPool(ctx, mtp.AmmPoolId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrPoolDoesNotExist, "pool %d", mtp.AmmPoolId)
+	}
+
+	ammPool, err := k.GetAmmPool(ctx, mtp.AmmPoolId, msg.Collateral.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.UpdateMTPCollateral(ctx, &mtp, &pool, ammPool, msg.Collateral, true); err != nil {
+		return nil, err
+	}
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	ammPoolAddr, err := sdk.AccAddressFromBech32(ammPool.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, creator, ammPoolAddr, sdk.NewCoins(msg.Collateral)); err != nil {
+		return nil, err
+	}
+
+	k.SetPool(ctx, pool)
+	if err := k.SetMTP(ctx, &mtp); err != nil {
+		return nil, err
+	}
+
+	if k.hooks != nil {
+		k.hooks.AfterMarginPositionModified(ctx, ammPool, pool)
+	}
+
+	k.EmitUpdateCollateral(ctx, types.EventAddCollateral, &mtp, msg.Collateral)
+
+	return &types.MsgAddCollateralResponse{}, nil
+}
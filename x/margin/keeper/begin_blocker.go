@@ -9,6 +9,26 @@ import (
 )
 
 func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	// close positions whose take profit or stop loss price is reached, up to the per pool cap so that
+	// a busy pool doesn't delay the others
+	triggerLimit := k.GetMaxTriggersPerBlock(ctx)
+	for _, poolId := range k.GetEnabledPools(ctx) {
+		ammPool, err := k.GetAmmPool(ctx, poolId, "")
+		if err != nil {
+			ctx.Logger().Error(errors.Wrap(err, fmt.Sprintf("error getting amm pool: %d", poolId)).Error())
+			continue
+		}
+		price := k.GetPoolTradingAssetPrice(ctx, poolId)
+		mtps := k.GetTakeProfitQueueMTPs(ctx, poolId, price, triggerLimit)
+		for _, mtp := range mtps {
+			BeginBlockerProcessTakeProfit(ctx, k, mtp, ammPool)
+		}
+		mtps = k.GetStopLossQueueMTPs(ctx, poolId, price, triggerLimit-int64(len(mtps)))
+		for _, mtp := range mtps {
+			BeginBlockerProcessStopLoss(ctx, k, mtp, ammPool)
+		}
+	}
+
 	//check if epoch has passed then execute
 	epochLength := k.GetEpochLength(ctx)
 	epochPosition := k.GetEpochPosition(ctx, epochLength)
@@ -33,16 +53,36 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 				}
 				pool.InterestRate = rate
 				pool.LastHeightInterestRateComputed = currentHeight
+				// the ending epoch accrues at the rate set when it started, then the rate of the next one is set
+				pool.AccrueFunding()
+				pool.FundingRate = k.FundingRateComputation(ctx, pool)
 				_ = k.UpdatePoolHealth(ctx, &pool)
 				// TODO: function missing
 				// k.TrackSQBeginBlock(ctx, pool)
-				mtps, _, _ := k.GetMTPsForPool(ctx, pool.AmmPoolId, nil)
-				for _, mtp := range mtps {
-					BeginBlockerProcessMTP(ctx, k, mtp, pool, ammPool)
-				}
 			}
 			k.SetPool(ctx, pool)
 		}
 	}
 
+	// force close unhealthy positions whose liquidation price is crossed, up to the per pool cap
+	limit := k.GetMaxLiquidationsPerBlock(ctx)
+	for _, poolId := range k.GetEnabledPools(ctx) {
+		price := k.GetPoolTradingAssetPrice(ctx, poolId)
+		mtps := k.GetLiquidationQueueMTPs(ctx, poolId, price, limit)
+		for _, mtp := range mtps {
+			// pools are updated by every force close
+			pool, found := k.GetPool(ctx, poolId)
+			if !found {
+				break
+			}
+			ammPool, err := k.GetAmmPool(ctx, poolId, "")
+			if err != nil {
+				ctx.Logger().Error(errors.Wrap(err, fmt.Sprintf("error getting amm pool: %d", poolId)).Error())
+				break
+			}
+			BeginBlockerProcessMTP(ctx, k, mtp, pool, ammPool)
+		}
+	}
+	// open the positions of pending orders whose trigger price is reached
+	k.ProcessMarginOrders(ctx)
 }
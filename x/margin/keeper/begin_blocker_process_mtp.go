@@ -1,9 +1,10 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
-	"github.com/cosmos/cosmos-sdk/types/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -19,50 +20,53 @@ func BeginBlockerProcessMTP(ctx sdk.Context, k Keeper, mtp *types.MTP, pool type
 			}
 		}
 	}()
+	// the force close updates the mtp, the queue entry is the one of the stored mtp
+	queued := *mtp
+
 	h, err := k.UpdateMTPHealth(ctx, *mtp, ammPool)
 	if err != nil {
-		ctx.Logger().Error(errors.Wrap(err, fmt.Sprintf("error updating mtp health: %s", mtp.String())).Error())
+		ctx.Logger().Error(sdkerrors.Wrap(err, fmt.Sprintf("error updating mtp health: %s", mtp.String())).Error())
+		k.dropLiquidationQueue(ctx, queued, err)
 		return
 	}
 	mtp.MtpHealth = h
-	// compute interest
-	// TODO: missing fields
-	for _, custody := range mtp.Custodies {
-		custodyAsset := custody.Denom
-		// Retrieve AmmPool
-		ammPool, err := k.CloseLongChecker.GetAmmPool(ctx, mtp.AmmPoolId, custodyAsset)
-		if err != nil {
-			ctx.Logger().Error(errors.Wrap(err, fmt.Sprintf("error retrieving amm pool: %d", mtp.AmmPoolId)).Error())
-			return
-		}
-
-		for _, collateral := range mtp.Collaterals {
-			collateralAsset := collateral.Denom
-			// Handle Interest if within epoch position
-			if err := k.CloseLongChecker.HandleInterest(ctx, mtp, &pool, ammPool, collateralAsset, custodyAsset); err != nil {
-				ctx.Logger().Error(errors.Wrap(err, fmt.Sprintf("error handling interest payment: %s", collateralAsset)).Error())
-				return
-			}
-		}
-	}
-
-	_ = k.SetMTP(ctx, mtp)
 
+	// the force close handles the interest, it is settled only if the close succeeds
+	cacheCtx, write := ctx.CacheContext()
 	var repayAmount sdk.Int
 	switch mtp.Position {
 	case types.Position_LONG:
-		repayAmount, err = k.ForceCloseLong(ctx, mtp, &pool, true)
+		repayAmount, err = k.ForceCloseLong(cacheCtx, mtp, &pool, nil)
 	case types.Position_SHORT:
-		repayAmount, err = k.ForceCloseShort(ctx, mtp, &pool, true)
+		repayAmount, err = k.ForceCloseShort(cacheCtx, mtp, &pool, nil)
 	default:
-		ctx.Logger().Error(errors.Wrap(types.ErrInvalidPosition, fmt.Sprintf("invalid position type: %s", mtp.Position)).Error())
+		err = sdkerrors.Wrap(types.ErrInvalidPosition, fmt.Sprintf("invalid position type: %s", mtp.Position))
 	}
 
-	if err == nil {
+	switch {
+	case err == nil:
+		write()
 		// Emit event if position was closed
 		k.EmitForceClose(ctx, mtp, repayAmount, "")
-	} else if err != types.ErrMTPHealthy {
-		ctx.Logger().Error(errors.Wrap(err, "error executing force close").Error())
+	case errors.Is(err, types.ErrMTPHealthy):
+		// the price moved since the mtp was indexed, index the stored mtp again at its current health
+		stored, err := k.GetMTP(ctx, queued.Address, queued.Id)
+		if err == nil {
+			err = k.SetMTP(ctx, &stored)
+		}
+		if err != nil {
+			ctx.Logger().Error(sdkerrors.Wrap(err, fmt.Sprintf("error indexing mtp: %s", queued.String())).Error())
+			k.dropLiquidationQueue(ctx, queued, err)
+		}
+	default:
+		ctx.Logger().Error(sdkerrors.Wrap(err, "error executing force close").Error())
+		k.dropLiquidationQueue(ctx, queued, err)
 	}
+}
 
+// dropLiquidationQueue removes the mtp from the liquidation queue so that it doesn't block the ones behind it.
+// It is indexed again when updated and can still be closed with MsgLiquidate.
+func (k Keeper) dropLiquidationQueue(ctx sdk.Context, mtp types.MTP, err error) {
+	k.RemoveLiquidationQueue(ctx, mtp)
+	k.EmitQueueDrop(ctx, mtp, "liquidation", err)
 }
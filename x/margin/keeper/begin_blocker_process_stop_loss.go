@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+// BeginBlockerProcessStopLoss force closes the mtp when its stop loss is reached
+func BeginBlockerProcessStopLoss(ctx sdk.Context, k Keeper, mtp *types.MTP, ammPool ammtypes.Pool) bool {
+	if !k.IsStopLossReached(ctx, *mtp, ammPool) {
+		return false
+	}
+
+	pool, found := k.GetPool(ctx, mtp.AmmPoolId)
+	if !found {
+		ctx.Logger().Error(errors.Wrap(types.ErrPoolDoesNotExist, fmt.Sprintf("pool: %d", mtp.AmmPoolId)).Error())
+		return false
+	}
+
+	// the force close updates the mtp, the queue entry is the one of the stored mtp
+	queued := *mtp
+	cacheCtx, write := ctx.CacheContext()
+	var repayAmount sdk.Int
+	var err error
+	switch mtp.Position {
+	case types.Position_LONG:
+		repayAmount, err = k.ForceCloseLong(cacheCtx, mtp, &pool, nil)
+	case types.Position_SHORT:
+		repayAmount, err = k.ForceCloseShort(cacheCtx, mtp, &pool, nil)
+	default:
+		err = errors.Wrap(types.ErrInvalidPosition, fmt.Sprintf("invalid position type: %s", mtp.Position))
+	}
+	if err != nil {
+		ctx.Logger().Error(errors.Wrap(err, fmt.Sprintf("error executing stop loss: %s", mtp.String())).Error())
+		// drop the mtp from the queue so that it doesn't block the ones behind it, it is indexed again when updated
+		k.RemoveStopLossQueue(ctx, queued)
+		k.EmitQueueDrop(ctx, queued, "stop_loss", err)
+		return false
+	}
+	write()
+
+	k.EmitForceClose(ctx, mtp, repayAmount, "")
+	return true
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/elys-network/elys/app"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/keeper"
+	"github.com/elys-network/elys/x/margin/types"
+	oracletypes "github.com/elys-network/elys/x/oracle/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeginBlockerStopLoss(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)))
+	err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], coins)
+	require.NoError(t, err)
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(10000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	_, err = amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	// ATOM oracle price is 0.0001, stop loss at 0.00005
+	_, err = mk.Open(ctx, types.NewMsgOpen(
+		addr[0].String(),
+		ptypes.BaseCurrency,
+		sdk.NewInt(100),
+		ptypes.ATOM,
+		types.Position_LONG,
+		sdk.NewDec(5),
+		sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+		sdk.MustNewDecFromStr("0.00005"),
+	))
+	require.NoError(t, err)
+	mtps := mk.GetAllMTPs(ctx)
+	require.Len(t, mtps, 1)
+	require.Equal(t, mtps[0].StopLossPrice, sdk.MustNewDecFromStr("0.00005"))
+
+	// only the owner can update the stop loss
+	msgServer := keeper.NewMsgServerImpl(mk)
+	_, err = msgServer.UpdateStopLoss(sdk.WrapSDKContext(ctx), types.NewMsgUpdateStopLoss(sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), mtps[0].Id, sdk.MustNewDecFromStr("0.00008")))
+	require.Error(t, err)
+
+	// the pool price of ATOM falls between open and the stop loss update
+	swapCoin := sdk.NewCoin(ptypes.ATOM, sdk.NewInt(10000))
+	err = app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, sdk.NewCoins(swapCoin))
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], sdk.NewCoins(swapCoin))
+	require.NoError(t, err)
+	ammPool, found := amm.GetPool(ctx, mtps[0].AmmPoolId)
+	require.True(t, found)
+	_, err = amm.SwapExactAmountIn(ctx, addr[0], ammPool, swapCoin, ptypes.BaseCurrency, sdk.ZeroInt(), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	_, err = msgServer.UpdateStopLoss(sdk.WrapSDKContext(ctx), types.NewMsgUpdateStopLoss(addr[0].String(), mtps[0].Id, sdk.MustNewDecFromStr("0.00008")))
+	require.NoError(t, err)
+
+	// the update stores and indexes the current health, not the health at open
+	mtp, err := mk.GetMTP(ctx, addr[0].String(), mtps[0].Id)
+	require.NoError(t, err)
+	ammPool, _ = amm.GetPool(ctx, mtps[0].AmmPoolId)
+	health, err := mk.UpdateMTPHealth(ctx, mtp, ammPool)
+	require.NoError(t, err)
+	require.True(t, health.LT(mtps[0].MtpHealth))
+	require.Equal(t, health, mtp.MtpHealth)
+	require.Equal(t, mk.GetPoolTradingAssetPrice(ctx, mtp.AmmPoolId).Quo(health), mtp.UnitHealthPrice)
+
+	oracle.SetPrice(ctx, oracletypes.Price{
+		Asset:     "ATOM",
+		Price:     sdk.NewDec(90),
+		Source:    "atom",
+		Provider:  sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		Timestamp: uint64(ctx.BlockTime().Unix()),
+	})
+
+	// stop loss not reached
+	mk.BeginBlocker(ctx)
+	require.Len(t, mk.GetAllMTPs(ctx), 1)
+
+	oracle.SetPrice(ctx, oracletypes.Price{
+		Asset:     "ATOM",
+		Price:     sdk.NewDec(80),
+		Source:    "atom",
+		Provider:  sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		Timestamp: uint64(ctx.BlockTime().Unix()),
+	})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	mk.BeginBlocker(ctx)
+	require.Len(t, mk.GetAllMTPs(ctx), 0)
+
+	found = false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventForceClose {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+// BeginBlockerProcessTakeProfit closes the mtp when its take profit is reached
+func BeginBlockerProcessTakeProfit(ctx sdk.Context, k Keeper, mtp *types.MTP, ammPool ammtypes.Pool) bool {
+	if !k.IsTakeProfitReached(ctx, *mtp, ammPool) {
+		return false
+	}
+
+	// settle through the same path as a user close
+	cacheCtx, write := ctx.CacheContext()
+	_, err := k.Close(cacheCtx, &types.MsgClose{Creator: mtp.Address, Id: mtp.Id})
+	if err != nil {
+		ctx.Logger().Error(errors.Wrap(err, fmt.Sprintf("error executing take profit: %s", mtp.String())).Error())
+		// drop the mtp from the queue so that it doesn't block the ones behind it, it is indexed again when updated
+		k.RemoveTakeProfitQueue(ctx, *mtp)
+		k.EmitQueueDrop(ctx, *mtp, "take_profit", err)
+		return false
+	}
+	write()
+
+	price := k.oracleKeeper.GetAssetPriceFromDenom(ctx, k.GetAmmPoolTradingAsset(ammPool))
+	k.EmitTakeProfit(ctx, mtp, price)
+	return true
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/elys-network/elys/app"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+	oracletypes "github.com/elys-network/elys/x/oracle/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeginBlockerTakeProfit(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)))
+	err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], coins)
+	require.NoError(t, err)
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(10000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	_, err = amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	// ATOM oracle price is 0.0001, take profit at 2
+	_, err = mk.Open(ctx, types.NewMsgOpen(
+		addr[0].String(),
+		ptypes.BaseCurrency,
+		sdk.NewInt(100),
+		ptypes.ATOM,
+		types.Position_LONG,
+		sdk.NewDec(5),
+		sdk.NewDec(2),
+		sdk.ZeroDec(),
+	))
+	require.NoError(t, err)
+	require.Len(t, mk.GetAllMTPs(ctx), 1)
+
+	// take profit not reached
+	mk.BeginBlocker(ctx)
+	require.Len(t, mk.GetAllMTPs(ctx), 1)
+
+	oracle.SetPrice(ctx, oracletypes.Price{
+		Asset:     "ATOM",
+		Price:     sdk.NewDec(3000000),
+		Source:    "atom",
+		Provider:  sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		Timestamp: uint64(ctx.BlockTime().Unix()),
+	})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	mk.BeginBlocker(ctx)
+	require.Len(t, mk.GetAllMTPs(ctx), 0)
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTakeProfit {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestIsTakeProfitReachedShort(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	mk, oracle := app.MarginKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	ammPool := ammtypes.Pool{
+		PoolAssets: []ammtypes.PoolAsset{
+			{Token: sdk.NewCoin(ptypes.ATOM, sdk.ZeroInt())},
+			{Token: sdk.NewCoin(ptypes.BaseCurrency, sdk.ZeroInt())},
+		},
+	}
+
+	// default take profit price is not set for shorts
+	mtp := types.NewMTP("creator", ptypes.BaseCurrency, ptypes.ATOM, types.Position_SHORT, sdk.NewDec(5), sdk.MustNewDecFromStr(types.TakeProfitPriceDefault), 1)
+	require.False(t, mk.IsTakeProfitReached(ctx, *mtp, ammPool))
+
+	mtp.TakeProfitPrice = sdk.MustNewDecFromStr("0.00005")
+	require.False(t, mk.IsTakeProfitReached(ctx, *mtp, ammPool))
+
+	mtp.TakeProfitPrice = sdk.MustNewDecFromStr("0.0001")
+	require.True(t, mk.IsTakeProfitReached(ctx, *mtp, ammPool))
+}
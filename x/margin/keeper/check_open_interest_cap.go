@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/margin/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+// CheckOpenInterestCap returns an error when the open interest of a side of the pool is over its cap
+func (k Keeper) CheckOpenInterestCap(ctx sdk.Context, poolId uint64, position types.Position) error {
+	maxRatio := k.GetMaxOpenInterestRatio(ctx, position)
+	if maxRatio.IsNil() || maxRatio.IsZero() {
+		return nil
+	}
+
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrPoolDoesNotExist, "pool %d", poolId)
+	}
+
+	ratio, err := k.GetOpenInterestRatio(ctx, pool, position)
+	if err != nil {
+		return err
+	}
+
+	if ratio.GT(maxRatio) {
+		return sdkerrors.Wrapf(types.ErrOpenInterestCapExceeded, "%s open interest %s over %s of pool %d", position, ratio, maxRatio, poolId)
+	}
+	return nil
+}
+
+// GetOpenInterestRatio returns the custody of a side of the pool as a fraction of the pool balance of the custody asset.
+// Longs hold the trading asset in custody and shorts hold the base currency.
+func (k Keeper) GetOpenInterestRatio(ctx sdk.Context, pool types.Pool, position types.Position) (sdk.Dec, error) {
+	ratio := sdk.ZeroDec()
+	for _, asset := range pool.PoolAssets {
+		if (asset.AssetDenom == ptypes.BaseCurrency) != (position == types.Position_SHORT) {
+			continue
+		}
+		if !asset.Custody.IsPositive() {
+			continue
+		}
+
+		ammPool, err := k.GetAmmPool(ctx, pool.AmmPoolId, "")
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+
+		ammBalance, err := k.GetAmmPoolBalance(ctx, ammPool, asset.AssetDenom)
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+
+		// balance the pool health is computed from
+		balance := asset.AssetBalance.Add(ammBalance)
+		if !balance.IsPositive() {
+			return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrBalanceNotAvailable, "%s balance of pool %d", asset.AssetDenom, pool.AmmPoolId)
+		}
+
+		ratio = sdk.MaxDec(ratio, sdk.NewDecFromInt(asset.Custody).QuoInt(balance))
+	}
+
+	return ratio, nil
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/elys-network/elys/app"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckOpenInterestCap(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(200000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)))
+	err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], coins)
+	require.NoError(t, err)
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	poolId, err := amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	params := mk.GetParams(ctx)
+	params.MaxLongOpenInterestRatio = sdk.NewDecWithPrec(1, 2)
+	params.MaxShortOpenInterestRatio = sdk.ZeroDec()
+	// allow several positions in the pool
+	params.PoolOpenThreshold = sdk.NewDecWithPrec(5, 1)
+	require.NoError(t, mk.SetParams(ctx, &params))
+
+	// failed opens are run on a cache context as their tx would be reverted
+	open := func(ctx sdk.Context, position types.Position, amount int64) error {
+		_, err := mk.Open(ctx, types.NewMsgOpen(
+			addr[0].String(),
+			ptypes.BaseCurrency,
+			sdk.NewInt(amount),
+			ptypes.ATOM,
+			position,
+			sdk.NewDec(5),
+			sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+			sdk.ZeroDec(),
+		))
+		return err
+	}
+
+	// the long is within the cap
+	require.NoError(t, open(ctx, types.Position_LONG, 100))
+
+	cacheCtx, _ := ctx.CacheContext()
+
+	// adding to the long takes it over the cap
+	require.ErrorIs(t, open(cacheCtx, types.Position_LONG, 200), types.ErrOpenInterestCapExceeded)
+
+	// shorts are uncapped
+	require.NoError(t, open(ctx, types.Position_SHORT, 100))
+
+	res, err := mk.Pool(sdk.WrapSDKContext(ctx), &types.QueryGetPoolRequest{Index: poolId})
+	require.NoError(t, err)
+	require.True(t, res.LongOpenInterestRatio.IsPositive())
+	require.True(t, res.LongOpenInterestRatio.LTE(params.MaxLongOpenInterestRatio))
+	require.True(t, res.ShortOpenInterestRatio.IsPositive())
+	require.Equal(t, params.MaxLongOpenInterestRatio, res.MaxLongOpenInterestRatio)
+	require.Equal(t, params.MaxShortOpenInterestRatio, res.MaxShortOpenInterestRatio)
+
+	require.NoError(t, mk.CheckOpenInterestCap(ctx, poolId, types.Position_SHORT))
+	params.MaxShortOpenInterestRatio = res.ShortOpenInterestRatio.QuoInt64(2)
+	require.NoError(t, mk.SetParams(ctx, &params))
+	require.ErrorIs(t, mk.CheckOpenInterestCap(ctx, poolId, types.Position_SHORT), types.ErrOpenInterestCapExceeded)
+}
@@ -16,6 +16,10 @@ func (k Keeper) CheckPoolHealth(ctx sdk.Context, poolId uint64) error {
 		return sdkerrors.Wrap(types.ErrMTPDisabled, "pool is disabled or closed")
 	}
 
+	if k.PoolChecker.IsPoolPaused(ctx, poolId) {
+		return sdkerrors.Wrapf(types.ErrAmmPoolPaused, "pool %d", poolId)
+	}
+
 	if !pool.Health.IsNil() && pool.Health.LTE(k.PoolChecker.GetPoolOpenThreshold(ctx)) {
 		return sdkerrors.Wrap(types.ErrInvalidPosition, "pool health too low to open new positions")
 	}
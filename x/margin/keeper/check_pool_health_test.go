@@ -57,6 +57,32 @@ func TestCheckPoolHealth_PoolDisabledOrClosed(t *testing.T) {
 	assert.True(t, errors.Is(err, types.ErrMTPDisabled))
 }
 
+func TestCheckPoolHealth_AmmPoolPaused(t *testing.T) {
+	// Setup the mock checker
+	mockChecker := new(mocks.PoolChecker)
+
+	// Create an instance of Keeper with the mock checker
+	k := keeper.Keeper{
+		PoolChecker: mockChecker,
+	}
+
+	ctx := sdk.Context{} // mock or setup a context
+
+	poolId := uint64(1)
+	pool := types.Pool{}
+
+	// Mock behavior
+	mockChecker.On("GetPool", ctx, poolId).Return(pool, true)
+	mockChecker.On("IsPoolEnabled", ctx, poolId).Return(true)
+	mockChecker.On("IsPoolClosed", ctx, poolId).Return(false)
+	mockChecker.On("IsPoolPaused", ctx, poolId).Return(true)
+
+	err := k.CheckPoolHealth(ctx, poolId)
+
+	// Expect an error about the amm pool being paused
+	assert.True(t, errors.Is(err, types.ErrAmmPoolPaused))
+}
+
 func TestCheckPoolHealth_PoolHealthTooLow(t *testing.T) {
 	// Setup the mock checker
 	mockChecker := new(mocks.PoolChecker)
@@ -78,6 +104,7 @@ func TestCheckPoolHealth_PoolHealthTooLow(t *testing.T) {
 	mockChecker.On("GetPool", ctx, poolId).Return(pool, true)
 	mockChecker.On("IsPoolEnabled", ctx, poolId).Return(true)
 	mockChecker.On("IsPoolClosed", ctx, poolId).Return(false)
+	mockChecker.On("IsPoolPaused", ctx, poolId).Return(false)
 	mockChecker.On("GetPoolOpenThreshold", ctx).Return(sdk.NewDec(10)) // threshold higher than health
 
 	err := k.CheckPoolHealth(ctx, poolId)
@@ -107,6 +134,7 @@ func TestCheckPoolHealth_PoolIsHealthy(t *testing.T) {
 	mockChecker.On("GetPool", ctx, poolId).Return(pool, true)
 	mockChecker.On("IsPoolEnabled", ctx, poolId).Return(true)
 	mockChecker.On("IsPoolClosed", ctx, poolId).Return(false)
+	mockChecker.On("IsPoolPaused", ctx, poolId).Return(false)
 	mockChecker.On("GetPoolOpenThreshold", ctx).Return(sdk.NewDec(10))
 
 	err := k.CheckPoolHealth(ctx, poolId)
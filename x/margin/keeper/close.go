@@ -14,15 +14,23 @@ func (k Keeper) Close(ctx sdk.Context, msg *types.MsgClose) (*types.MsgCloseResp
 		return nil, err
 	}
 
+	// closing the whole custody is a full close
+	partial := msg.IsPartial() && !msg.Amount.Equal(mtp.Custodies[0].Amount)
+
 	var closedMtp *types.MTP
 	var repayAmount sdk.Int
-	switch mtp.Position {
-	case types.Position_LONG:
+	switch {
+	case partial && (mtp.Position == types.Position_LONG || mtp.Position == types.Position_SHORT):
+		closedMtp, repayAmount, err = k.PartialClose(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+	case mtp.Position == types.Position_LONG:
 		closedMtp, repayAmount, err = k.CloseLong(ctx, msg)
 		if err != nil {
 			return nil, err
 		}
-	case types.Position_SHORT:
+	case mtp.Position == types.Position_SHORT:
 		closedMtp, repayAmount, err = k.CloseShort(ctx, msg)
 		if err != nil {
 			return nil, err
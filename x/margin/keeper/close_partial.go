@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+// PartialClose unwinds msg.Amount of the mtp custody. Liabilities, collaterals and interest
+// are reduced proportionally and the rest of the position stays open.
+func (k Keeper) PartialClose(ctx sdk.Context, msg *types.MsgClose) (*types.MTP, sdk.Int, error) {
+	// Retrieve MTP
+	mtp, err := k.GetMTP(ctx, msg.Creator, msg.Id)
+	if err != nil {
+		return nil, sdk.ZeroInt(), err
+	}
+
+	// Retrieve Pool
+	pool, found := k.GetPool(ctx, mtp.AmmPoolId)
+	if !found {
+		return nil, sdk.ZeroInt(), sdkerrors.Wrap(types.ErrInvalidBorrowingAsset, "invalid pool id")
+	}
+
+	custody := mtp.Custodies[0]
+	if !msg.Amount.LT(custody.Amount) {
+		return nil, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrInvalidCloseSize, "close amount %s must be lower than custody %s", msg.Amount, custody.Amount)
+	}
+
+	// Retrieve AmmPool
+	ammPool, err := k.GetAmmPool(ctx, mtp.AmmPoolId, custody.Denom)
+	if err != nil {
+		return nil, sdk.ZeroInt(), err
+	}
+
+	for _, collateral := range mtp.Collaterals {
+		// Handle Interest if within epoch position
+		if err := k.HandleInterest(ctx, &mtp, &pool, ammPool, collateral.Denom, custody.Denom); err != nil {
+			return nil, sdk.ZeroInt(), err
+		}
+	}
+
+	closing, remaining := splitMTP(mtp, msg.Amount, custody.Amount)
+
+	repayAmount := sdk.ZeroInt()
+	for _, custody := range closing.Custodies {
+		// Take out custody
+		err = k.TakeOutCustody(ctx, closing, &pool, custody.Denom)
+		if err != nil {
+			return nil, sdk.ZeroInt(), err
+		}
+
+		for _, collateral := range closing.Collaterals {
+			// Estimate swap and repay the closing part only
+			repayAmt, err := k.EstimateSwap(ctx, custody, collateral.Denom, ammPool)
+			if err != nil {
+				return nil, sdk.ZeroInt(), err
+			}
+
+			if err := k.Repay(ctx, &closing, &pool, ammPool, repayAmt, false, collateral.Denom, nil); err != nil {
+				return nil, sdk.ZeroInt(), err
+			}
+
+			repayAmount = repayAmount.Add(repayAmt)
+		}
+	}
+
+	remaining.MtpHealth, err = k.UpdateMTPHealth(ctx, remaining, ammPool)
+	if err != nil {
+		return nil, sdk.ZeroInt(), err
+	}
+
+	if err := k.CalcMTPConsolidateCollateral(ctx, &remaining); err != nil {
+		return nil, sdk.ZeroInt(), err
+	}
+	k.CalcMTPConsolidateLiability(ctx, &remaining)
+
+	if err := k.SetMTP(ctx, &remaining); err != nil {
+		return nil, sdk.ZeroInt(), err
+	}
+
+	// Hooks after margin position modified
+	if k.hooks != nil {
+		k.hooks.AfterMarginPositionModified(ctx, ammPool, pool)
+	}
+
+	return &closing, repayAmount, nil
+}
+
+// splitMTP splits the mtp into the part closed at amount/total and the part remaining open
+func splitMTP(mtp types.MTP, amount sdk.Int, total sdk.Int) (types.MTP, types.MTP) {
+	closing, remaining := mtp, mtp
+	ratio := func(value sdk.Int) sdk.Int {
+		return value.Mul(amount).Quo(total)
+	}
+
+	closing.Liabilities = ratio(mtp.Liabilities)
+	remaining.Liabilities = mtp.Liabilities.Sub(closing.Liabilities)
+	closing.TakeProfitLiabilities = ratio(mtp.TakeProfitLiabilities)
+	remaining.TakeProfitLiabilities = mtp.TakeProfitLiabilities.Sub(closing.TakeProfitLiabilities)
+
+	closing.Collaterals, remaining.Collaterals = splitCoins(mtp.Collaterals, ratio)
+	closing.Custodies, remaining.Custodies = splitCoins(mtp.Custodies, ratio)
+	closing.TakeProfitCustodies, remaining.TakeProfitCustodies = splitCoins(mtp.TakeProfitCustodies, ratio)
+	closing.InterestPaidCollaterals, remaining.InterestPaidCollaterals = splitCoins(mtp.InterestPaidCollaterals, ratio)
+	closing.InterestPaidCustodies, remaining.InterestPaidCustodies = splitCoins(mtp.InterestPaidCustodies, ratio)
+	closing.InterestUnpaidCollaterals, remaining.InterestUnpaidCollaterals = splitCoins(mtp.InterestUnpaidCollaterals, ratio)
+
+	return closing, remaining
+}
+
+// splitCoins splits each coin with ratio, keeping the order of the coins
+func splitCoins(coins []sdk.Coin, ratio func(sdk.Int) sdk.Int) ([]sdk.Coin, []sdk.Coin) {
+	closing := make([]sdk.Coin, len(coins))
+	remaining := make([]sdk.Coin, len(coins))
+	for i, coin := range coins {
+		closingAmount := ratio(coin.Amount)
+		closing[i] = sdk.NewCoin(coin.Denom, closingAmount)
+		remaining[i] = sdk.NewCoin(coin.Denom, coin.Amount.Sub(closingAmount))
+	}
+	return closing, remaining
+}
@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/elys-network/elys/app"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartialClose(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)))
+	err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], coins)
+	require.NoError(t, err)
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(10000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	_, err = amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	_, err = mk.Open(ctx, types.NewMsgOpen(
+		addr[0].String(),
+		ptypes.BaseCurrency,
+		sdk.NewInt(100),
+		ptypes.ATOM,
+		types.Position_LONG,
+		sdk.NewDec(5),
+		sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+		sdk.ZeroDec(),
+	))
+	require.NoError(t, err)
+	mtps := mk.GetAllMTPs(ctx)
+	require.Len(t, mtps, 1)
+	opened := mtps[0]
+
+	// more than the whole custody can't be closed
+	_, err = mk.Close(ctx, types.NewMsgClose(addr[0].String(), opened.Id, opened.Custodies[0].Amount.AddRaw(1)))
+	require.ErrorIs(t, err, types.ErrInvalidCloseSize)
+
+	closeAmount := opened.Custodies[0].Amount.QuoRaw(2)
+	_, err = mk.Close(ctx, types.NewMsgClose(addr[0].String(), opened.Id, closeAmount))
+	require.NoError(t, err)
+
+	mtps = mk.GetAllMTPs(ctx)
+	require.Len(t, mtps, 1)
+	mtp := mtps[0]
+	require.Equal(t, opened.Id, mtp.Id)
+	require.Equal(t, opened.Custodies[0].Amount.Sub(closeAmount), mtp.Custodies[0].Amount)
+	closedCollateral := opened.Collaterals[0].Amount.Mul(closeAmount).Quo(opened.Custodies[0].Amount)
+	require.Equal(t, opened.Collaterals[0].Amount.Sub(closedCollateral), mtp.Collaterals[0].Amount)
+	require.True(t, mtp.Liabilities.LT(opened.Liabilities))
+	require.True(t, mtp.Liabilities.IsPositive())
+
+	pool, found := mk.GetPool(ctx, mtp.AmmPoolId)
+	require.True(t, found)
+	for _, asset := range pool.PoolAssets {
+		if asset.AssetDenom == ptypes.ATOM {
+			require.Equal(t, mtp.Custodies[0].Amount, asset.Custody)
+		}
+		if asset.AssetDenom == ptypes.BaseCurrency {
+			require.Equal(t, mtp.Liabilities, asset.Liabilities)
+		}
+	}
+
+	// closing the whole remaining custody closes the position
+	_, err = mk.Close(ctx, types.NewMsgClose(addr[0].String(), mtp.Id, mtp.Custodies[0].Amount))
+	require.NoError(t, err)
+	require.Len(t, mk.GetAllMTPs(ctx), 0)
+}
+
+func TestPartialCloseInterestOncePerBlock(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{}).WithBlockHeight(10)
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)))
+	err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], coins)
+	require.NoError(t, err)
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(10000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	poolId, err := amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	params := mk.GetParams(ctx)
+	params.EpochLength = 100
+	params.IncrementalInterestPaymentEnabled = false
+	require.NoError(t, mk.SetParams(ctx, &params))
+
+	_, err = mk.Open(ctx, types.NewMsgOpen(
+		addr[0].String(),
+		ptypes.BaseCurrency,
+		sdk.NewInt(100),
+		ptypes.ATOM,
+		types.Position_LONG,
+		sdk.NewDec(5),
+		sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+		sdk.ZeroDec(),
+	))
+	require.NoError(t, err)
+	mtps := mk.GetAllMTPs(ctx)
+	require.Len(t, mtps, 1)
+	opened := mtps[0]
+	require.Equal(t, int64(10), opened.LastInterestHeight)
+
+	pool, found := mk.GetPool(ctx, poolId)
+	require.True(t, found)
+	pool.InterestRate = sdk.NewDecWithPrec(5, 1)
+	mk.SetPool(ctx, pool)
+
+	// the first partial close of the epoch charges the interest accrued since the open
+	ctx = ctx.WithBlockHeight(50)
+	_, err = mk.Close(ctx, types.NewMsgClose(addr[0].String(), opened.Id, opened.Custodies[0].Amount.QuoRaw(4)))
+	require.NoError(t, err)
+	first, err := mk.GetMTP(ctx, addr[0].String(), opened.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), first.LastInterestHeight)
+	interest := func(mtp types.MTP) sdk.Int {
+		return mtp.InterestPaidCustodies[0].Amount.Add(mtp.InterestPaidCollaterals[0].Amount).Add(mtp.InterestUnpaidCollaterals[0].Amount)
+	}
+	require.True(t, interest(first).IsPositive())
+
+	// a second partial close in the same epoch and block charges nothing more, the interest is only split
+	closeAmount := first.Custodies[0].Amount.QuoRaw(3)
+	_, err = mk.Close(ctx, types.NewMsgClose(addr[0].String(), first.Id, closeAmount))
+	require.NoError(t, err)
+	second, err := mk.GetMTP(ctx, addr[0].String(), opened.Id)
+	require.NoError(t, err)
+	remaining := func(value sdk.Int) sdk.Int {
+		return value.Sub(value.Mul(closeAmount).Quo(first.Custodies[0].Amount))
+	}
+	require.Equal(t, remaining(first.InterestPaidCustodies[0].Amount), second.InterestPaidCustodies[0].Amount)
+	require.Equal(t, remaining(first.InterestPaidCollaterals[0].Amount), second.InterestPaidCollaterals[0].Amount)
+	require.Equal(t, remaining(first.InterestUnpaidCollaterals[0].Amount), second.InterestUnpaidCollaterals[0].Amount)
+}
@@ -7,6 +7,11 @@ import (
 )
 
 func (k Keeper) EstimateAndRepay(ctx sdk.Context, mtp types.MTP, pool types.Pool, ammPool ammtypes.Pool, collateralAsset string, custodyAsset string) (sdk.Int, error) {
+	return k.estimateAndRepay(ctx, mtp, pool, ammPool, collateralAsset, custodyAsset, nil)
+}
+
+// estimateAndRepay repays the mtp with its custody swapped to collateral, paying the liquidator bonus when liquidator is set
+func (k Keeper) estimateAndRepay(ctx sdk.Context, mtp types.MTP, pool types.Pool, ammPool ammtypes.Pool, collateralAsset string, custodyAsset string, liquidator sdk.AccAddress) (sdk.Int, error) {
 	collateralIndex, custodyIndex := k.GetMTPAssetIndex(&mtp, collateralAsset, custodyAsset)
 	cutodyAmtTokenIn := sdk.NewCoin(mtp.Custodies[custodyIndex].Denom, mtp.Custodies[custodyIndex].Amount)
 	repayAmount, err := k.EstimateSwap(ctx, cutodyAmtTokenIn, mtp.Collaterals[collateralIndex].Denom, ammPool)
@@ -14,7 +19,11 @@ func (k Keeper) EstimateAndRepay(ctx sdk.Context, mtp types.MTP, pool types.Pool
 		return sdk.ZeroInt(), err
 	}
 
-	if err := k.Repay(ctx, &mtp, &pool, ammPool, repayAmount, false, collateralAsset); err != nil {
+	if err := k.Repay(ctx, &mtp, &pool, ammPool, repayAmount, false, collateralAsset, liquidator); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	if err := k.DestroyMTP(ctx, mtp.Address, mtp.Id); err != nil {
 		return sdk.ZeroInt(), err
 	}
 
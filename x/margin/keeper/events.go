@@ -33,3 +33,65 @@ func (k Keeper) EmitForceClose(ctx sdk.Context, mtp *types.MTP, repayAmount sdk.
 		sdk.NewAttribute("closer", closer),
 	))
 }
+
+func (k Keeper) EmitTakeProfit(ctx sdk.Context, mtp *types.MTP, price sdk.Dec) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTakeProfit,
+		sdk.NewAttribute("id", strconv.FormatInt(int64(mtp.Id), 10)),
+		sdk.NewAttribute("position", mtp.Position.String()),
+		sdk.NewAttribute("address", mtp.Address),
+		sdk.NewAttribute("take_profit_price", mtp.TakeProfitPrice.String()),
+		sdk.NewAttribute("price", price.String()),
+	))
+}
+
+func (k Keeper) EmitUpdateCollateral(ctx sdk.Context, eventType string, mtp *types.MTP, collateral sdk.Coin) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType,
+		sdk.NewAttribute("id", strconv.FormatInt(int64(mtp.Id), 10)),
+		sdk.NewAttribute("address", mtp.Address),
+		sdk.NewAttribute("collateral", collateral.String()),
+		sdk.NewAttribute("liabilities", mtp.Liabilities.String()),
+		sdk.NewAttribute("health", mtp.MtpHealth.String()),
+	))
+}
+
+func (k Keeper) EmitBadDebt(ctx sdk.Context, badDebt types.BadDebt) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventBadDebt,
+		sdk.NewAttribute("id", strconv.FormatInt(int64(badDebt.MtpId), 10)),
+		sdk.NewAttribute("address", badDebt.Address),
+		sdk.NewAttribute("amm_pool_id", strconv.FormatInt(int64(badDebt.AmmPoolId), 10)),
+		sdk.NewAttribute("shortfall", badDebt.Shortfall.String()),
+		sdk.NewAttribute("covered", badDebt.Covered.String()),
+		sdk.NewAttribute("socialised", badDebt.Socialised.String()),
+	))
+}
+
+func (k Keeper) EmitOrder(ctx sdk.Context, eventType string, order types.MarginOrder) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType,
+		sdk.NewAttribute("id", strconv.FormatInt(int64(order.Id), 10)),
+		sdk.NewAttribute("address", order.Creator),
+		sdk.NewAttribute("position", order.Position.String()),
+		sdk.NewAttribute("collateral", order.Collateral.String()),
+		sdk.NewAttribute("borrow_asset", order.BorrowAsset),
+		sdk.NewAttribute("trigger_price", order.TriggerPrice.String()),
+	))
+}
+
+func (k Keeper) EmitFundingPayment(ctx sdk.Context, mtp *types.MTP, funding sdk.Dec, payment sdk.Int) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventFundingPayment,
+		sdk.NewAttribute("id", strconv.FormatInt(int64(mtp.Id), 10)),
+		sdk.NewAttribute("address", mtp.Address),
+		sdk.NewAttribute("position", mtp.Position.String()),
+		sdk.NewAttribute("funding", funding.String()),
+		sdk.NewAttribute("payment_amount", payment.String()),
+	))
+}
+
+func (k Keeper) EmitQueueDrop(ctx sdk.Context, mtp types.MTP, queue string, err error) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventQueueDrop,
+		sdk.NewAttribute("id", strconv.FormatInt(int64(mtp.Id), 10)),
+		sdk.NewAttribute("address", mtp.Address),
+		sdk.NewAttribute("position", mtp.Position.String()),
+		sdk.NewAttribute("queue", queue),
+		sdk.NewAttribute("error", err.Error()),
+	))
+}
@@ -6,60 +6,57 @@ import (
 	"github.com/elys-network/elys/x/margin/types"
 )
 
-func (k Keeper) ForceCloseLong(ctx sdk.Context, mtp *types.MTP, pool *types.Pool, takeFundPayment bool) (sdk.Int, error) {
-
+// ForceCloseLong closes a long mtp that is unhealthy or whose stop loss is reached and repays its liabilities,
+// paying the liquidator bonus when liquidator is set.
+// It is not gated on the epoch position since liquidations and stop losses are processed in every block,
+// including the first block of an epoch.
+func (k Keeper) ForceCloseLong(ctx sdk.Context, mtp *types.MTP, pool *types.Pool, liquidator sdk.AccAddress) (sdk.Int, error) {
 	// check MTP health against threshold
 	safetyFactor := k.GetSafetyFactor(ctx)
 
-	epochLength := k.GetEpochLength(ctx)
-	epochPosition := k.GetEpochPosition(ctx, epochLength)
+	repayAmount := sdk.ZeroInt()
+	for _, custody := range mtp.Custodies {
+		custodyAsset := custody.Denom
+		// Retrieve AmmPool
+		ammPool, err := k.GetAmmPool(ctx, mtp.AmmPoolId, custodyAsset)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
 
-	if epochPosition > 0 {
-		repayAmount := sdk.ZeroInt()
-		for _, custody := range mtp.Custodies {
-			custodyAsset := custody.Denom
-			// Retrieve AmmPool
-			ammPool, err := k.GetAmmPool(ctx, mtp.AmmPoolId, custodyAsset)
-			if err != nil {
+		for _, collateral := range mtp.Collaterals {
+			collateralAsset := collateral.Denom
+			// Handle Interest if within epoch position
+			if err := k.HandleInterest(ctx, mtp, pool, ammPool, collateralAsset, custodyAsset); err != nil {
 				return math.ZeroInt(), err
 			}
+		}
 
-			for _, collateral := range mtp.Collaterals {
-				collateralAsset := collateral.Denom
-				// Handle Interest if within epoch position
-				if err := k.HandleInterest(ctx, mtp, pool, ammPool, collateralAsset, custodyAsset); err != nil {
-					return math.ZeroInt(), err
-				}
-			}
+		// a reached stop loss closes the position before it becomes unhealthy
+		if mtp.MtpHealth.GT(safetyFactor) && !k.IsStopLossReached(ctx, *mtp, ammPool) {
+			return math.ZeroInt(), types.ErrMTPHealthy
+		}
 
-			if mtp.MtpHealth.GT(safetyFactor) {
-				return math.ZeroInt(), types.ErrMTPHealthy
-			}
+		err = k.TakeOutCustody(ctx, *mtp, pool, custodyAsset)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
 
-			err = k.TakeOutCustody(ctx, *mtp, pool, custodyAsset)
+		for _, collateral := range mtp.Collaterals {
+			collateralAsset := collateral.Denom
+			// Estimate swap and repay
+			repayAmt, err := k.estimateAndRepay(ctx, *mtp, *pool, ammPool, collateralAsset, custodyAsset, liquidator)
 			if err != nil {
 				return math.ZeroInt(), err
 			}
 
-			for _, collateral := range mtp.Collaterals {
-				collateralAsset := collateral.Denom
-				// Estimate swap and repay
-				repayAmt, err := k.EstimateAndRepay(ctx, *mtp, *pool, ammPool, collateralAsset, custodyAsset)
-				if err != nil {
-					return math.ZeroInt(), err
-				}
-
-				repayAmount = repayAmount.Add(repayAmt)
-			}
-
-			// Hooks after margin position closed
-			if k.hooks != nil {
-				k.hooks.AfterMarginPositionClosed(ctx, ammPool, *pool)
-			}
+			repayAmount = repayAmount.Add(repayAmt)
 		}
 
-		return repayAmount, nil
+		// Hooks after margin position closed
+		if k.hooks != nil {
+			k.hooks.AfterMarginPositionClosed(ctx, ammPool, *pool)
+		}
 	}
 
-	return math.ZeroInt(), nil
+	return repayAmount, nil
 }
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/elys-network/elys/app"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForceCloseAtEpochStart(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)))
+	err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], coins)
+	require.NoError(t, err)
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(10000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	_, err = amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	_, err = mk.Open(ctx, types.NewMsgOpen(
+		addr[0].String(),
+		ptypes.BaseCurrency,
+		sdk.NewInt(100),
+		ptypes.ATOM,
+		types.Position_LONG,
+		sdk.NewDec(5),
+		sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+		sdk.ZeroDec(),
+	))
+	require.NoError(t, err)
+	mtps := mk.GetAllMTPs(ctx)
+	require.Len(t, mtps, 1)
+	mtp := mtps[0]
+
+	params := mk.GetParams(ctx)
+	params.SafetyFactor = mtp.MtpHealth.Add(sdk.OneDec())
+	require.NoError(t, mk.SetParams(ctx, &params))
+
+	// force closes happen on the first block of an epoch as well
+	require.Equal(t, int64(0), mk.GetEpochPosition(ctx, mk.GetEpochLength(ctx)))
+	pool, found := mk.GetPool(ctx, mtp.AmmPoolId)
+	require.True(t, found)
+	repayAmount, err := mk.ForceCloseLong(ctx, &mtp, &pool, nil)
+	require.NoError(t, err)
+	require.True(t, repayAmount.IsPositive())
+	require.Len(t, mk.GetAllMTPs(ctx), 0)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+// FundingRateComputation returns the funding rate of the pool from the imbalance between the liabilities of its longs and shorts.
+// It reaches the max funding rate when all the liabilities are on one side.
+func (k Keeper) FundingRateComputation(ctx sdk.Context, pool types.Pool) sdk.Dec {
+	fundingRateMax := k.GetFundingRateMax(ctx)
+	if fundingRateMax.IsNil() || fundingRateMax.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	longLiabilities, shortLiabilities := pool.LongLiabilities, pool.ShortLiabilities
+	if longLiabilities.IsNil() || shortLiabilities.IsNil() {
+		return sdk.ZeroDec()
+	}
+
+	totalLiabilities := longLiabilities.Add(shortLiabilities)
+	if totalLiabilities.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	return fundingRateMax.MulInt(longLiabilities.Sub(shortLiabilities)).QuoInt(totalLiabilities)
+}
+
+// CalcMTPFundingPayment returns the funding of the mtp in base currency accrued on its side since it was last settled.
+// It is positive when the mtp pays it, and negative when the mtp receives it.
+func (k Keeper) CalcMTPFundingPayment(ctx sdk.Context, mtp *types.MTP, pool types.Pool) sdk.Int {
+	index := pool.GetFundingIndex(mtp.Position)
+	if index.IsNil() || mtp.LastFundingIndex.IsNil() {
+		return sdk.ZeroInt()
+	}
+
+	return index.Sub(mtp.LastFundingIndex).MulInt(mtp.Liabilities).TruncateInt()
+}
+
+// ReceiveFundingPayment moves the funding received by the mtp, in base currency, from the pool balance to its custody
+func (k Keeper) ReceiveFundingPayment(ctx sdk.Context, mtp *types.MTP, pool *types.Pool, ammPool ammtypes.Pool, custodyAsset string, amount sdk.Int) error {
+	_, custodyIndex := k.GetMTPAssetIndex(mtp, "", custodyAsset)
+	if custodyIndex < 0 {
+		return sdkerrors.Wrap(types.ErrInvalidBorrowingAsset, custodyAsset)
+	}
+
+	custodyAmount := amount
+	if custodyAsset != ptypes.BaseCurrency {
+		var err error
+		custodyAmount, err = k.EstimateSwap(ctx, sdk.NewCoin(ptypes.BaseCurrency, amount), custodyAsset, ammPool)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := pool.UpdateBalance(ctx, custodyAsset, custodyAmount, false); err != nil {
+		return err
+	}
+	if err := pool.UpdateCustody(ctx, custodyAsset, custodyAmount, true); err != nil {
+		return err
+	}
+	mtp.Custodies[custodyIndex].Amount = mtp.Custodies[custodyIndex].Amount.Add(custodyAmount)
+
+	if err := k.SetMTP(ctx, mtp); err != nil {
+		return err
+	}
+	k.SetPool(ctx, *pool)
+
+	return nil
+}
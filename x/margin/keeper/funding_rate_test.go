@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/elys-network/elys/app"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFundingRate(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(200000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)))
+	err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], coins)
+	require.NoError(t, err)
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	poolId, err := amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	params := mk.GetParams(ctx)
+	params.EpochLength = 10
+	params.FundingRateMax = sdk.NewDecWithPrec(5, 1)
+	// allow several positions in the pool
+	params.PoolOpenThreshold = sdk.NewDecWithPrec(5, 1)
+	require.NoError(t, mk.SetParams(ctx, &params))
+
+	// the longs are crowded
+	coins = sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(1000)))
+	err = app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[1], coins)
+	require.NoError(t, err)
+	for i, position := range []types.Position{types.Position_LONG, types.Position_SHORT} {
+		_, err = mk.Open(ctx, types.NewMsgOpen(
+			addr[i].String(),
+			ptypes.BaseCurrency,
+			sdk.NewInt(300-int64(i)*200),
+			ptypes.ATOM,
+			position,
+			sdk.NewDec(5),
+			sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+			sdk.ZeroDec(),
+		))
+		require.NoError(t, err)
+	}
+
+	pool, found := mk.GetPool(ctx, poolId)
+	require.True(t, found)
+	rate := mk.FundingRateComputation(ctx, pool)
+	require.True(t, rate.IsPositive())
+	require.True(t, rate.LT(params.FundingRateMax))
+	pool.FundingRate = rate
+
+	long, err := mk.GetMTP(ctx, addr[0].String(), 1)
+	require.NoError(t, err)
+	short, err := mk.GetMTP(ctx, addr[1].String(), 2)
+	require.NoError(t, err)
+	require.Equal(t, types.Position_SHORT, short.Position)
+	// the funding rate is computed from the liabilities of each side tracked on the pool
+	require.Equal(t, long.Liabilities, pool.LongLiabilities)
+	require.Equal(t, short.Liabilities, pool.ShortLiabilities)
+
+	// an epoch accrues the funding rate to the longs and the same total, pro rata, to the shorts
+	pool.AccrueFunding()
+	require.Equal(t, rate, pool.LongFundingIndex)
+	require.Equal(t, rate.MulInt(pool.LongLiabilities).QuoInt(pool.ShortLiabilities).Neg(), pool.ShortFundingIndex)
+
+	longPayment := mk.CalcMTPFundingPayment(ctx, &long, pool)
+	require.Equal(t, rate.MulInt(long.Liabilities).TruncateInt(), longPayment)
+	shortPayment := mk.CalcMTPFundingPayment(ctx, &short, pool)
+	require.True(t, shortPayment.IsNegative())
+	// the shorts receive what the longs pay, up to rounding in favour of the pool
+	require.True(t, longPayment.Add(shortPayment).GTE(sdk.ZeroInt()))
+	require.True(t, longPayment.Add(shortPayment).LTE(sdk.OneInt()))
+
+	// the short funding is credited to its custody when its interest is handled
+	params.IncrementalInterestPaymentEnabled = false
+	require.NoError(t, mk.SetParams(ctx, &params))
+	ammPool, found := amm.GetPool(ctx, poolId)
+	require.True(t, found)
+	custody := short.Custodies[0].Amount
+	poolCustody := pool.PoolAssets[1].Custody
+	ctx = ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	err = mk.HandleInterest(ctx, &short, &pool, ammPool, ptypes.BaseCurrency, ptypes.BaseCurrency)
+	require.NoError(t, err)
+	require.Equal(t, custody.Add(shortPayment.Neg()), short.Custodies[0].Amount)
+	require.Equal(t, poolCustody.Add(shortPayment.Neg()), pool.PoolAssets[1].Custody)
+	require.Equal(t, pool.ShortFundingIndex, short.LastFundingIndex)
+
+	// the funding already paid is not paid again in the same epoch
+	custody = short.Custodies[0].Amount
+	err = mk.HandleInterest(ctx, &short, &pool, ammPool, ptypes.BaseCurrency, ptypes.BaseCurrency)
+	require.NoError(t, err)
+	require.Equal(t, custody, short.Custodies[0].Amount)
+
+	// a new funding rate only applies to the epochs accrued after it is set
+	pool.FundingRate = rate.Neg()
+	require.True(t, mk.CalcMTPFundingPayment(ctx, &short, pool).IsZero())
+	pool.AccrueFunding()
+	require.True(t, mk.CalcMTPFundingPayment(ctx, &short, pool).IsPositive())
+	require.True(t, mk.CalcMTPFundingPayment(ctx, &long, pool).IsPositive())
+
+	found = false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventFundingPayment {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
@@ -9,11 +9,41 @@ import (
 func (k Keeper) HandleInterest(ctx sdk.Context, mtp *types.MTP, pool *types.Pool, ammPool ammtypes.Pool, collateralAsset string, custodyAsset string) error {
 	epochLength := k.GetEpochLength(ctx)
 	epochPosition := k.GetEpochPosition(ctx, epochLength)
-	if epochPosition <= 0 {
+
+	// interest is charged for the blocks of the epoch elapsed since it was last charged,
+	// so handling the mtp again in the same epoch doesn't charge the same blocks twice
+	height := ctx.BlockHeight()
+	interestBlocks := epochPosition
+	if epochStart := height - epochPosition; mtp.LastInterestHeight > epochStart {
+		interestBlocks = height - mtp.LastInterestHeight
+	}
+	mtp.LastInterestHeight = height
+
+	interestPayment := sdk.ZeroInt()
+	if interestBlocks > 0 {
+		interestPayment = k.CalcMTPInterestLiabilities(ctx, mtp, pool.InterestRate, interestBlocks, epochLength, ammPool, collateralAsset)
+	}
+
+	// the funding accrued on the side of the mtp since it was last settled is paid with the interest,
+	// or received in custody when the mtp is on the receiving side
+	fundingIndex := pool.GetFundingIndex(mtp.Position)
+	fundingPayment := k.CalcMTPFundingPayment(ctx, mtp, *pool)
+	if !fundingPayment.IsZero() {
+		k.EmitFundingPayment(ctx, mtp, fundingIndex.Sub(mtp.LastFundingIndex), fundingPayment)
+	}
+	mtp.LastFundingIndex = fundingIndex
+	if fundingPayment.IsPositive() {
+		interestPayment = interestPayment.Add(fundingPayment)
+	} else if fundingPayment.IsNegative() {
+		if err := k.ReceiveFundingPayment(ctx, mtp, pool, ammPool, custodyAsset, fundingPayment.Neg()); err != nil {
+			return err
+		}
+	}
+
+	if !interestPayment.IsPositive() {
 		return nil
 	}
 
-	interestPayment := k.CalcMTPInterestLiabilities(ctx, mtp, pool.InterestRate, epochPosition, epochLength, ammPool, collateralAsset)
 	finalInterestPayment := k.HandleInterestPayment(ctx, collateralAsset, custodyAsset, interestPayment, mtp, pool, ammPool)
 
 	// finalInterestPayment is in custodyAsset
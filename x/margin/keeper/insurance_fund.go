@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"errors"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+// GetInsuranceFundAddress returns the address of the insurance fund module account
+func (k Keeper) GetInsuranceFundAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.InsuranceFundName)
+}
+
+// TakeInsuranceFundPayment sends the share of amount, in asset, from the amm pool account to the insurance fund.
+// The fund keeps the asset in the denom it is paid in, no swap is involved, so callers must leave the taken
+// amount out of the pool balances. The amount taken is returned.
+func (k Keeper) TakeInsuranceFundPayment(ctx sdk.Context, amount sdk.Int, asset string, share sdk.Dec, ammPool ammtypes.Pool) (sdk.Int, error) {
+	takeAmount := share.MulInt(amount).TruncateInt()
+	if takeAmount.IsZero() {
+		return sdk.ZeroInt(), nil
+	}
+
+	ammPoolAddr, err := sdk.AccAddressFromBech32(ammPool.Address)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	takeCoins := sdk.NewCoins(sdk.NewCoin(asset, takeAmount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, ammPoolAddr, types.InsuranceFundName, takeCoins); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	return takeAmount, nil
+}
+
+// CoverBadDebt pays the shortfall of an mtp, in base currency, back to the pool from the insurance fund.
+// The fund base currency is used first, then the fund holdings of the other pool assets are paid in kind
+// at their estimated base currency value. What the fund can't cover is left to the pool according to the
+// bad debt policy.
+func (k Keeper) CoverBadDebt(ctx sdk.Context, mtp *types.MTP, pool *types.Pool, ammPool ammtypes.Pool, shortfall sdk.Int) error {
+	ammPoolAddr, err := sdk.AccAddressFromBech32(ammPool.Address)
+	if err != nil {
+		return err
+	}
+
+	denoms := []string{ptypes.BaseCurrency}
+	for _, asset := range ammPool.PoolAssets {
+		if asset.Token.Denom != ptypes.BaseCurrency {
+			denoms = append(denoms, asset.Token.Denom)
+		}
+	}
+
+	covered := sdk.ZeroInt()
+	for _, denom := range denoms {
+		remaining := shortfall.Sub(covered)
+		if !remaining.IsPositive() {
+			break
+		}
+
+		coverAmount, coverValue, err := k.getInsuranceFundCover(ctx, denom, remaining, ammPool)
+		if err != nil {
+			return err
+		}
+		if coverAmount.IsZero() {
+			continue
+		}
+
+		coverCoins := sdk.NewCoins(sdk.NewCoin(denom, coverAmount))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.InsuranceFundName, ammPoolAddr, coverCoins); err != nil {
+			return err
+		}
+
+		if err := pool.UpdateBalance(ctx, denom, coverAmount, true); err != nil {
+			return err
+		}
+		covered = covered.Add(coverValue)
+	}
+
+	socialised := shortfall.Sub(covered)
+	if socialised.IsPositive() && k.GetBadDebtPolicy(ctx) == types.BadDebtPolicy_SOCIALISE_AND_CLOSE_POOL {
+		pool.Closed = true
+	}
+
+	badDebt := types.BadDebt{
+		Id:          k.GetBadDebtCount(ctx) + 1,
+		Address:     mtp.Address,
+		MtpId:       mtp.Id,
+		AmmPoolId:   mtp.AmmPoolId,
+		Shortfall:   shortfall,
+		Covered:     covered,
+		Socialised:  socialised,
+		BlockHeight: ctx.BlockHeight(),
+	}
+	k.SetBadDebt(ctx, badDebt)
+	k.SetBadDebtCount(ctx, badDebt.Id)
+	k.EmitBadDebt(ctx, badDebt)
+
+	return nil
+}
+
+// getInsuranceFundCover returns the amount of the fund holdings of denom paid to cover up to remaining,
+// in base currency, and the base currency value it covers
+func (k Keeper) getInsuranceFundCover(ctx sdk.Context, denom string, remaining sdk.Int, ammPool ammtypes.Pool) (sdk.Int, sdk.Int, error) {
+	holdings := k.bankKeeper.GetBalance(ctx, k.GetInsuranceFundAddress(), denom).Amount
+	if holdings.IsZero() {
+		return sdk.ZeroInt(), sdk.ZeroInt(), nil
+	}
+
+	if denom == ptypes.BaseCurrency {
+		covered := sdk.MinInt(remaining, holdings)
+		return covered, covered, nil
+	}
+
+	value, err := k.EstimateSwap(ctx, sdk.NewCoin(denom, holdings), ptypes.BaseCurrency, ammPool)
+	if errors.Is(err, types.ErrAmountTooLow) {
+		return sdk.ZeroInt(), sdk.ZeroInt(), nil
+	}
+	if err != nil {
+		return sdk.ZeroInt(), sdk.ZeroInt(), err
+	}
+	if value.LTE(remaining) {
+		return holdings, value, nil
+	}
+
+	amount, err := k.EstimateSwapGivenOut(ctx, sdk.NewCoin(ptypes.BaseCurrency, remaining), denom, ammPool)
+	if err != nil {
+		return sdk.ZeroInt(), sdk.ZeroInt(), err
+	}
+	return sdk.MinInt(amount, holdings), remaining, nil
+}
+
+func (k Keeper) GetBadDebtCount(ctx sdk.Context) uint64 {
+	countBz := ctx.KVStore(k.storeKey).Get(types.BadDebtCountPrefix)
+	if countBz == nil {
+		return 0
+	}
+	return types.GetUint64FromBytes(countBz)
+}
+
+func (k Keeper) SetBadDebtCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BadDebtCountPrefix, types.GetUint64Bytes(count))
+}
+
+func (k Keeper) SetBadDebt(ctx sdk.Context, badDebt types.BadDebt) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBadDebtKey(badDebt.Id), k.cdc.MustMarshal(&badDebt))
+}
+
+func (k Keeper) GetAllBadDebts(ctx sdk.Context) []types.BadDebt {
+	var badDebts []types.BadDebt
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BadDebtPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var badDebt types.BadDebt
+		k.cdc.MustUnmarshal(iterator.Value(), &badDebt)
+		badDebts = append(badDebts, badDebt)
+	}
+	return badDebts
+}
+
+func (k Keeper) GetBadDebts(ctx sdk.Context, pagination *query.PageRequest) ([]types.BadDebt, *query.PageResponse, error) {
+	var badDebts []types.BadDebt
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BadDebtPrefix)
+
+	if pagination == nil {
+		pagination = &query.PageRequest{
+			Limit: math.MaxUint64 - 1,
+		}
+	}
+
+	pageRes, err := query.Paginate(prefixStore, pagination, func(key []byte, value []byte) error {
+		var badDebt types.BadDebt
+		k.cdc.MustUnmarshal(value, &badDebt)
+		badDebts = append(badDebts, badDebt)
+		return nil
+	})
+
+	return badDebts, pageRes, err
+}
@@ -0,0 +1,280 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/elys-network/elys/app"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoverBadDebt(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(200000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)))
+	err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], coins)
+	require.NoError(t, err)
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	poolId, err := amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	_, err = mk.Open(ctx, types.NewMsgOpen(
+		addr[0].String(),
+		ptypes.BaseCurrency,
+		sdk.NewInt(100),
+		ptypes.ATOM,
+		types.Position_LONG,
+		sdk.NewDec(5),
+		sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+		sdk.ZeroDec(),
+	))
+	require.NoError(t, err)
+	mtps := mk.GetAllMTPs(ctx)
+	require.Len(t, mtps, 1)
+	mtp := mtps[0]
+
+	params := mk.GetParams(ctx)
+	params.BadDebtPolicy = types.BadDebtPolicy_SOCIALISE_AND_CLOSE_POOL
+	require.NoError(t, mk.SetParams(ctx, &params))
+
+	// fund the insurance fund with less than the shortfall
+	fund := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(30)))
+	err = app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, fund)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToModule(ctx, ammtypes.ModuleName, types.InsuranceFundName, fund)
+	require.NoError(t, err)
+
+	pool, found := mk.GetPool(ctx, poolId)
+	require.True(t, found)
+	ammPool, found := amm.GetPool(ctx, poolId)
+	require.True(t, found)
+	ammPoolAddr := sdk.MustAccAddressFromBech32(ammPool.Address)
+	ammPoolBalance := app.BankKeeper.GetBalance(ctx, ammPoolAddr, ptypes.BaseCurrency)
+
+	err = mk.CoverBadDebt(ctx, &mtp, &pool, ammPool, sdk.NewInt(50))
+	require.NoError(t, err)
+
+	// the fund is drained into the pool and the rest is socialised
+	require.True(t, app.BankKeeper.GetBalance(ctx, mk.GetInsuranceFundAddress(), ptypes.BaseCurrency).IsZero())
+	require.Equal(t, ammPoolBalance.Amount.Add(sdk.NewInt(30)), app.BankKeeper.GetBalance(ctx, ammPoolAddr, ptypes.BaseCurrency).Amount)
+	require.True(t, pool.Closed)
+
+	res, err := mk.BadDebts(sdk.WrapSDKContext(ctx), &types.BadDebtsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.BadDebts, 1)
+	require.Equal(t, uint64(1), res.BadDebts[0].Id)
+	require.Equal(t, mtp.Id, res.BadDebts[0].MtpId)
+	require.Equal(t, sdk.NewInt(50), res.BadDebts[0].Shortfall)
+	require.Equal(t, sdk.NewInt(30), res.BadDebts[0].Covered)
+	require.Equal(t, sdk.NewInt(20), res.BadDebts[0].Socialised)
+}
+
+func TestInsuranceFundInKind(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(200000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)))
+	err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], coins)
+	require.NoError(t, err)
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	poolId, err := amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	_, err = mk.Open(ctx, types.NewMsgOpen(
+		addr[0].String(),
+		ptypes.BaseCurrency,
+		sdk.NewInt(100),
+		ptypes.ATOM,
+		types.Position_LONG,
+		sdk.NewDec(5),
+		sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+		sdk.ZeroDec(),
+	))
+	require.NoError(t, err)
+	mtps := mk.GetAllMTPs(ctx)
+	require.Len(t, mtps, 1)
+	mtp := mtps[0]
+
+	pool, found := mk.GetPool(ctx, poolId)
+	require.True(t, found)
+	ammPool, found := amm.GetPool(ctx, poolId)
+	require.True(t, found)
+	ammPoolAddr := sdk.MustAccAddressFromBech32(ammPool.Address)
+	fundAddr := mk.GetInsuranceFundAddress()
+
+	// the fund is paid in the asset taken, the pool base currency is left untouched
+	ammPoolBalance := app.BankKeeper.GetBalance(ctx, ammPoolAddr, ptypes.BaseCurrency)
+	taken, err := mk.TakeInsuranceFundPayment(ctx, sdk.NewInt(100), ptypes.ATOM, sdk.NewDecWithPrec(5, 1), ammPool)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(50), taken)
+	require.Equal(t, sdk.NewInt(50), app.BankKeeper.GetBalance(ctx, fundAddr, ptypes.ATOM).Amount)
+	require.True(t, app.BankKeeper.GetBalance(ctx, fundAddr, ptypes.BaseCurrency).IsZero())
+	require.Equal(t, ammPoolBalance, app.BankKeeper.GetBalance(ctx, ammPoolAddr, ptypes.BaseCurrency))
+
+	fund := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(30)))
+	err = app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, fund)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToModule(ctx, ammtypes.ModuleName, types.InsuranceFundName, fund)
+	require.NoError(t, err)
+
+	// base currency is used first and the rest is paid in kind
+	atomBalance := func() sdk.Int {
+		for _, asset := range pool.PoolAssets {
+			if asset.AssetDenom == ptypes.ATOM {
+				return asset.AssetBalance
+			}
+		}
+		return sdk.ZeroInt()
+	}
+	initialAtomBalance := atomBalance()
+	err = mk.CoverBadDebt(ctx, &mtp, &pool, ammPool, sdk.NewInt(40))
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetBalance(ctx, fundAddr, ptypes.BaseCurrency).IsZero())
+
+	atomPaid := sdk.NewInt(50).Sub(app.BankKeeper.GetBalance(ctx, fundAddr, ptypes.ATOM).Amount)
+	require.True(t, atomPaid.IsPositive())
+	require.True(t, atomPaid.LT(sdk.NewInt(50)))
+	require.Equal(t, initialAtomBalance.Add(atomPaid), atomBalance())
+	require.False(t, pool.Closed)
+
+	badDebts := mk.GetAllBadDebts(ctx)
+	require.Len(t, badDebts, 1)
+	require.Equal(t, sdk.NewInt(40), badDebts[0].Covered)
+	require.True(t, badDebts[0].Socialised.IsZero())
+}
+
+func TestRepayInCollateralAsset(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(200000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(200000)))
+	err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], coins)
+	require.NoError(t, err)
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	poolId, err := amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	_, err = mk.Open(ctx, types.NewMsgOpen(
+		addr[0].String(),
+		ptypes.ATOM,
+		sdk.NewInt(100),
+		ptypes.ATOM,
+		types.Position_LONG,
+		sdk.NewDec(5),
+		sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+		sdk.ZeroDec(),
+	))
+	require.NoError(t, err)
+	mtps := mk.GetAllMTPs(ctx)
+	require.Len(t, mtps, 1)
+	mtp := mtps[0]
+
+	pool, found := mk.GetPool(ctx, poolId)
+	require.True(t, found)
+	ammPool, found := amm.GetPool(ctx, poolId)
+	require.True(t, found)
+	ammPoolAddr := sdk.MustAccAddressFromBech32(ammPool.Address)
+	fundAddr := mk.GetInsuranceFundAddress()
+
+	atomBalance := func() sdk.Int {
+		for _, asset := range pool.PoolAssets {
+			if asset.AssetDenom == ptypes.ATOM {
+				return asset.AssetBalance
+			}
+		}
+		return sdk.ZeroInt()
+	}
+
+	// the position is repaid as unhealthy, so the insurance fund takes its liquidation share
+	params := mk.GetParams(ctx)
+	params.SafetyFactor = mtp.MtpHealth.Add(sdk.OneDec())
+	require.NoError(t, mk.SetParams(ctx, &params))
+
+	initialAtomBalance := atomBalance()
+	initialAmmAtom := app.BankKeeper.GetBalance(ctx, ammPoolAddr, ptypes.ATOM).Amount
+	initialAmmBaseCurrency := app.BankKeeper.GetBalance(ctx, ammPoolAddr, ptypes.BaseCurrency).Amount
+	err = mk.Repay(ctx, &mtp, &pool, ammPool, mtp.Liabilities.Add(sdk.NewInt(200)), false, ptypes.ATOM, nil)
+	require.NoError(t, err)
+
+	// every share of the return leaves the pool in the collateral asset, as recorded in the pool balance
+	require.True(t, app.BankKeeper.GetBalance(ctx, fundAddr, ptypes.ATOM).Amount.IsPositive())
+	require.True(t, app.BankKeeper.GetBalance(ctx, fundAddr, ptypes.BaseCurrency).IsZero())
+	require.Equal(t, initialAmmBaseCurrency, app.BankKeeper.GetBalance(ctx, ammPoolAddr, ptypes.BaseCurrency).Amount)
+	ammAtomSent := initialAmmAtom.Sub(app.BankKeeper.GetBalance(ctx, ammPoolAddr, ptypes.ATOM).Amount)
+	require.True(t, ammAtomSent.IsPositive())
+	require.Equal(t, initialAtomBalance.Sub(ammAtomSent), atomBalance())
+}
@@ -101,6 +101,7 @@ func TestCheckBalanceInvariant_InvalidBalance(t *testing.T) {
 		margintypes.Position_LONG,
 		sdk.NewDec(5),
 		sdk.MustNewDecFromStr(margintypes.TakeProfitPriceDefault),
+		sdk.ZeroDec(),
 	)
 
 	_, err = mk.Open(ctx, msg2)
@@ -122,6 +123,7 @@ func TestCheckBalanceInvariant_InvalidBalance(t *testing.T) {
 	msg3 := margintypes.NewMsgClose(
 		addr[0].String(),
 		mtpId,
+		sdk.ZeroInt(),
 	)
 
 	_, err = mk.Close(ctx, msg3)
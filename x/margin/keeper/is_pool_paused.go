@@ -0,0 +1,10 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsPoolPaused returns true when the amm pool is paused and no new positions can be opened on it
+func (k Keeper) IsPoolPaused(ctx sdk.Context, poolId uint64) bool {
+	return k.amm.IsPoolPaused(ctx, poolId)
+}
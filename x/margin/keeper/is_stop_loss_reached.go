@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+// IsStopLossReached returns true when the oracle price of the traded asset
+// crosses the mtp stop loss price, downwards for longs and upwards for shorts
+func (k Keeper) IsStopLossReached(ctx sdk.Context, mtp types.MTP, ammPool ammtypes.Pool) bool {
+	// stop loss price not set
+	if mtp.StopLossPrice.IsNil() || !mtp.StopLossPrice.IsPositive() {
+		return false
+	}
+
+	tradingAsset := k.GetAmmPoolTradingAsset(ammPool)
+	if tradingAsset == "" {
+		return false
+	}
+
+	price := k.oracleKeeper.GetAssetPriceFromDenom(ctx, tradingAsset)
+	if !price.IsPositive() {
+		return false
+	}
+
+	switch mtp.Position {
+	case types.Position_LONG:
+		return price.LTE(mtp.StopLossPrice)
+	case types.Position_SHORT:
+		return price.GTE(mtp.StopLossPrice)
+	default:
+		return false
+	}
+}
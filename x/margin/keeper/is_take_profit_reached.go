@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+// GetAmmPoolTradingAsset returns the non base currency asset of the amm pool
+func (k Keeper) GetAmmPoolTradingAsset(ammPool ammtypes.Pool) string {
+	for _, asset := range ammPool.PoolAssets {
+		if asset.Token.Denom != ptypes.BaseCurrency {
+			return asset.Token.Denom
+		}
+	}
+	return ""
+}
+
+// IsTakeProfitReached returns true when the oracle price of the traded asset
+// reaches the mtp take profit price, upwards for longs and downwards for shorts
+func (k Keeper) IsTakeProfitReached(ctx sdk.Context, mtp types.MTP, ammPool ammtypes.Pool) bool {
+	// take profit price not set
+	if mtp.TakeProfitPrice.IsNil() || !mtp.TakeProfitPrice.IsPositive() ||
+		mtp.TakeProfitPrice.GTE(sdk.MustNewDecFromStr(types.TakeProfitPriceDefault)) {
+		return false
+	}
+
+	tradingAsset := k.GetAmmPoolTradingAsset(ammPool)
+	if tradingAsset == "" {
+		return false
+	}
+
+	price := k.oracleKeeper.GetAssetPriceFromDenom(ctx, tradingAsset)
+	if !price.IsPositive() {
+		return false
+	}
+
+	switch mtp.Position {
+	case types.Position_LONG:
+		return price.GTE(mtp.TakeProfitPrice)
+	case types.Position_SHORT:
+		return price.LTE(mtp.TakeProfitPrice)
+	default:
+		return false
+	}
+}
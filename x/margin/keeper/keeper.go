@@ -212,6 +212,11 @@ func (k Keeper) Borrow(ctx sdk.Context, collateralAsset string, custodyAsset str
 		return err
 	}
 
+	err = pool.UpdatePositionLiabilities(ctx, mtp.Position, mtp.Liabilities, true)
+	if err != nil {
+		return err
+	}
+
 	k.SetPool(ctx, *pool)
 
 	return k.SetMTP(ctx, mtp)
@@ -341,6 +346,16 @@ func (k Keeper) IncrementalInterestPayment(ctx sdk.Context, collateralAsset stri
 		k.EmitFundPayment(ctx, mtp, takeAmount, mtp.Custodies[custodyIndex].Denom, types.EventIncrementalPayFund)
 	}
 
+	insuranceAmount, err := k.TakeInsuranceFundPayment(ctx, actualInterestPaymentCustody, mtp.Custodies[custodyIndex].Denom, k.GetInsuranceFundInterestShare(ctx), ammPool)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	actualInterestPaymentCustody = actualInterestPaymentCustody.Sub(insuranceAmount)
+
+	if !insuranceAmount.IsZero() {
+		k.EmitFundPayment(ctx, mtp, insuranceAmount, mtp.Custodies[custodyIndex].Denom, types.EventInsuranceFundPayment)
+	}
+
 	err = pool.UpdateCustody(ctx, mtp.Custodies[custodyIndex].Denom, interestPaymentCustody, false)
 	if err != nil {
 		return sdk.ZeroInt(), err
@@ -464,9 +479,15 @@ func (k Keeper) CheckMinLiabilities(ctx sdk.Context, collateralAmount sdk.Coin,
 func (k Keeper) DestroyMTP(ctx sdk.Context, mtpAddress string, id uint64) error {
 	key := types.GetMTPKey(mtpAddress, id)
 	store := ctx.KVStore(k.storeKey)
-	if !store.Has(key) {
+	bz := store.Get(key)
+	if bz == nil {
 		return types.ErrMTPDoesNotExist
 	}
+	var mtp types.MTP
+	k.cdc.MustUnmarshal(bz, &mtp)
+	k.RemoveLiquidationQueue(ctx, mtp)
+	k.RemoveTriggerQueues(ctx, mtp)
+
 	store.Delete(key)
 	// decrement open mtp count
 	openCount := k.GetOpenMTPCount(ctx)
@@ -511,6 +532,23 @@ func (k Keeper) SetMTP(ctx sdk.Context, mtp *types.MTP) error {
 		return err
 	}
 	key := types.GetMTPKey(mtp.Address, mtp.Id)
+
+	// the unit health price is extrapolated from the current price, so the health must be current too
+	if err := k.refreshMTPHealth(ctx, mtp); err != nil {
+		return err
+	}
+
+	// re-index the mtp in the liquidation, take profit and stop loss queues
+	if bz := store.Get(key); bz != nil {
+		var prev types.MTP
+		k.cdc.MustUnmarshal(bz, &prev)
+		k.RemoveLiquidationQueue(ctx, prev)
+		k.RemoveTriggerQueues(ctx, prev)
+	}
+	mtp.UnitHealthPrice = k.GetMTPUnitHealthPrice(ctx, *mtp)
+	k.SetLiquidationQueue(ctx, *mtp)
+	k.SetTriggerQueues(ctx, *mtp)
+
 	store.Set(key, k.cdc.MustMarshal(mtp))
 	return nil
 }
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+// Liquidate lets any account close an mtp whose health is at or below the safety factor,
+// rewarding the liquidator with a share of the collateral returned to the owner.
+func (k Keeper) Liquidate(ctx sdk.Context, msg *types.MsgLiquidate) (*types.MsgLiquidateResponse, error) {
+	mtp, err := k.GetMTP(ctx, msg.Address, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, found := k.GetPool(ctx, mtp.AmmPoolId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrPoolDoesNotExist, "pool %d", mtp.AmmPoolId)
+	}
+
+	liquidator, err := sdk.AccAddressFromBech32(msg.Liquidator)
+	if err != nil {
+		return nil, err
+	}
+
+	ammPool, err := k.GetAmmPool(ctx, mtp.AmmPoolId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// a reached stop loss alone doesn't make the position liquidatable
+	safetyFactor := k.GetSafetyFactor(ctx)
+	mtp.MtpHealth, err = k.UpdateMTPHealth(ctx, mtp, ammPool)
+	if err != nil {
+		return nil, err
+	}
+	if mtp.MtpHealth.GT(safetyFactor) {
+		return nil, sdkerrors.Wrapf(types.ErrMTPHealthy, "health %s is above safety factor %s", mtp.MtpHealth, safetyFactor)
+	}
+
+	var repayAmount sdk.Int
+	switch mtp.Position {
+	case types.Position_LONG:
+		repayAmount, err = k.ForceCloseLong(ctx, &mtp, &pool, liquidator)
+	case types.Position_SHORT:
+		repayAmount, err = k.ForceCloseShort(ctx, &mtp, &pool, liquidator)
+	default:
+		err = sdkerrors.Wrap(types.ErrInvalidPosition, fmt.Sprintf("invalid position type: %s", mtp.Position))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	k.EmitForceClose(ctx, &mtp, repayAmount, msg.Liquidator)
+
+	return &types.MsgLiquidateResponse{}, nil
+}
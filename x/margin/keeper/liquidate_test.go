@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/elys-network/elys/app"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/keeper"
+	"github.com/elys-network/elys/x/margin/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLiquidate(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)))
+	err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], coins)
+	require.NoError(t, err)
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(10000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	_, err = amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	_, err = mk.Open(ctx, types.NewMsgOpen(
+		addr[0].String(),
+		ptypes.BaseCurrency,
+		sdk.NewInt(100),
+		ptypes.ATOM,
+		types.Position_LONG,
+		sdk.NewDec(5),
+		sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+		sdk.ZeroDec(),
+	))
+	require.NoError(t, err)
+	mtps := mk.GetAllMTPs(ctx)
+	require.Len(t, mtps, 1)
+	mtp := mtps[0]
+	msgServer := keeper.NewMsgServerImpl(mk)
+	liquidator := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// healthy positions cannot be liquidated
+	_, err = msgServer.Liquidate(sdk.WrapSDKContext(ctx), types.NewMsgLiquidate(liquidator.String(), mtp.Address, mtp.Id))
+	require.ErrorIs(t, err, types.ErrMTPHealthy)
+
+	params := mk.GetParams(ctx)
+	params.SafetyFactor = mtp.MtpHealth.Add(sdk.OneDec())
+	require.NoError(t, mk.SetParams(ctx, &params))
+
+	ownerBalance := app.BankKeeper.GetBalance(ctx, addr[0], ptypes.BaseCurrency)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.Liquidate(sdk.WrapSDKContext(ctx), types.NewMsgLiquidate(liquidator.String(), mtp.Address, mtp.Id))
+	require.NoError(t, err)
+	require.Len(t, mk.GetAllMTPs(ctx), 0)
+
+	// the liquidator bonus is carved out of the amount returned to the owner
+	bonus := app.BankKeeper.GetBalance(ctx, liquidator, ptypes.BaseCurrency)
+	require.True(t, bonus.IsPositive())
+	returned := app.BankKeeper.GetBalance(ctx, addr[0], ptypes.BaseCurrency).Sub(ownerBalance)
+	require.True(t, returned.IsPositive())
+	require.Equal(t, params.LiquidatorBonus.MulInt(bonus.Amount.Add(returned.Amount)).TruncateInt(), bonus.Amount)
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventForceClose {
+			for _, attr := range event.Attributes {
+				if attr.Key == "closer" && attr.Value == liquidator.String() {
+					found = true
+				}
+			}
+		}
+	}
+	require.True(t, found)
+}
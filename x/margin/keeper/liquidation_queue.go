@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+// GetMTPUnitHealthPrice returns the oracle price of the traded asset at which the mtp health reaches one,
+// extrapolated from the current price and health. Health moves with the price for longs and against
+// it for shorts. Zero is returned when it cannot be computed.
+func (k Keeper) GetMTPUnitHealthPrice(ctx sdk.Context, mtp types.MTP) sdk.Dec {
+	if mtp.MtpHealth.IsNil() || !mtp.MtpHealth.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	price := k.GetPoolTradingAssetPrice(ctx, mtp.AmmPoolId)
+	if !price.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	switch mtp.Position {
+	case types.Position_LONG:
+		return price.Quo(mtp.MtpHealth)
+	case types.Position_SHORT:
+		return price.Mul(mtp.MtpHealth)
+	default:
+		return sdk.ZeroDec()
+	}
+}
+
+// refreshMTPHealth recomputes the health of the mtp at the current pool state before it is indexed.
+// Mtps of unknown or disabled pools keep their stored health, they are not processed until the pool is enabled.
+func (k Keeper) refreshMTPHealth(ctx sdk.Context, mtp *types.MTP) error {
+	if !k.IsPoolEnabled(ctx, mtp.AmmPoolId) {
+		return nil
+	}
+
+	ammPool, found := k.amm.GetPool(ctx, mtp.AmmPoolId)
+	if !found {
+		return nil
+	}
+
+	health, err := k.UpdateMTPHealth(ctx, *mtp, ammPool)
+	if err != nil {
+		return err
+	}
+	mtp.MtpHealth = health
+
+	return nil
+}
+
+// GetPoolTradingAssetPrice returns the oracle price of the non base currency asset of the amm pool
+func (k Keeper) GetPoolTradingAssetPrice(ctx sdk.Context, ammPoolId uint64) sdk.Dec {
+	ammPool, found := k.amm.GetPool(ctx, ammPoolId)
+	if !found {
+		return sdk.ZeroDec()
+	}
+
+	tradingAsset := k.GetAmmPoolTradingAsset(ammPool)
+	if tradingAsset == "" {
+		return sdk.ZeroDec()
+	}
+
+	return k.oracleKeeper.GetAssetPriceFromDenom(ctx, tradingAsset)
+}
+
+// SetLiquidationQueue indexes the mtp by its unit health price, mtps without one are not indexed
+func (k Keeper) SetLiquidationQueue(ctx sdk.Context, mtp types.MTP) {
+	k.setMTPPriceQueue(ctx, types.LiquidationQueuePrefix, mtp, mtp.UnitHealthPrice)
+}
+
+// RemoveLiquidationQueue removes the mtp from the liquidation queue
+func (k Keeper) RemoveLiquidationQueue(ctx sdk.Context, mtp types.MTP) {
+	k.removeMTPPriceQueue(ctx, types.LiquidationQueuePrefix, mtp, mtp.UnitHealthPrice)
+}
+
+// GetLiquidationQueueMTPs returns up to limit mtps of the pool whose health is at or below the safety factor
+// at price. Longs reach it when price falls to their unit health price times the safety factor, and shorts
+// when it rises to their unit health price divided by the safety factor, so a change of the safety factor
+// applies to the whole queue without re-indexing it.
+func (k Keeper) GetLiquidationQueueMTPs(ctx sdk.Context, ammPoolId uint64, price sdk.Dec, limit int64) []*types.MTP {
+	var mtps []*types.MTP
+	safetyFactor := k.GetSafetyFactor(ctx)
+	if !price.IsPositive() || safetyFactor.IsNil() || !safetyFactor.IsPositive() {
+		return mtps
+	}
+
+	mtps = k.getPriceQueueMTPs(ctx, types.LiquidationQueuePrefix, ammPoolId, types.Position_LONG, price.Quo(safetyFactor), true, mtps, limit)
+	return k.getPriceQueueMTPs(ctx, types.LiquidationQueuePrefix, ammPoolId, types.Position_SHORT, price.Mul(safetyFactor), false, mtps, limit)
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/elys-network/elys/app"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLiquidationQueue(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(200000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)))
+	for _, owner := range addr {
+		err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+		require.NoError(t, err)
+		err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, owner, coins)
+		require.NoError(t, err)
+	}
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	poolId, err := amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	params := mk.GetParams(ctx)
+	params.PoolOpenThreshold = sdk.MustNewDecFromStr("0.65")
+	require.NoError(t, mk.SetParams(ctx, &params))
+
+	for _, owner := range addr {
+		_, err = mk.Open(ctx, types.NewMsgOpen(
+			owner.String(),
+			ptypes.BaseCurrency,
+			sdk.NewInt(100),
+			ptypes.ATOM,
+			types.Position_LONG,
+			sdk.NewDec(5),
+			sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+			sdk.ZeroDec(),
+		))
+		require.NoError(t, err)
+	}
+	mtps := mk.GetAllMTPs(ctx)
+	require.Len(t, mtps, 2)
+	mtp := mtps[0]
+
+	// healthy longs are indexed below the current price
+	price := mk.GetPoolTradingAssetPrice(ctx, poolId)
+	liquidationPrice := mtp.UnitHealthPrice.Mul(params.SafetyFactor)
+	require.True(t, liquidationPrice.IsPositive())
+	require.True(t, liquidationPrice.LT(price))
+	require.Len(t, mk.GetLiquidationQueueMTPs(ctx, poolId, price, 10), 0)
+	require.Len(t, mk.GetLiquidationQueueMTPs(ctx, poolId, liquidationPrice, 10), 2)
+	require.Len(t, mk.GetLiquidationQueueMTPs(ctx, poolId, liquidationPrice, 1), 1)
+
+	// a stale health is recomputed before the mtp is indexed
+	ammPool, found := amm.GetPool(ctx, poolId)
+	require.True(t, found)
+	health, err := mk.UpdateMTPHealth(ctx, mtp, ammPool)
+	require.NoError(t, err)
+	stale := mtp
+	stale.MtpHealth = health.MulInt64(10)
+	require.NoError(t, mk.SetMTP(ctx, &stale))
+	require.Equal(t, health, stale.MtpHealth)
+	require.Equal(t, price.Quo(health), stale.UnitHealthPrice)
+	require.Len(t, mk.GetLiquidationQueueMTPs(ctx, poolId, liquidationPrice, 10), 2)
+
+	// a position found healthy when it is read is indexed again at its current health instead of being retried
+	_, err = amm.SwapExactAmountIn(ctx, addr[0], ammPool, sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(20000)), ptypes.ATOM, sdk.ZeroInt(), sdk.ZeroDec())
+	require.NoError(t, err)
+	ammPool, found = amm.GetPool(ctx, poolId)
+	require.True(t, found)
+	improved, err := mk.UpdateMTPHealth(ctx, mtp, ammPool)
+	require.NoError(t, err)
+	require.True(t, improved.GT(health))
+	params.SafetyFactor = health.Add(improved).QuoInt64(2)
+	require.NoError(t, mk.SetParams(ctx, &params))
+	require.Len(t, mk.GetLiquidationQueueMTPs(ctx, poolId, price, 10), 2)
+	mk.BeginBlocker(ctx)
+	require.Len(t, mk.GetAllMTPs(ctx), 2)
+	require.Len(t, mk.GetLiquidationQueueMTPs(ctx, poolId, price, 10), 0)
+
+	// raising the safety factor above the mtp health moves the liquidation prices above the current price
+	// without re-indexing the mtps
+	params.SafetyFactor = improved.Add(sdk.OneDec())
+	params.MaxLiquidationsPerBlock = 1
+	require.NoError(t, mk.SetParams(ctx, &params))
+	require.Len(t, mk.GetLiquidationQueueMTPs(ctx, poolId, price, 10), 2)
+
+	// force closes are capped per pool and block
+	mk.BeginBlocker(ctx)
+	require.Len(t, mk.GetAllMTPs(ctx), 1)
+	mk.BeginBlocker(ctx)
+	require.Len(t, mk.GetAllMTPs(ctx), 0)
+	require.Len(t, mk.GetLiquidationQueueMTPs(ctx, poolId, price, 10), 0)
+}
@@ -0,0 +1,298 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/elys-network/elys/x/margin/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+// PlaceMarginOrder runs the open checks that don't depend on the price and escrows the order collateral
+func (k Keeper) PlaceMarginOrder(ctx sdk.Context, msg *types.MsgPlaceMarginOrder) (*types.MsgPlaceMarginOrderResponse, error) {
+	order := types.NewMarginOrder(msg)
+	msgOpen := order.GetMsgOpen()
+
+	switch msg.Position {
+	case types.Position_LONG:
+		if err := k.OpenChecker.CheckLongAssets(ctx, msg.CollateralAsset, msg.BorrowAsset); err != nil {
+			return nil, err
+		}
+	case types.Position_SHORT:
+		if err := k.OpenChecker.CheckShortAssets(ctx, msg.CollateralAsset, msg.BorrowAsset); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, sdkerrors.Wrap(types.ErrInvalidPosition, msg.Position.String())
+	}
+
+	if err := k.OpenChecker.CheckUserAuthorization(ctx, msgOpen); err != nil {
+		return nil, err
+	}
+
+	// a triggered order on the same position consolidates it instead of opening a new one
+	if mtp := k.OpenChecker.CheckSamePosition(ctx, msgOpen); mtp == nil {
+		if err := k.OpenChecker.CheckMaxOpenPositions(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	tradingAsset := k.OpenChecker.GetTradingAsset(msg.CollateralAsset, msg.BorrowAsset)
+	poolId, ammPool, _, err := k.OpenChecker.PreparePools(ctx, tradingAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.OpenChecker.CheckPoolHealth(ctx, poolId); err != nil {
+		return nil, err
+	}
+	order.AmmPoolId = poolId
+
+	if order.Expiry == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidOrderExpiry, "expiry must be set")
+	}
+	if order.IsExpired(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrOrderExpired, "expiry %s", order.Expiry)
+	}
+	maxExpiry := ctx.BlockTime().Add(time.Duration(k.GetMaxOrderDuration(ctx)) * time.Second)
+	if order.Expiry.After(maxExpiry) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidOrderExpiry, "expiry %s is after %s", order.Expiry, maxExpiry)
+	}
+
+	// the collateral is valued in base currency against the minimum
+	collateralValue := order.Collateral.Amount
+	if order.Collateral.Denom != ptypes.BaseCurrency {
+		collateralValue, err = k.EstimateSwap(ctx, order.Collateral, ptypes.BaseCurrency, ammPool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if minCollateral := k.GetMinOrderCollateral(ctx); collateralValue.LT(minCollateral) {
+		return nil, sdkerrors.Wrapf(types.ErrAmountTooLow, "order collateral %s is worth less than %s %s", order.Collateral, minCollateral, ptypes.BaseCurrency)
+	}
+
+	creator := sdk.MustAccAddressFromBech32(msg.Creator)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, sdk.NewCoins(order.Collateral)); err != nil {
+		return nil, err
+	}
+
+	order.Id = k.GetOrderCount(ctx) + 1
+	k.SetOrderCount(ctx, order.Id)
+	k.SetOrder(ctx, order)
+	k.EmitOrder(ctx, types.EventPlaceOrder, order)
+
+	return &types.MsgPlaceMarginOrderResponse{Id: order.Id}, nil
+}
+
+// CancelMarginOrder removes a pending order and refunds its collateral
+func (k Keeper) CancelMarginOrder(ctx sdk.Context, msg *types.MsgCancelMarginOrder) (*types.MsgCancelMarginOrderResponse, error) {
+	order, found := k.GetOrder(ctx, msg.Creator, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrOrderDoesNotExist, "order %d of %s", msg.Id, msg.Creator)
+	}
+
+	if err := k.RefundOrder(ctx, order); err != nil {
+		return nil, err
+	}
+
+	k.DeleteOrder(ctx, order)
+	k.EmitOrder(ctx, types.EventCancelOrder, order)
+
+	return &types.MsgCancelMarginOrderResponse{}, nil
+}
+
+// ProcessMarginOrders refunds expired orders and opens the positions of orders whose trigger price is reached,
+// up to the per block cap. Orders that fail to open are refunded and removed.
+func (k Keeper) ProcessMarginOrders(ctx sdk.Context) {
+	limit := k.GetMaxOrderExecutionsPerBlock(ctx)
+
+	expired := k.GetExpiredOrders(ctx, limit)
+	limit -= int64(len(expired))
+	for _, order := range expired {
+		k.closeOrder(ctx, order, types.EventExpireOrder)
+	}
+
+	for _, poolId := range k.GetEnabledPools(ctx) {
+		if limit <= 0 {
+			break
+		}
+		price := k.GetPoolTradingAssetPrice(ctx, poolId)
+		orders := k.GetTriggeredOrders(ctx, poolId, price, limit)
+		limit -= int64(len(orders))
+		for _, order := range orders {
+			if order.IsExpired(ctx.BlockTime()) {
+				k.closeOrder(ctx, order, types.EventExpireOrder)
+				continue
+			}
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.ExecuteMarginOrder(cacheCtx, order); err != nil {
+				ctx.Logger().Error(sdkerrors.Wrap(err, fmt.Sprintf("error executing margin order: %d", order.Id)).Error())
+				k.closeOrder(ctx, order, types.EventFailOrder)
+				continue
+			}
+			write()
+		}
+	}
+}
+
+// closeOrder refunds and removes an order that won't be executed
+func (k Keeper) closeOrder(ctx sdk.Context, order types.MarginOrder, eventType string) {
+	if err := k.RefundOrder(ctx, order); err != nil {
+		ctx.Logger().Error(sdkerrors.Wrap(err, fmt.Sprintf("error refunding margin order: %d", order.Id)).Error())
+		return
+	}
+	k.DeleteOrder(ctx, order)
+	k.EmitOrder(ctx, eventType, order)
+}
+
+// ExecuteMarginOrder returns the escrowed collateral to the creator and opens the order position
+func (k Keeper) ExecuteMarginOrder(ctx sdk.Context, order types.MarginOrder) error {
+	if err := k.RefundOrder(ctx, order); err != nil {
+		return err
+	}
+
+	if _, err := k.Open(ctx, order.GetMsgOpen()); err != nil {
+		return err
+	}
+
+	k.DeleteOrder(ctx, order)
+	k.EmitOrder(ctx, types.EventExecuteOrder, order)
+
+	return nil
+}
+
+// RefundOrder sends the escrowed collateral of the order back to its creator
+func (k Keeper) RefundOrder(ctx sdk.Context, order types.MarginOrder) error {
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, sdk.NewCoins(order.Collateral))
+}
+
+func (k Keeper) GetOrderCount(ctx sdk.Context) uint64 {
+	countBz := ctx.KVStore(k.storeKey).Get(types.OrderCountPrefix)
+	if countBz == nil {
+		return 0
+	}
+	return types.GetUint64FromBytes(countBz)
+}
+
+func (k Keeper) SetOrderCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OrderCountPrefix, types.GetUint64Bytes(count))
+}
+
+// SetOrder stores the order and indexes it by trigger price and expiry
+func (k Keeper) SetOrder(ctx sdk.Context, order types.MarginOrder) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetOrderKey(order.Creator, order.Id)
+	store.Set(key, k.cdc.MustMarshal(&order))
+
+	k.setPriceQueue(ctx, types.OrderPriceQueuePrefix, order.AmmPoolId, order.Position, order.TriggerPrice, key)
+	if order.Expiry != nil {
+		store.Set(types.GetOrderExpiryQueueKey(*order.Expiry, order.Creator, order.Id), key)
+	}
+}
+
+func (k Keeper) GetOrder(ctx sdk.Context, address string, id uint64) (types.MarginOrder, bool) {
+	var order types.MarginOrder
+	bz := ctx.KVStore(k.storeKey).Get(types.GetOrderKey(address, id))
+	if bz == nil {
+		return order, false
+	}
+	k.cdc.MustUnmarshal(bz, &order)
+	return order, true
+}
+
+// DeleteOrder removes the order and its indexes
+func (k Keeper) DeleteOrder(ctx sdk.Context, order types.MarginOrder) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetOrderKey(order.Creator, order.Id)
+	store.Delete(key)
+
+	k.removePriceQueue(ctx, types.OrderPriceQueuePrefix, order.AmmPoolId, order.Position, order.TriggerPrice, key)
+	if order.Expiry != nil {
+		store.Delete(types.GetOrderExpiryQueueKey(*order.Expiry, order.Creator, order.Id))
+	}
+}
+
+// GetTriggeredOrders returns up to limit orders of the pool whose trigger price is reached at price,
+// longs with a trigger price at or above it and shorts at or below it
+func (k Keeper) GetTriggeredOrders(ctx sdk.Context, ammPoolId uint64, price sdk.Dec, limit int64) []types.MarginOrder {
+	keys := k.getPriceQueueKeys(ctx, types.OrderPriceQueuePrefix, ammPoolId, types.Position_LONG, price, true, limit)
+	keys = append(keys, k.getPriceQueueKeys(ctx, types.OrderPriceQueuePrefix, ammPoolId, types.Position_SHORT, price, false, limit-int64(len(keys)))...)
+	return k.getOrders(ctx, keys)
+}
+
+// GetExpiredOrders returns up to limit orders whose expiry is before the block time, the oldest first
+func (k Keeper) GetExpiredOrders(ctx sdk.Context, limit int64) []types.MarginOrder {
+	var keys [][]byte
+	if limit <= 0 {
+		return nil
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderExpiryQueuePrefix)
+	iterator := store.Iterator(nil, sdk.FormatTimeBytes(ctx.BlockTime()))
+	defer iterator.Close()
+
+	for ; iterator.Valid() && int64(len(keys)) < limit; iterator.Next() {
+		keys = append(keys, iterator.Value())
+	}
+	return k.getOrders(ctx, keys)
+}
+
+func (k Keeper) getOrders(ctx sdk.Context, keys [][]byte) []types.MarginOrder {
+	var orders []types.MarginOrder
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+
+		var order types.MarginOrder
+		k.cdc.MustUnmarshal(bz, &order)
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+func (k Keeper) GetAllOrders(ctx sdk.Context) []types.MarginOrder {
+	var orders []types.MarginOrder
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.OrderPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.MarginOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+func (k Keeper) GetMarginOrdersForAddress(ctx sdk.Context, address string, pagination *query.PageRequest) ([]types.MarginOrder, *query.PageResponse, error) {
+	var orders []types.MarginOrder
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetOrderPrefixForAddress(address))
+
+	if pagination == nil {
+		pagination = &query.PageRequest{
+			Limit: math.MaxUint64 - 1,
+		}
+	}
+
+	pageRes, err := query.Paginate(prefixStore, pagination, func(key []byte, value []byte) error {
+		var order types.MarginOrder
+		k.cdc.MustUnmarshal(value, &order)
+		orders = append(orders, order)
+		return nil
+	})
+
+	return orders, pageRes, err
+}
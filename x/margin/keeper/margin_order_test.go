@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/elys-network/elys/app"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+	oracletypes "github.com/elys-network/elys/x/oracle/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarginOrder(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)))
+	err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], coins)
+	require.NoError(t, err)
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(10000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	_, err = amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	params := mk.GetParams(ctx)
+	params.MinOrderCollateral = sdk.NewInt(100)
+	params.MaxOrderDuration = 86400
+	require.NoError(t, mk.SetParams(ctx, &params))
+
+	msgPlaceOrder := func(collateral sdk.Int, triggerPrice sdk.Dec, expiry time.Time) *types.MsgPlaceMarginOrder {
+		return types.NewMsgPlaceMarginOrder(
+			addr[0].String(),
+			ptypes.BaseCurrency,
+			collateral,
+			ptypes.ATOM,
+			types.Position_LONG,
+			sdk.NewDec(5),
+			sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+			sdk.ZeroDec(),
+			triggerPrice,
+			&expiry,
+		)
+	}
+	placeOrder := func(triggerPrice sdk.Dec, expiry time.Time) uint64 {
+		res, err := mk.PlaceMarginOrder(ctx, msgPlaceOrder(sdk.NewInt(100), triggerPrice, expiry))
+		require.NoError(t, err)
+		return res.Id
+	}
+	balance := func() sdk.Int {
+		return app.BankKeeper.GetBalance(ctx, addr[0], ptypes.BaseCurrency).Amount
+	}
+
+	// orders need a minimum collateral and an expiry within the max order duration
+	expiry := ctx.BlockTime().Add(time.Hour)
+	_, err = mk.PlaceMarginOrder(ctx, msgPlaceOrder(sdk.NewInt(99), sdk.MustNewDecFromStr("0.00005"), expiry))
+	require.ErrorIs(t, err, types.ErrAmountTooLow)
+	_, err = mk.PlaceMarginOrder(ctx, msgPlaceOrder(sdk.NewInt(100), sdk.MustNewDecFromStr("0.00005"), ctx.BlockTime().Add(48*time.Hour)))
+	require.ErrorIs(t, err, types.ErrInvalidOrderExpiry)
+
+	// ATOM oracle price is 0.0001, the long is opened once it falls to 0.00005
+	initialBalance := balance()
+	orderId := placeOrder(sdk.MustNewDecFromStr("0.00005"), expiry)
+	require.Equal(t, initialBalance.Sub(sdk.NewInt(100)), balance())
+
+	// cancelling refunds the collateral
+	_, err = mk.CancelMarginOrder(ctx, types.NewMsgCancelMarginOrder(addr[0].String(), orderId))
+	require.NoError(t, err)
+	require.Equal(t, initialBalance, balance())
+	_, err = mk.CancelMarginOrder(ctx, types.NewMsgCancelMarginOrder(addr[0].String(), orderId))
+	require.ErrorIs(t, err, types.ErrOrderDoesNotExist)
+
+	// expired orders are refunded
+	placeOrder(sdk.MustNewDecFromStr("0.00005"), expiry)
+	mk.ProcessMarginOrders(ctx.WithBlockTime(expiry.Add(time.Second)))
+	require.Len(t, mk.GetAllOrders(ctx), 0)
+	require.Equal(t, initialBalance, balance())
+
+	orderId = placeOrder(sdk.MustNewDecFromStr("0.00005"), expiry)
+
+	// trigger price not reached
+	mk.ProcessMarginOrders(ctx)
+	require.Len(t, mk.GetAllMTPs(ctx), 0)
+	res, err := mk.GetOrdersForAddress(sdk.WrapSDKContext(ctx), &types.OrdersForAddressRequest{Address: addr[0].String()})
+	require.NoError(t, err)
+	require.Len(t, res.Orders, 1)
+	require.Equal(t, orderId, res.Orders[0].Id)
+
+	oracle.SetPrice(ctx, oracletypes.Price{
+		Asset:     "ATOM",
+		Price:     sdk.NewDec(40),
+		Source:    "atom",
+		Provider:  sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		Timestamp: uint64(ctx.BlockTime().Unix()),
+	})
+
+	// a second order on the same position
+	placeOrder(sdk.MustNewDecFromStr("0.00005"), expiry)
+	require.Equal(t, initialBalance.Sub(sdk.NewInt(200)), balance())
+
+	// executions are capped per block
+	params.MaxOrderExecutionsPerBlock = 1
+	require.NoError(t, mk.SetParams(ctx, &params))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	mk.ProcessMarginOrders(ctx)
+	require.Len(t, mk.GetAllOrders(ctx), 1)
+
+	mtps := mk.GetAllMTPs(ctx)
+	require.Len(t, mtps, 1)
+	require.Equal(t, types.Position_LONG, mtps[0].Position)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100))), sdk.NewCoins(mtps[0].Collaterals...))
+	require.Equal(t, initialBalance.Sub(sdk.NewInt(200)), balance())
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventExecuteOrder {
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	// an order that fails to open is refunded and removed instead of being retried
+	params.WhitelistingEnabled = true
+	require.NoError(t, mk.SetParams(ctx, &params))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	mk.ProcessMarginOrders(ctx)
+	require.Len(t, mk.GetAllOrders(ctx), 0)
+	require.Equal(t, initialBalance.Sub(sdk.NewInt(100)), balance())
+
+	found = false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventFailOrder {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
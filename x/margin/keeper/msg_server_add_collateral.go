@@ -0,0 +1,14 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+func (k msgServer) AddCollateral(goCtx context.Context, msg *types.MsgAddCollateral) (*types.MsgAddCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return k.Keeper.AddCollateral(ctx, msg)
+}
@@ -0,0 +1,14 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+func (k msgServer) CancelMarginOrder(goCtx context.Context, msg *types.MsgCancelMarginOrder) (*types.MsgCancelMarginOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return k.Keeper.CancelMarginOrder(ctx, msg)
+}
@@ -0,0 +1,14 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+func (k msgServer) PlaceMarginOrder(goCtx context.Context, msg *types.MsgPlaceMarginOrder) (*types.MsgPlaceMarginOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return k.Keeper.PlaceMarginOrder(ctx, msg)
+}
@@ -63,6 +63,10 @@ func (k Keeper) Open(ctx sdk.Context, msg *types.MsgOpen) (*types.MsgOpenRespons
 		return nil, sdkerrors.Wrap(types.ErrInvalidPosition, msg.Position.String())
 	}
 
+	if err := k.OpenChecker.CheckOpenInterestCap(ctx, poolId, msg.Position); err != nil {
+		return nil, err
+	}
+
 	k.OpenChecker.EmitOpenEvent(ctx, mtp)
 
 	if k.hooks != nil {
@@ -20,6 +20,10 @@ func (k Keeper) OpenConsolidate(ctx sdk.Context, mtp *types.MTP, msg *types.MsgO
 		return nil, sdkerrors.Wrap(types.ErrMTPDisabled, tradingAsset)
 	}
 
+	if k.OpenLongChecker.IsPoolPaused(ctx, poolId) {
+		return nil, sdkerrors.Wrapf(types.ErrAmmPoolPaused, "pool %d", poolId)
+	}
+
 	ammPool, err := k.OpenLongChecker.GetAmmPool(ctx, poolId, tradingAsset)
 	if err != nil {
 		return nil, err
@@ -40,6 +44,10 @@ func (k Keeper) OpenConsolidate(ctx sdk.Context, mtp *types.MTP, msg *types.MsgO
 		return nil, sdkerrors.Wrap(types.ErrInvalidPosition, msg.Position.String())
 	}
 
+	if err := k.CheckOpenInterestCap(ctx, poolId, msg.Position); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvent(k.GenerateOpenEvent(mtp))
 
 	if k.hooks != nil {
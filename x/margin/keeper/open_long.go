@@ -18,7 +18,9 @@ func (k Keeper) OpenLong(ctx sdk.Context, poolId uint64, msg *types.MsgOpen) (*t
 
 	// Initialize a new Margin Trading Position (MTP).
 	mtp := types.NewMTP(msg.Creator, msg.CollateralAsset, msg.BorrowAsset, msg.Position, leverage, msg.TakeProfitPrice, poolId)
-
+	if !msg.StopLossPrice.IsNil() {
+		mtp.StopLossPrice = msg.StopLossPrice
+	}
 	// Call the function to process the open long logic.
 	return k.ProcessOpenLong(ctx, mtp, leverage, eta, collateralAmountDec, poolId, msg)
 }
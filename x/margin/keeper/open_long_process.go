@@ -17,6 +17,11 @@ func (k Keeper) ProcessOpenLong(ctx sdk.Context, mtp *types.MTP, leverage sdk.De
 		return nil, sdkerrors.Wrap(types.ErrPoolDoesNotExist, tradingAsset)
 	}
 
+	// Funding accrues from the current funding index of the position.
+	mtp.LastFundingIndex = pool.GetFundingIndex(mtp.Position)
+	// Interest accrues from the opening block.
+	mtp.LastInterestHeight = ctx.BlockHeight()
+
 	// Check if the pool is enabled.
 	if !k.OpenLongChecker.IsPoolEnabled(ctx, poolId) {
 		return nil, sdkerrors.Wrap(types.ErrMTPDisabled, tradingAsset)
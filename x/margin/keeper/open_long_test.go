@@ -265,6 +265,7 @@ func TestOpenLong_ErrorsDuringOperations(t *testing.T) {
 	mtp := types.NewMTP(msg.Creator, msg.CollateralAsset, msg.BorrowAsset, msg.Position, msg.Leverage, sdk.MustNewDecFromStr(types.TakeProfitPriceDefault), poolId)
 
 	borrowError := errors.New("borrow error")
+	mtp.LastFundingIndex = types.Pool{}.GetFundingIndex(msg.Position)
 	mockChecker.On("Borrow", ctx, msg.CollateralAsset, msg.BorrowAsset, msg.CollateralAmount, custodyAmount, mtp, &ammtypes.Pool{}, &types.Pool{}, eta).Return(borrowError)
 
 	_, err := k.OpenLong(ctx, poolId, msg)
@@ -322,6 +323,7 @@ func TestOpenLong_LeverageRatioLessThanSafetyFactor(t *testing.T) {
 
 	mtp := types.NewMTP(msg.Creator, msg.CollateralAsset, msg.BorrowAsset, msg.Position, msg.Leverage, sdk.MustNewDecFromStr(types.TakeProfitPriceDefault), poolId)
 
+	mtp.LastFundingIndex = types.Pool{}.GetFundingIndex(msg.Position)
 	mockChecker.On("Borrow", ctx, msg.CollateralAsset, msg.BorrowAsset, msg.CollateralAmount, custodyAmount, mtp, &ammtypes.Pool{}, &types.Pool{}, eta).Return(nil)
 	mockChecker.On("UpdatePoolHealth", ctx, &types.Pool{}).Return(nil)
 	mockChecker.On("TakeInCustody", ctx, *mtp, &types.Pool{}).Return(nil)
@@ -386,6 +388,7 @@ func TestOpenLong_Success(t *testing.T) {
 
 	mtp := types.NewMTP(msg.Creator, msg.CollateralAsset, msg.BorrowAsset, msg.Position, msg.Leverage, sdk.MustNewDecFromStr(types.TakeProfitPriceDefault), poolId)
 
+	mtp.LastFundingIndex = types.Pool{}.GetFundingIndex(msg.Position)
 	mockChecker.On("Borrow", ctx, msg.CollateralAsset, msg.BorrowAsset, msg.CollateralAmount, custodyAmount, mtp, &ammtypes.Pool{}, &types.Pool{}, eta).Return(nil)
 	mockChecker.On("UpdatePoolHealth", ctx, &types.Pool{}).Return(nil)
 	mockChecker.On("TakeInCustody", ctx, *mtp, &types.Pool{}).Return(nil)
@@ -494,6 +497,7 @@ func TestOpenLong_BaseCurrency_Collateral(t *testing.T) {
 		types.Position_LONG,
 		sdk.NewDec(5),
 		sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+		sdk.ZeroDec(),
 	)
 
 	_, err = mk.Open(ctx, msg2)
@@ -599,6 +603,7 @@ func TestOpenLong_ATOM_Collateral(t *testing.T) {
 		types.Position_LONG,
 		sdk.NewDec(5),
 		sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+		sdk.ZeroDec(),
 	)
 
 	_, err = mk.Open(ctx, msg2)
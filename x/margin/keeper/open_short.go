@@ -18,7 +18,9 @@ func (k Keeper) OpenShort(ctx sdk.Context, poolId uint64, msg *types.MsgOpen) (*
 
 	// Initialize a new Margin Trading Position (MTP).
 	mtp := types.NewMTP(msg.Creator, msg.CollateralAsset, msg.BorrowAsset, msg.Position, leverage, msg.TakeProfitPrice, poolId)
-
+	if !msg.StopLossPrice.IsNil() {
+		mtp.StopLossPrice = msg.StopLossPrice
+	}
 	// Call the function to process the open short logic.
 	return k.ProcessOpenShort(ctx, mtp, leverage, eta, collateralAmountDec, poolId, msg)
 }
@@ -284,6 +284,7 @@ func TestOpenShort_ErrorsDuringOperations(t *testing.T) {
 	mtp := types.NewMTP(msg.Creator, msg.CollateralAsset, ptypes.BaseCurrency, msg.Position, msg.Leverage, sdk.MustNewDecFromStr(types.TakeProfitPriceDefault), poolId)
 
 	borrowError := errors.New("borrow error")
+	mtp.LastFundingIndex = types.Pool{}.GetFundingIndex(msg.Position)
 	mockChecker.On("Borrow", ctx, msg.CollateralAsset, ptypes.BaseCurrency, msg.CollateralAmount, custodyAmount, mtp, &ammtypes.Pool{}, &types.Pool{}, eta).Return(borrowError)
 
 	_, err := k.OpenShort(ctx, poolId, msg)
@@ -344,6 +345,7 @@ func TestOpenShort_LeverageRatioLessThanSafetyFactor(t *testing.T) {
 
 	mtp := types.NewMTP(msg.Creator, msg.CollateralAsset, ptypes.BaseCurrency, msg.Position, msg.Leverage, sdk.MustNewDecFromStr(types.TakeProfitPriceDefault), poolId)
 
+	mtp.LastFundingIndex = types.Pool{}.GetFundingIndex(msg.Position)
 	mockChecker.On("Borrow", ctx, msg.CollateralAsset, ptypes.BaseCurrency, msg.CollateralAmount, custodyAmount, mtp, &ammtypes.Pool{}, &types.Pool{}, eta).Return(nil)
 	mockChecker.On("UpdatePoolHealth", ctx, &types.Pool{}).Return(nil)
 	mockChecker.On("TakeInCustody", ctx, *mtp, &types.Pool{}).Return(nil)
@@ -411,6 +413,7 @@ func TestOpenShort_Success(t *testing.T) {
 
 	mtp := types.NewMTP(msg.Creator, msg.CollateralAsset, ptypes.BaseCurrency, msg.Position, msg.Leverage, sdk.MustNewDecFromStr(types.TakeProfitPriceDefault), poolId)
 
+	mtp.LastFundingIndex = types.Pool{}.GetFundingIndex(msg.Position)
 	mockChecker.On("Borrow", ctx, msg.CollateralAsset, ptypes.BaseCurrency, msg.CollateralAmount, custodyAmount, mtp, &ammtypes.Pool{}, &types.Pool{}, eta).Return(nil)
 	mockChecker.On("UpdatePoolHealth", ctx, &types.Pool{}).Return(nil)
 	mockChecker.On("TakeInCustody", ctx, *mtp, &types.Pool{}).Return(nil)
@@ -519,6 +522,7 @@ func TestOpenShort_BaseCurrency_Collateral(t *testing.T) {
 		types.Position_SHORT,
 		sdk.NewDec(5),
 		sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+		sdk.ZeroDec(),
 	)
 
 	_, err = mk.Open(ctx, msg2)
@@ -624,6 +628,7 @@ func TestOpenShort_ATOM_Collateral(t *testing.T) {
 		types.Position_SHORT,
 		sdk.NewDec(5),
 		sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+		sdk.ZeroDec(),
 	)
 
 	_, err = mk.Open(ctx, msg2)
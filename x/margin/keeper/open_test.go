@@ -290,6 +290,7 @@ func TestOpen_Successful(t *testing.T) {
 	mockChecker.On("PreparePools", ctx, msg.BorrowAsset).Return(poolId, ammtypes.Pool{}, types.Pool{}, nil)
 	mockChecker.On("CheckPoolHealth", ctx, poolId).Return(nil)
 	mockChecker.On("OpenShort", ctx, poolId, msg).Return(mtp, nil)
+	mockChecker.On("CheckOpenInterestCap", ctx, poolId, msg.Position).Return(nil)
 	mockChecker.On("EmitOpenEvent", ctx, mtp).Return()
 
 	_, err := k.Open(ctx, msg)
@@ -90,6 +90,46 @@ func (k Keeper) GetSafetyFactor(ctx sdk.Context) sdk.Dec {
 	return k.GetParams(ctx).SafetyFactor
 }
 
+func (k Keeper) GetCollateralRemovalHealthMargin(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).CollateralRemovalHealthMargin
+}
+
+func (k Keeper) GetLiquidatorBonus(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).LiquidatorBonus
+}
+
+func (k Keeper) GetMaxLiquidationsPerBlock(ctx sdk.Context) int64 {
+	return k.GetParams(ctx).MaxLiquidationsPerBlock
+}
+
+func (k Keeper) GetMaxTriggersPerBlock(ctx sdk.Context) int64 {
+	return k.GetParams(ctx).MaxTriggersPerBlock
+}
+
+func (k Keeper) GetMaxOrderExecutionsPerBlock(ctx sdk.Context) int64 {
+	return k.GetParams(ctx).MaxOrderExecutionsPerBlock
+}
+
+func (k Keeper) GetMinOrderCollateral(ctx sdk.Context) sdk.Int {
+	return k.GetParams(ctx).MinOrderCollateral
+}
+
+func (k Keeper) GetMaxOrderDuration(ctx sdk.Context) int64 {
+	return k.GetParams(ctx).MaxOrderDuration
+}
+
+func (k Keeper) GetInsuranceFundInterestShare(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).InsuranceFundInterestShare
+}
+
+func (k Keeper) GetInsuranceFundLiquidationShare(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).InsuranceFundLiquidationShare
+}
+
+func (k Keeper) GetBadDebtPolicy(ctx sdk.Context) types.BadDebtPolicy {
+	return k.GetParams(ctx).BadDebtPolicy
+}
+
 func (k Keeper) GetEnabledPools(ctx sdk.Context) []uint64 {
 	poolIds := make([]uint64, 0)
 	pools := k.GetAllPools(ctx)
@@ -142,3 +182,19 @@ func (k Keeper) GetSqModifier(ctx sdk.Context) sdk.Dec {
 func (k Keeper) IsWhitelistingEnabled(ctx sdk.Context) bool {
 	return k.GetParams(ctx).WhitelistingEnabled
 }
+
+func (k Keeper) GetFundingRateMax(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).FundingRateMax
+}
+
+// GetMaxOpenInterestRatio returns the open interest cap of a side of a pool, zero when uncapped
+func (k Keeper) GetMaxOpenInterestRatio(ctx sdk.Context, position types.Position) sdk.Dec {
+	switch position {
+	case types.Position_LONG:
+		return k.GetParams(ctx).MaxLongOpenInterestRatio
+	case types.Position_SHORT:
+		return k.GetParams(ctx).MaxShortOpenInterestRatio
+	default:
+		return sdk.ZeroDec()
+	}
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+// setPriceQueue indexes the mtp or order stored at key in the price queue at price, non positive prices are not indexed
+func (k Keeper) setPriceQueue(ctx sdk.Context, queuePrefix []byte, ammPoolId uint64, position types.Position, price sdk.Dec, key []byte) {
+	if price.IsNil() || !price.IsPositive() {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPriceQueueKey(queuePrefix, ammPoolId, position, price, key), key)
+}
+
+// removePriceQueue removes the mtp or order stored at key and indexed at price from the price queue
+func (k Keeper) removePriceQueue(ctx sdk.Context, queuePrefix []byte, ammPoolId uint64, position types.Position, price sdk.Dec, key []byte) {
+	if price.IsNil() || !price.IsPositive() {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPriceQueueKey(queuePrefix, ammPoolId, position, price, key))
+}
+
+// getPriceQueueKeys returns up to limit store keys of the pool and position indexed at or above price
+// when above is true and at or below it otherwise, the furthest from price first
+func (k Keeper) getPriceQueueKeys(ctx sdk.Context, queuePrefix []byte, ammPoolId uint64, position types.Position, price sdk.Dec, above bool, limit int64) [][]byte {
+	var keys [][]byte
+	if !price.IsPositive() || limit <= 0 {
+		return keys
+	}
+	if price.GT(sdk.MaxSortableDec) {
+		price = sdk.MaxSortableDec
+	}
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPriceQueuePrefix(queuePrefix, ammPoolId, position))
+	priceBytes := sdk.SortableDecBytes(price)
+
+	var iterator sdk.Iterator
+	if above {
+		iterator = queueStore.ReverseIterator(priceBytes, nil)
+	} else {
+		iterator = queueStore.Iterator(nil, sdk.PrefixEndBytes(priceBytes))
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid() && int64(len(keys)) < limit; iterator.Next() {
+		keys = append(keys, iterator.Value())
+	}
+
+	return keys
+}
+
+// setMTPPriceQueue indexes the mtp in the price queue at price
+func (k Keeper) setMTPPriceQueue(ctx sdk.Context, queuePrefix []byte, mtp types.MTP, price sdk.Dec) {
+	k.setPriceQueue(ctx, queuePrefix, mtp.AmmPoolId, mtp.Position, price, types.GetMTPKey(mtp.Address, mtp.Id))
+}
+
+// removeMTPPriceQueue removes the mtp indexed at price from the price queue
+func (k Keeper) removeMTPPriceQueue(ctx sdk.Context, queuePrefix []byte, mtp types.MTP, price sdk.Dec) {
+	k.removePriceQueue(ctx, queuePrefix, mtp.AmmPoolId, mtp.Position, price, types.GetMTPKey(mtp.Address, mtp.Id))
+}
+
+// getPriceQueueMTPs appends to mtps, up to limit mtps in total, the mtps of the pool and position indexed
+// at or above price when above is true and at or below it otherwise, the furthest from price first
+func (k Keeper) getPriceQueueMTPs(ctx sdk.Context, queuePrefix []byte, ammPoolId uint64, position types.Position, price sdk.Dec, above bool, mtps []*types.MTP, limit int64) []*types.MTP {
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range k.getPriceQueueKeys(ctx, queuePrefix, ammPoolId, position, price, above, limit-int64(len(mtps))) {
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+
+		var mtp types.MTP
+		k.cdc.MustUnmarshal(bz, &mtp)
+		mtps = append(mtps, &mtp)
+	}
+
+	return mtps
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/margin/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BadDebts(goCtx context.Context, req *types.BadDebtsRequest) (*types.BadDebtsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", types.MaxPageLimit))
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	badDebts, page, err := k.GetBadDebts(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BadDebtsResponse{
+		BadDebts:   badDebts,
+		Pagination: page,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/margin/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GetOrdersForAddress(goCtx context.Context, req *types.OrdersForAddressRequest) (*types.OrdersForAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", types.MaxPageLimit))
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	orders, page, err := k.GetMarginOrdersForAddress(ctx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.OrdersForAddressResponse{
+		Orders:     orders,
+		Pagination: page,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/margin/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) InsuranceFund(goCtx context.Context, req *types.InsuranceFundRequest) (*types.InsuranceFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr := k.GetInsuranceFundAddress()
+	return &types.InsuranceFundResponse{
+		Address: addr.String(),
+		Balance: k.bankKeeper.GetAllBalances(ctx, addr),
+	}, nil
+}
@@ -53,5 +53,21 @@ func (k Keeper) Pool(goCtx context.Context, req *types.QueryGetPoolRequest) (*ty
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryGetPoolResponse{Pool: val}, nil
+	longRatio, err := k.GetOpenInterestRatio(ctx, val, types.Position_LONG)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	shortRatio, err := k.GetOpenInterestRatio(ctx, val, types.Position_SHORT)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetPoolResponse{
+		Pool:                      val,
+		LongOpenInterestRatio:     longRatio,
+		ShortOpenInterestRatio:    shortRatio,
+		MaxLongOpenInterestRatio:  k.GetMaxOpenInterestRatio(ctx, types.Position_LONG),
+		MaxShortOpenInterestRatio: k.GetMaxOpenInterestRatio(ctx, types.Position_SHORT),
+	}, nil
 }
@@ -22,6 +22,7 @@ func TestPoolQuerySingle(t *testing.T) {
 	keeper, ctx := keepertest.MarginKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
 	msgs := createNPool(keeper, ctx, 2)
+	params := keeper.GetParams(ctx)
 	tests := []struct {
 		desc     string
 		request  *types.QueryGetPoolRequest
@@ -33,14 +34,26 @@ func TestPoolQuerySingle(t *testing.T) {
 			request: &types.QueryGetPoolRequest{
 				Index: msgs[0].AmmPoolId,
 			},
-			response: &types.QueryGetPoolResponse{Pool: msgs[0]},
+			response: &types.QueryGetPoolResponse{
+				Pool:                      msgs[0],
+				LongOpenInterestRatio:     sdk.ZeroDec(),
+				ShortOpenInterestRatio:    sdk.ZeroDec(),
+				MaxLongOpenInterestRatio:  params.MaxLongOpenInterestRatio,
+				MaxShortOpenInterestRatio: params.MaxShortOpenInterestRatio,
+			},
 		},
 		{
 			desc: "Second",
 			request: &types.QueryGetPoolRequest{
 				Index: msgs[1].AmmPoolId,
 			},
-			response: &types.QueryGetPoolResponse{Pool: msgs[1]},
+			response: &types.QueryGetPoolResponse{
+				Pool:                      msgs[1],
+				LongOpenInterestRatio:     sdk.ZeroDec(),
+				ShortOpenInterestRatio:    sdk.ZeroDec(),
+				MaxLongOpenInterestRatio:  params.MaxLongOpenInterestRatio,
+				MaxShortOpenInterestRatio: params.MaxShortOpenInterestRatio,
+			},
 		},
 		{
 			desc: "KeyNotFound",
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+func (k Keeper) RemoveCollateral(ctx sdk.Context, msg *types.MsgRemoveCollateral) (*types.MsgRemoveCollateralResponse, error) {
+	mtp, err := k.GetMTP(ctx, msg.Creator, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, found := k.GetPool(ctx, mtp.AmmPoolId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrPoolDoesNotExist, "pool %d", mtp.AmmPoolId)
+	}
+
+	if k.IsPoolPaused(ctx, mtp.AmmPoolId) {
+		return nil, sdkerrors.Wrapf(types.ErrAmmPoolPaused, "pool %d", mtp.AmmPoolId)
+	}
+
+	ammPool, err := k.GetAmmPool(ctx, mtp.AmmPoolId, msg.Collateral.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// the pool must hold the removed collateral before its balance is reduced by the update
+	if !k.HasSufficientPoolBalance(ctx, ammPool, msg.Collateral.Denom, msg.Collateral.Amount) {
+		return nil, sdkerrors.Wrap(types.ErrBorrowTooHigh, msg.Collateral.String())
+	}
+
+	if err := k.UpdateMTPCollateral(ctx, &mtp, &pool, ammPool, msg.Collateral, false); err != nil {
+		return nil, err
+	}
+
+	// Removal must keep the mtp health above the safety factor with a margin
+	minHealth := k.GetSafetyFactor(ctx).Add(k.GetCollateralRemovalHealthMargin(ctx))
+	if !mtp.MtpHealth.GT(minHealth) {
+		return nil, sdkerrors.Wrapf(types.ErrMTPUnhealthy, "health %s would not be above %s", mtp.MtpHealth, minHealth)
+	}
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	ammPoolAddr, err := sdk.AccAddressFromBech32(ammPool.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, ammPoolAddr, creator, sdk.NewCoins(msg.Collateral)); err != nil {
+		return nil, err
+	}
+
+	k.SetPool(ctx, pool)
+	if err := k.SetMTP(ctx, &mtp); err != nil {
+		return nil, err
+	}
+
+	if k.hooks != nil {
+		k.hooks.AfterMarginPositionModified(ctx, ammPool, pool)
+	}
+
+	k.EmitUpdateCollateral(ctx, types.EventRemoveCollateral, &mtp, msg.Collateral)
+
+	return &types.MsgRemoveCollateralResponse{}, nil
+}
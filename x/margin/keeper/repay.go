@@ -7,14 +7,18 @@ import (
 	ptypes "github.com/elys-network/elys/x/parameter/types"
 )
 
-func (k Keeper) Repay(ctx sdk.Context, mtp *types.MTP, pool *types.Pool, ammPool ammtypes.Pool, repayAmount sdk.Int, takeFundPayment bool, collateralAsset string) error {
+// Repay settles the mtp liabilities with repayAmount and returns what is left to the owner.
+// Unhealthy positions pay the insurance fund liquidation share out of the returned amount, and
+// when liquidator is set, the liquidator bonus is carved out of what remains. A shortfall is
+// covered by the insurance fund as far as its balance allows.
+func (k Keeper) Repay(ctx sdk.Context, mtp *types.MTP, pool *types.Pool, ammPool ammtypes.Pool, repayAmount sdk.Int, takeFundPayment bool, collateralAsset string, liquidator sdk.AccAddress) error {
 	collateralIndex, _ := k.GetMTPAssetIndex(mtp, collateralAsset, "")
 	// nolint:staticcheck,ineffassign
 	returnAmount := sdk.ZeroInt()
 	Liabilities := mtp.Liabilities
 	InterestUnpaidCollateral := mtp.InterestUnpaidCollaterals[collateralIndex]
 
-	if collateralAsset != ptypes.BaseCurrency {
+	if collateralAsset != ptypes.BaseCurrency && !InterestUnpaidCollateral.Amount.IsZero() {
 		// swap to base currency
 		unpaidCollateralIn := sdk.NewCoin(mtp.Collaterals[collateralIndex].Denom, mtp.InterestUnpaidCollaterals[collateralIndex].Amount)
 		C, err := k.EstimateSwapGivenOut(ctx, unpaidCollateralIn, ptypes.BaseCurrency, ammPool)
@@ -45,68 +49,73 @@ func (k Keeper) Repay(ctx sdk.Context, mtp *types.MTP, pool *types.Pool, ammPool
 		returnAmount = have.Sub(Liabilities).Sub(InterestUnpaidCollateral.Amount)
 	}
 
+	// the insurance fund covers the shortfall before it hits the pool
+	if have.LT(owe) {
+		if err := k.CoverBadDebt(ctx, mtp, pool, ammPool, owe.Sub(have)); err != nil {
+			return err
+		}
+	}
+
+	// the return amount is so far in base currency, it leaves the pool in the collateral asset
+	if collateralAsset != ptypes.BaseCurrency && !returnAmount.IsZero() {
+		amtTokenIn := sdk.NewCoin(ptypes.BaseCurrency, returnAmount)
+		C, err := k.EstimateSwapGivenOut(ctx, amtTokenIn, collateralAsset, ammPool)
+		if err != nil {
+			return err
+		}
+
+		returnAmount = C
+	}
+
 	if !returnAmount.IsZero() {
 		actualReturnAmount := returnAmount
 		if takeFundPayment {
 			takePercentage := k.GetForceCloseFundPercentage(ctx)
 
 			fundAddr := k.GetForceCloseFundAddress(ctx)
-			takeAmount, err := k.TakeFundPayment(ctx, returnAmount, ptypes.BaseCurrency, takePercentage, fundAddr, &ammPool)
+			takeAmount, err := k.TakeFundPayment(ctx, returnAmount, collateralAsset, takePercentage, fundAddr, &ammPool)
 			if err != nil {
 				return err
 			}
 			actualReturnAmount = returnAmount.Sub(takeAmount)
 			if !takeAmount.IsZero() {
-				k.EmitFundPayment(ctx, mtp, takeAmount, ptypes.BaseCurrency, types.EventRepayFund)
+				k.EmitFundPayment(ctx, mtp, takeAmount, collateralAsset, types.EventRepayFund)
 			}
 		}
 
-		// actualReturnAmount is so far in base currency, now should convert it to collateralAsset in order to return
-		if !actualReturnAmount.IsZero() {
-			if collateralAsset != ptypes.BaseCurrency {
-				// swap to base currency
-				amtTokenIn := sdk.NewCoin(ptypes.BaseCurrency, actualReturnAmount)
-				C, err := k.EstimateSwapGivenOut(ctx, amtTokenIn, collateralAsset, ammPool)
-				if err != nil {
-					return err
-				}
-
-				actualReturnAmount = C
-			}
-
-			var coins sdk.Coins
-			returnCoin := sdk.NewCoin(collateralAsset, sdk.NewIntFromBigInt(actualReturnAmount.BigInt()))
-			returnCoins := coins.Add(returnCoin)
-			addr, err := sdk.AccAddressFromBech32(mtp.Address)
+		// unhealthy positions pay the liquidation share to the insurance fund
+		if mtp.MtpHealth.LTE(k.GetSafetyFactor(ctx)) {
+			insuranceAmount, err := k.TakeInsuranceFundPayment(ctx, actualReturnAmount, collateralAsset, k.GetInsuranceFundLiquidationShare(ctx), ammPool)
 			if err != nil {
 				return err
 			}
-
-			ammPoolAddr, err := sdk.AccAddressFromBech32(ammPool.Address)
-			if err != nil {
-				return err
+			actualReturnAmount = actualReturnAmount.Sub(insuranceAmount)
+			if !insuranceAmount.IsZero() {
+				k.EmitFundPayment(ctx, mtp, insuranceAmount, collateralAsset, types.EventInsuranceFundPayment)
 			}
+		}
 
-			err = k.bankKeeper.SendCoins(ctx, ammPoolAddr, addr, returnCoins)
-			if err != nil {
-				return err
+		if liquidator != nil {
+			bonusAmount := k.GetLiquidatorBonus(ctx).MulInt(actualReturnAmount).TruncateInt()
+			if !bonusAmount.IsZero() {
+				if err := k.SendReturnAmount(ctx, ammPool, liquidator, bonusAmount, collateralAsset); err != nil {
+					return err
+				}
+				actualReturnAmount = actualReturnAmount.Sub(bonusAmount)
 			}
 		}
-	}
 
-	// before updating collateral asset balance, we should convert returnAmount to collateralAsset
-	// because so far returnAmount is in base currency.
-	if collateralAsset != ptypes.BaseCurrency {
-		// swap to base currency
-		amtTokenIn := sdk.NewCoin(ptypes.BaseCurrency, returnAmount)
-		C, err := k.EstimateSwapGivenOut(ctx, amtTokenIn, collateralAsset, ammPool)
+		addr, err := sdk.AccAddressFromBech32(mtp.Address)
 		if err != nil {
 			return err
 		}
 
-		returnAmount = C
+		if err := k.SendReturnAmount(ctx, ammPool, addr, actualReturnAmount, collateralAsset); err != nil {
+			return err
+		}
 	}
 
+	// every share of the return amount has left the pool in the collateral asset
 	err = pool.UpdateBalance(ctx, mtp.Collaterals[collateralIndex].Denom, returnAmount, false)
 	if err != nil {
 		return err
@@ -118,7 +127,7 @@ func (k Keeper) Repay(ctx sdk.Context, mtp *types.MTP, pool *types.Pool, ammPool
 		return err
 	}
 
-	err = k.DestroyMTP(ctx, mtp.Address, mtp.Id)
+	err = pool.UpdatePositionLiabilities(ctx, mtp.Position, mtp.Liabilities, false)
 	if err != nil {
 		return err
 	}
@@ -127,3 +136,17 @@ func (k Keeper) Repay(ctx sdk.Context, mtp *types.MTP, pool *types.Pool, ammPool
 
 	return nil
 }
+
+// SendReturnAmount sends the collateralAsset amount returned from a closed mtp from the pool to addr
+func (k Keeper) SendReturnAmount(ctx sdk.Context, ammPool ammtypes.Pool, addr sdk.AccAddress, amount sdk.Int, collateralAsset string) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	ammPoolAddr, err := sdk.AccAddressFromBech32(ammPool.Address)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoins(ctx, ammPoolAddr, addr, sdk.NewCoins(sdk.NewCoin(collateralAsset, amount)))
+}
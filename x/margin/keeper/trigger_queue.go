@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+// getTakeProfitQueuePrice returns the take profit price the mtp is indexed at, zero when it is unset
+func getTakeProfitQueuePrice(mtp types.MTP) sdk.Dec {
+	if mtp.TakeProfitPrice.IsNil() || mtp.TakeProfitPrice.GTE(sdk.MustNewDecFromStr(types.TakeProfitPriceDefault)) {
+		return sdk.ZeroDec()
+	}
+	return mtp.TakeProfitPrice
+}
+
+// SetTriggerQueues indexes the mtp by its take profit and stop loss prices, unset prices are not indexed
+func (k Keeper) SetTriggerQueues(ctx sdk.Context, mtp types.MTP) {
+	k.setMTPPriceQueue(ctx, types.TakeProfitQueuePrefix, mtp, getTakeProfitQueuePrice(mtp))
+	k.setMTPPriceQueue(ctx, types.StopLossQueuePrefix, mtp, mtp.StopLossPrice)
+}
+
+// RemoveTriggerQueues removes the mtp from the take profit and stop loss queues
+func (k Keeper) RemoveTriggerQueues(ctx sdk.Context, mtp types.MTP) {
+	k.removeMTPPriceQueue(ctx, types.TakeProfitQueuePrefix, mtp, getTakeProfitQueuePrice(mtp))
+	k.removeMTPPriceQueue(ctx, types.StopLossQueuePrefix, mtp, mtp.StopLossPrice)
+}
+
+// RemoveTakeProfitQueue removes the mtp from the take profit queue only
+func (k Keeper) RemoveTakeProfitQueue(ctx sdk.Context, mtp types.MTP) {
+	k.removeMTPPriceQueue(ctx, types.TakeProfitQueuePrefix, mtp, getTakeProfitQueuePrice(mtp))
+}
+
+// RemoveStopLossQueue removes the mtp from the stop loss queue only
+func (k Keeper) RemoveStopLossQueue(ctx sdk.Context, mtp types.MTP) {
+	k.removeMTPPriceQueue(ctx, types.StopLossQueuePrefix, mtp, mtp.StopLossPrice)
+}
+
+// GetTakeProfitQueueMTPs returns up to limit mtps of the pool whose take profit is reached at price,
+// longs at or below it and shorts at or above it
+func (k Keeper) GetTakeProfitQueueMTPs(ctx sdk.Context, ammPoolId uint64, price sdk.Dec, limit int64) []*types.MTP {
+	var mtps []*types.MTP
+	mtps = k.getPriceQueueMTPs(ctx, types.TakeProfitQueuePrefix, ammPoolId, types.Position_LONG, price, false, mtps, limit)
+	return k.getPriceQueueMTPs(ctx, types.TakeProfitQueuePrefix, ammPoolId, types.Position_SHORT, price, true, mtps, limit)
+}
+
+// GetStopLossQueueMTPs returns up to limit mtps of the pool whose stop loss is reached at price,
+// longs at or above it and shorts at or below it
+func (k Keeper) GetStopLossQueueMTPs(ctx sdk.Context, ammPoolId uint64, price sdk.Dec, limit int64) []*types.MTP {
+	var mtps []*types.MTP
+	mtps = k.getPriceQueueMTPs(ctx, types.StopLossQueuePrefix, ammPoolId, types.Position_LONG, price, true, mtps, limit)
+	return k.getPriceQueueMTPs(ctx, types.StopLossQueuePrefix, ammPoolId, types.Position_SHORT, price, false, mtps, limit)
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/elys-network/elys/app"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+	oracletypes "github.com/elys-network/elys/x/oracle/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriggerQueues(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(200000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)))
+	for _, owner := range addr {
+		err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+		require.NoError(t, err)
+		err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, owner, coins)
+		require.NoError(t, err)
+	}
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	poolId, err := amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	params := mk.GetParams(ctx)
+	params.PoolOpenThreshold = sdk.MustNewDecFromStr("0.65")
+	params.MaxTriggersPerBlock = 1
+	require.NoError(t, mk.SetParams(ctx, &params))
+
+	// ATOM oracle price is 0.0001, both longs take profit at 2 and the second one stops its loss at 0.00005
+	for i, owner := range addr {
+		stopLossPrice := sdk.ZeroDec()
+		if i == 1 {
+			stopLossPrice = sdk.MustNewDecFromStr("0.00005")
+		}
+		_, err = mk.Open(ctx, types.NewMsgOpen(
+			owner.String(),
+			ptypes.BaseCurrency,
+			sdk.NewInt(100),
+			ptypes.ATOM,
+			types.Position_LONG,
+			sdk.NewDec(5),
+			sdk.NewDec(2),
+			stopLossPrice,
+		))
+		require.NoError(t, err)
+	}
+	require.Len(t, mk.GetAllMTPs(ctx), 2)
+
+	// only the mtps whose trigger price is reached are read
+	price := mk.GetPoolTradingAssetPrice(ctx, poolId)
+	require.Len(t, mk.GetTakeProfitQueueMTPs(ctx, poolId, price, 10), 0)
+	require.Len(t, mk.GetStopLossQueueMTPs(ctx, poolId, price, 10), 0)
+	require.Len(t, mk.GetTakeProfitQueueMTPs(ctx, poolId, sdk.NewDec(2), 10), 2)
+	require.Len(t, mk.GetTakeProfitQueueMTPs(ctx, poolId, sdk.NewDec(2), 1), 1)
+	require.Len(t, mk.GetStopLossQueueMTPs(ctx, poolId, sdk.MustNewDecFromStr("0.00005"), 10), 1)
+
+	oracle.SetPrice(ctx, oracletypes.Price{
+		Asset:     "ATOM",
+		Price:     sdk.NewDec(3000000),
+		Source:    "atom",
+		Provider:  sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		Timestamp: uint64(ctx.BlockTime().Unix()),
+	})
+
+	// take profits are capped per block
+	mk.BeginBlocker(ctx)
+	require.Len(t, mk.GetAllMTPs(ctx), 1)
+	mk.BeginBlocker(ctx)
+	require.Len(t, mk.GetAllMTPs(ctx), 0)
+
+	// closed mtps are removed from the queues
+	require.Len(t, mk.GetTakeProfitQueueMTPs(ctx, poolId, sdk.NewDec(2), 10), 0)
+	require.Len(t, mk.GetStopLossQueueMTPs(ctx, poolId, sdk.MustNewDecFromStr("0.00005"), 10), 0)
+}
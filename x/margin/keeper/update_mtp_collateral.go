@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+)
+
+// UpdateMTPCollateral adds collateral to the mtp or removes it, repaying or borrowing
+// its base currency value so that custody stays unchanged.
+func (k Keeper) UpdateMTPCollateral(ctx sdk.Context, mtp *types.MTP, pool *types.Pool, ammPool ammtypes.Pool, collateral sdk.Coin, isIncrease bool) error {
+	collateralIndex, _ := k.GetMTPAssetIndex(mtp, collateral.Denom, "")
+	if collateralIndex < 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidCollateralAsset, "%s is not a collateral of mtp %d", collateral.Denom, mtp.Id)
+	}
+
+	// Liabilities are in base currency
+	liabilities := collateral.Amount
+	if collateral.Denom != ptypes.BaseCurrency {
+		C, err := k.EstimateSwapGivenOut(ctx, collateral, ptypes.BaseCurrency, ammPool)
+		if err != nil {
+			return err
+		}
+		liabilities = C
+	}
+
+	if isIncrease {
+		if !liabilities.LT(mtp.Liabilities) {
+			return sdkerrors.Wrapf(types.ErrInvalidCollateralAsset, "collateral %s would repay all liabilities", collateral)
+		}
+		mtp.Collaterals[collateralIndex].Amount = mtp.Collaterals[collateralIndex].Amount.Add(collateral.Amount)
+		mtp.Liabilities = mtp.Liabilities.Sub(liabilities)
+	} else {
+		if !collateral.Amount.LT(mtp.Collaterals[collateralIndex].Amount) {
+			return sdkerrors.Wrapf(types.ErrInvalidCollateralAsset, "collateral %s is higher than mtp collateral", collateral)
+		}
+		mtp.Collaterals[collateralIndex].Amount = mtp.Collaterals[collateralIndex].Amount.Sub(collateral.Amount)
+		mtp.Liabilities = mtp.Liabilities.Add(liabilities)
+	}
+
+	err := pool.UpdateBalance(ctx, collateral.Denom, collateral.Amount, isIncrease)
+	if err != nil {
+		return err
+	}
+
+	err = pool.UpdateLiabilities(ctx, ptypes.BaseCurrency, liabilities, !isIncrease)
+	if err != nil {
+		return err
+	}
+
+	err = pool.UpdatePositionLiabilities(ctx, mtp.Position, liabilities, !isIncrease)
+	if err != nil {
+		return err
+	}
+
+	mtp.MtpHealth, err = k.UpdateMTPHealth(ctx, *mtp, ammPool)
+	if err != nil {
+		return err
+	}
+
+	if err := k.CalcMTPConsolidateCollateral(ctx, mtp); err != nil {
+		return err
+	}
+	k.CalcMTPConsolidateLiability(ctx, mtp)
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/elys-network/elys/app"
+	ammtypes "github.com/elys-network/elys/x/amm/types"
+	"github.com/elys-network/elys/x/margin/keeper"
+	"github.com/elys-network/elys/x/margin/types"
+	ptypes "github.com/elys-network/elys/x/parameter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateMTPCollateral(t *testing.T) {
+	app := simapp.InitElysTestApp(true)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	mk, amm, oracle := app.MarginKeeper, app.AmmKeeper, app.OracleKeeper
+
+	// Setup coin prices
+	SetupStableCoinPrices(ctx, oracle)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000))
+
+	coins := sdk.NewCoins(sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(100000)), sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)))
+	err := app.BankKeeper.MintCoins(ctx, ammtypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, ammtypes.ModuleName, addr[0], coins)
+	require.NoError(t, err)
+
+	poolAssets := []ammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.ATOM, sdk.NewInt(100000)),
+		},
+		{
+			Weight: sdk.NewInt(50),
+			Token:  sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(10000)),
+		},
+	}
+	poolParams := &ammtypes.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}
+	_, err = amm.CreatePool(ctx, ammtypes.NewMsgCreatePool(addr[0].String(), poolParams, poolAssets))
+	require.NoError(t, err)
+
+	_, err = mk.Open(ctx, types.NewMsgOpen(
+		addr[0].String(),
+		ptypes.BaseCurrency,
+		sdk.NewInt(100),
+		ptypes.ATOM,
+		types.Position_LONG,
+		sdk.NewDec(5),
+		sdk.MustNewDecFromStr(types.TakeProfitPriceDefault),
+		sdk.ZeroDec(),
+	))
+	require.NoError(t, err)
+	mtps := mk.GetAllMTPs(ctx)
+	require.Len(t, mtps, 1)
+	opened := mtps[0]
+	msgServer := keeper.NewMsgServerImpl(mk)
+
+	// adding collateral repays liabilities and improves health
+	collateral := sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(50))
+	_, err = msgServer.AddCollateral(sdk.WrapSDKContext(ctx), types.NewMsgAddCollateral(addr[0].String(), opened.Id, collateral))
+	require.NoError(t, err)
+
+	mtp, err := mk.GetMTP(ctx, addr[0].String(), opened.Id)
+	require.NoError(t, err)
+	require.Equal(t, opened.Collaterals[0].Amount.Add(collateral.Amount), mtp.Collaterals[0].Amount)
+	require.Equal(t, opened.Liabilities.Sub(collateral.Amount), mtp.Liabilities)
+	require.Equal(t, opened.Custodies, mtp.Custodies)
+	require.Equal(t, mtp.Collaterals[0].Amount, mtp.SumCollateral)
+	require.True(t, mtp.MtpHealth.GT(opened.MtpHealth))
+
+	// collateral not held by the mtp cannot be added
+	_, err = msgServer.AddCollateral(sdk.WrapSDKContext(ctx), types.NewMsgAddCollateral(addr[0].String(), opened.Id, sdk.NewCoin(ptypes.ATOM, sdk.NewInt(10))))
+	require.ErrorIs(t, err, types.ErrInvalidCollateralAsset)
+
+	// removal that would bring health under the safety factor margin is rejected
+	_, err = msgServer.RemoveCollateral(sdk.WrapSDKContext(ctx), types.NewMsgRemoveCollateral(addr[0].String(), opened.Id, sdk.NewCoin(ptypes.BaseCurrency, sdk.NewInt(140))))
+	require.ErrorIs(t, err, types.ErrMTPUnhealthy)
+
+	balanceBefore := app.BankKeeper.GetBalance(ctx, addr[0], ptypes.BaseCurrency)
+	_, err = msgServer.RemoveCollateral(sdk.WrapSDKContext(ctx), types.NewMsgRemoveCollateral(addr[0].String(), opened.Id, collateral))
+	require.NoError(t, err)
+	require.Equal(t, balanceBefore.Add(collateral), app.BankKeeper.GetBalance(ctx, addr[0], ptypes.BaseCurrency))
+
+	mtp, err = mk.GetMTP(ctx, addr[0].String(), opened.Id)
+	require.NoError(t, err)
+	require.Equal(t, opened.Collaterals, mtp.Collaterals)
+	require.Equal(t, opened.Liabilities, mtp.Liabilities)
+	require.Equal(t, opened.MtpHealth, mtp.MtpHealth)
+
+	pool, found := mk.GetPool(ctx, mtp.AmmPoolId)
+	require.True(t, found)
+	for _, asset := range pool.PoolAssets {
+		if asset.AssetDenom == ptypes.BaseCurrency {
+			require.Equal(t, mtp.Liabilities, asset.Liabilities)
+		}
+	}
+}
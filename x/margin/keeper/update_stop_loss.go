@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+func (k Keeper) UpdateStopLoss(ctx sdk.Context, msg *types.MsgUpdateStopLoss) (*types.MsgUpdateStopLossResponse, error) {
+	mtp, err := k.GetMTP(ctx, msg.Creator, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the mtp is re-indexed in the liquidation queue, so its health must reflect the current pool state
+	ammPool, found := k.amm.GetPool(ctx, mtp.AmmPoolId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrPoolDoesNotExist, "pool %d", mtp.AmmPoolId)
+	}
+	mtp.MtpHealth, err = k.UpdateMTPHealth(ctx, mtp, ammPool)
+	if err != nil {
+		return nil, err
+	}
+
+	mtp.StopLossPrice = msg.StopLossPrice
+	if err := k.SetMTP(ctx, &mtp); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventUpdateStopLoss,
+		sdk.NewAttribute("id", strconv.FormatInt(int64(mtp.Id), 10)),
+		sdk.NewAttribute("address", mtp.Address),
+		sdk.NewAttribute("stop_loss_price", mtp.StopLossPrice.String()),
+	))
+
+	return &types.MsgUpdateStopLossResponse{}, nil
+}
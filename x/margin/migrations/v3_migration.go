@@ -0,0 +1,121 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/elys-network/elys/x/margin/types"
+)
+
+// V3Migration sets the params and fields added since v2 and indexes open mtps in the liquidation, take profit and stop loss queues
+func (m Migrator) V3Migration(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	defaults := types.DefaultParams()
+	backfillCollateralRemovalParams(&params, defaults)
+	backfillLiquidatorParams(&params, defaults)
+	if params.MaxLiquidationsPerBlock <= 0 {
+		params.MaxLiquidationsPerBlock = defaults.MaxLiquidationsPerBlock
+	}
+	if params.InsuranceFundInterestShare.IsNil() {
+		params.InsuranceFundInterestShare = defaults.InsuranceFundInterestShare
+	}
+	if params.InsuranceFundLiquidationShare.IsNil() {
+		params.InsuranceFundLiquidationShare = defaults.InsuranceFundLiquidationShare
+	}
+	if params.MaxLongOpenInterestRatio.IsNil() {
+		params.MaxLongOpenInterestRatio = defaults.MaxLongOpenInterestRatio
+	}
+	if params.MaxShortOpenInterestRatio.IsNil() {
+		params.MaxShortOpenInterestRatio = defaults.MaxShortOpenInterestRatio
+	}
+	if params.FundingRateMax.IsNil() {
+		params.FundingRateMax = defaults.FundingRateMax
+	}
+	if params.MaxTriggersPerBlock <= 0 {
+		params.MaxTriggersPerBlock = defaults.MaxTriggersPerBlock
+	}
+	backfillOrderParams(&params, defaults)
+	if err := m.keeper.SetParams(ctx, &params); err != nil {
+		return err
+	}
+
+	mtps := m.keeper.GetAllMTPs(ctx)
+	for _, mtp := range mtps {
+		backfillStopLossPrice(&mtp)
+		backfillLastFundingIndex(&mtp)
+		if err := m.keeper.SetMTP(ctx, &mtp); err != nil {
+			return err
+		}
+	}
+
+	for _, pool := range m.keeper.GetAllPools(ctx) {
+		if pool.FundingRate.IsNil() {
+			pool.FundingRate = sdk.ZeroDec()
+		}
+		if pool.LongFundingIndex.IsNil() {
+			pool.LongFundingIndex = sdk.ZeroDec()
+		}
+		if pool.ShortFundingIndex.IsNil() {
+			pool.ShortFundingIndex = sdk.ZeroDec()
+		}
+		if err := backfillPositionLiabilities(ctx, &pool, mtps); err != nil {
+			return err
+		}
+		m.keeper.SetPool(ctx, pool)
+	}
+	return nil
+}
+
+// backfillLastFundingIndex starts the funding of mtps opened before funding existed at the initial funding index
+func backfillLastFundingIndex(mtp *types.MTP) {
+	if mtp.LastFundingIndex.IsNil() {
+		mtp.LastFundingIndex = sdk.ZeroDec()
+	}
+}
+
+// backfillPositionLiabilities sums the liabilities of the long and short mtps of the pool
+func backfillPositionLiabilities(ctx sdk.Context, pool *types.Pool, mtps []types.MTP) error {
+	pool.LongLiabilities = sdk.ZeroInt()
+	pool.ShortLiabilities = sdk.ZeroInt()
+	for _, mtp := range mtps {
+		if mtp.AmmPoolId != pool.AmmPoolId {
+			continue
+		}
+		if err := pool.UpdatePositionLiabilities(ctx, mtp.Position, mtp.Liabilities, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillStopLossPrice leaves the stop loss of mtps opened before stop losses existed unset
+func backfillStopLossPrice(mtp *types.MTP) {
+	if mtp.StopLossPrice.IsNil() {
+		mtp.StopLossPrice = sdk.ZeroDec()
+	}
+}
+
+// backfillCollateralRemovalParams sets the health margin required when removing collateral
+func backfillCollateralRemovalParams(params *types.Params, defaults types.Params) {
+	if params.CollateralRemovalHealthMargin.IsNil() {
+		params.CollateralRemovalHealthMargin = defaults.CollateralRemovalHealthMargin
+	}
+}
+
+// backfillLiquidatorParams sets the bonus paid to permissionless liquidators
+func backfillLiquidatorParams(params *types.Params, defaults types.Params) {
+	if params.LiquidatorBonus.IsNil() {
+		params.LiquidatorBonus = defaults.LiquidatorBonus
+	}
+}
+
+// backfillOrderParams sets the limits on pending margin orders
+func backfillOrderParams(params *types.Params, defaults types.Params) {
+	if params.MaxOrderExecutionsPerBlock <= 0 {
+		params.MaxOrderExecutionsPerBlock = defaults.MaxOrderExecutionsPerBlock
+	}
+	if params.MinOrderCollateral.IsNil() {
+		params.MinOrderCollateral = defaults.MinOrderCollateral
+	}
+	if params.MaxOrderDuration <= 0 {
+		params.MaxOrderDuration = defaults.MaxOrderDuration
+	}
+}
@@ -14,6 +14,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdatePools{}, "margin/UpdatePools", nil)
 	cdc.RegisterConcrete(&MsgWhitelist{}, "margin/Whitelist", nil)
 	cdc.RegisterConcrete(&MsgDewhitelist{}, "margin/Dewhitelist", nil)
+	cdc.RegisterConcrete(&MsgUpdateStopLoss{}, "margin/UpdateStopLoss", nil)
+	cdc.RegisterConcrete(&MsgAddCollateral{}, "margin/AddCollateral", nil)
+	cdc.RegisterConcrete(&MsgRemoveCollateral{}, "margin/RemoveCollateral", nil)
+	cdc.RegisterConcrete(&MsgLiquidate{}, "margin/Liquidate", nil)
+	cdc.RegisterConcrete(&MsgPlaceMarginOrder{}, "margin/PlaceMarginOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelMarginOrder{}, "margin/CancelMarginOrder", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -25,6 +31,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdatePools{},
 		&MsgWhitelist{},
 		&MsgDewhitelist{},
+		&MsgUpdateStopLoss{},
+		&MsgAddCollateral{},
+		&MsgRemoveCollateral{},
+		&MsgLiquidate{},
+		&MsgPlaceMarginOrder{},
+		&MsgCancelMarginOrder{},
 	)
 
 	// this line is used by starport scaffolding # 3
@@ -3,5 +3,18 @@ package types
 const EventOpen = "margin/mtp_open"
 const EventClose = "margin/mtp_close"
 const EventForceClose = "margin/mtp_force_close"
+const EventTakeProfit = "margin_take_profit"
+const EventUpdateStopLoss = "margin/mtp_update_stop_loss"
+const EventAddCollateral = "margin/mtp_add_collateral"
+const EventRemoveCollateral = "margin/mtp_remove_collateral"
 const EventIncrementalPayFund = "margin/incremental_pay_fund"
 const EventRepayFund = "margin/repay_fund"
+const EventInsuranceFundPayment = "margin/insurance_fund_payment"
+const EventBadDebt = "margin/bad_debt"
+const EventPlaceOrder = "margin/order_place"
+const EventCancelOrder = "margin/order_cancel"
+const EventExecuteOrder = "margin/order_execute"
+const EventExpireOrder = "margin/order_expire"
+const EventFailOrder = "margin/order_fail"
+const EventFundingPayment = "margin/funding_payment"
+const EventQueueDrop = "margin/mtp_queue_drop"
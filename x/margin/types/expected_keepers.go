@@ -24,6 +24,7 @@ type PoolChecker interface {
 	GetPool(ctx sdk.Context, poolId uint64) (Pool, bool)
 	IsPoolEnabled(ctx sdk.Context, poolId uint64) bool
 	IsPoolClosed(ctx sdk.Context, poolId uint64) bool
+	IsPoolPaused(ctx sdk.Context, poolId uint64) bool
 	GetPoolOpenThreshold(ctx sdk.Context) math.LegacyDec
 }
 
@@ -36,6 +37,7 @@ type OpenChecker interface {
 	GetTradingAsset(collateralAsset string, borrowAsset string) string
 	PreparePools(ctx sdk.Context, tradingAsset string) (poolId uint64, ammPool ammtypes.Pool, pool Pool, err error)
 	CheckPoolHealth(ctx sdk.Context, poolId uint64) error
+	CheckOpenInterestCap(ctx sdk.Context, poolId uint64, position Position) error
 	OpenLong(ctx sdk.Context, poolId uint64, msg *MsgOpen) (*MTP, error)
 	OpenShort(ctx sdk.Context, poolId uint64, msg *MsgOpen) (*MTP, error)
 	EmitOpenEvent(ctx sdk.Context, mtp *MTP)
@@ -51,6 +53,7 @@ type OpenLongChecker interface {
 	GetTradingAsset(collateralAsset string, borrowAsset string) string
 	GetPool(ctx sdk.Context, poolId uint64) (Pool, bool)
 	IsPoolEnabled(ctx sdk.Context, poolId uint64) bool
+	IsPoolPaused(ctx sdk.Context, poolId uint64) bool
 	GetAmmPool(ctx sdk.Context, poolId uint64, tradingAsset string) (ammtypes.Pool, error)
 	HasSufficientPoolBalance(ctx sdk.Context, ammPool ammtypes.Pool, assetDenom string, requiredAmount sdk.Int) bool
 	CheckMinLiabilities(ctx sdk.Context, collateralTokenAmt sdk.Coin, eta sdk.Dec, pool Pool, ammPool ammtypes.Pool, borrowAsset string) error
@@ -144,6 +147,8 @@ type AmmKeeper interface {
 	// IterateCommitments iterates over all Commitments and performs a callback.
 	IterateLiquidityPools(sdk.Context, func(ammtypes.Pool) bool)
 	GetPoolSnapshotOrSet(ctx sdk.Context, pool ammtypes.Pool) (val ammtypes.Pool)
+	// IsPoolPaused returns true when swaps and joins are disabled on the pool
+	IsPoolPaused(ctx sdk.Context, poolId uint64) bool
 
 	CalcOutAmtGivenIn(ctx sdk.Context, poolId uint64, oracle ammtypes.OracleKeeper, snapshot *ammtypes.Pool, tokensIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (sdk.Coin, error)
 	CalcInAmtGivenOut(ctx sdk.Context, poolId uint64, oracle ammtypes.OracleKeeper, snapshot *ammtypes.Pool, tokensOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error)
@@ -13,6 +13,8 @@ func DefaultGenesis() *GenesisState {
 		PoolList:         []Pool{},
 		MtpList:          []MTP{},
 		AddressWhitelist: []string{},
+		BadDebtList:      []BadDebt{},
+		OrderList:        []MarginOrder{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -52,6 +54,22 @@ func (gs GenesisState) Validate() error {
 		}
 		whitelistMap[index] = struct{}{}
 	}
+	// Check for duplicated index in bad debt
+	badDebtIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.BadDebtList {
+		if _, ok := badDebtIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated index for bad debt")
+		}
+		badDebtIndexMap[elem.Id] = struct{}{}
+	}
+	// Check for duplicated index in order
+	orderIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.OrderList {
+		if _, ok := orderIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated index for order")
+		}
+		orderIndexMap[elem.Id] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -25,10 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the margin module's genesis state.
 type GenesisState struct {
-	Params           Params   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PoolList         []Pool   `protobuf:"bytes,2,rep,name=pool_list,json=poolList,proto3" json:"pool_list"`
-	MtpList          []MTP    `protobuf:"bytes,3,rep,name=mtp_list,json=mtpList,proto3" json:"mtp_list"`
-	AddressWhitelist []string `protobuf:"bytes,4,rep,name=address_whitelist,json=addressWhitelist,proto3" json:"address_whitelist,omitempty"`
+	Params           Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PoolList         []Pool        `protobuf:"bytes,2,rep,name=pool_list,json=poolList,proto3" json:"pool_list"`
+	MtpList          []MTP         `protobuf:"bytes,3,rep,name=mtp_list,json=mtpList,proto3" json:"mtp_list"`
+	AddressWhitelist []string      `protobuf:"bytes,4,rep,name=address_whitelist,json=addressWhitelist,proto3" json:"address_whitelist,omitempty"`
+	BadDebtList      []BadDebt     `protobuf:"bytes,5,rep,name=bad_debt_list,json=badDebtList,proto3" json:"bad_debt_list"`
+	OrderList        []MarginOrder `protobuf:"bytes,6,rep,name=order_list,json=orderList,proto3" json:"order_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +94,20 @@ func (m *GenesisState) GetAddressWhitelist() []string {
 	return nil
 }
 
+func (m *GenesisState) GetBadDebtList() []BadDebt {
+	if m != nil {
+		return m.BadDebtList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrderList() []MarginOrder {
+	if m != nil {
+		return m.OrderList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "elys.margin.GenesisState")
 }
@@ -99,26 +115,29 @@ func init() {
 func init() { proto.RegisterFile("elys/margin/genesis.proto", fileDescriptor_c83986b328ef5983) }
 
 var fileDescriptor_c83986b328ef5983 = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xc1, 0x4a, 0xc3, 0x30,
-	0x18, 0xc7, 0x1b, 0x3b, 0xe6, 0x96, 0x7a, 0xd8, 0xaa, 0x68, 0xed, 0x21, 0x16, 0x4f, 0x85, 0x61,
-	0xcb, 0xa6, 0x4f, 0x30, 0x10, 0x2f, 0x0a, 0x63, 0x0a, 0x82, 0x97, 0xd1, 0xb9, 0xd0, 0x05, 0xdb,
-	0xa6, 0x34, 0x9f, 0xcc, 0xbd, 0x85, 0x8f, 0xb5, 0xe3, 0x6e, 0x7a, 0x12, 0x69, 0x5f, 0x44, 0x9a,
-	0x44, 0x68, 0x6f, 0xc9, 0xff, 0xf7, 0xfd, 0xf2, 0x0f, 0x1f, 0x3e, 0xa7, 0xc9, 0x56, 0x84, 0x69,
-	0x54, 0xc4, 0x2c, 0x0b, 0x63, 0x9a, 0x51, 0xc1, 0x44, 0x90, 0x17, 0x1c, 0xb8, 0x6d, 0xd5, 0x28,
-	0x50, 0xc8, 0x3d, 0x89, 0x79, 0xcc, 0x65, 0x1e, 0xd6, 0x27, 0x35, 0xe2, 0x3a, 0x4d, 0x3b, 0x8f,
-	0x8a, 0x28, 0xd5, 0xb2, 0x7b, 0xda, 0x22, 0x9c, 0x27, 0x3a, 0x3f, 0x6b, 0xe6, 0xb0, 0xcd, 0xa9,
-	0x16, 0x2e, 0xbf, 0x10, 0x3e, 0xba, 0x53, 0xfd, 0x8f, 0x10, 0x01, 0xb5, 0xc7, 0xb8, 0xab, 0x5e,
-	0x74, 0x90, 0x87, 0x7c, 0x6b, 0x72, 0x1c, 0x34, 0xfe, 0x13, 0xcc, 0x24, 0x9a, 0x76, 0x76, 0x3f,
-	0x17, 0xc6, 0x5c, 0x0f, 0xda, 0x37, 0xb8, 0x5f, 0x57, 0x2d, 0x12, 0x26, 0xc0, 0x39, 0xf0, 0x4c,
-	0xdf, 0x9a, 0x0c, 0xdb, 0x16, 0xe7, 0x89, 0x76, 0x7a, 0xf5, 0xe4, 0x3d, 0x13, 0x60, 0x8f, 0x71,
-	0x2f, 0x85, 0x5c, 0x49, 0xa6, 0x94, 0x06, 0x2d, 0xe9, 0xe1, 0x69, 0xa6, 0x9d, 0xc3, 0x14, 0x72,
-	0xa9, 0x8c, 0xf0, 0x30, 0x5a, 0xad, 0x0a, 0x2a, 0xc4, 0x62, 0xb3, 0x66, 0x40, 0xa5, 0xdb, 0xf1,
-	0x4c, 0xbf, 0x3f, 0x1f, 0x68, 0xf0, 0xfc, 0x9f, 0x4f, 0x6f, 0x77, 0x25, 0x41, 0xfb, 0x92, 0xa0,
-	0xdf, 0x92, 0xa0, 0xcf, 0x8a, 0x18, 0xfb, 0x8a, 0x18, 0xdf, 0x15, 0x31, 0x5e, 0x46, 0x31, 0x83,
-	0xf5, 0xfb, 0x32, 0x78, 0xe5, 0x69, 0x58, 0x37, 0x5e, 0x65, 0x14, 0x36, 0xbc, 0x78, 0x93, 0x97,
-	0xf0, 0xa3, 0xb5, 0xa6, 0x65, 0x57, 0xee, 0xe9, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x21, 0x60,
-	0x56, 0x41, 0xb2, 0x01, 0x00, 0x00,
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xcd, 0x4a, 0xc3, 0x40,
+	0x14, 0x85, 0x93, 0xb6, 0xd6, 0x76, 0xa2, 0xd0, 0xc6, 0xa2, 0xb1, 0x8b, 0x58, 0x5c, 0x15, 0x8a,
+	0x09, 0xad, 0x6e, 0x75, 0x51, 0x14, 0x37, 0x8a, 0xa5, 0x0a, 0x82, 0x9b, 0x32, 0x31, 0x43, 0x1a,
+	0x4c, 0x3a, 0x61, 0xe6, 0x4a, 0xed, 0x5b, 0xf8, 0x58, 0x5d, 0x76, 0x25, 0xae, 0x44, 0xda, 0x17,
+	0x91, 0xf9, 0x11, 0x3a, 0xab, 0x64, 0xce, 0x39, 0xdf, 0x3d, 0x17, 0x2e, 0x3a, 0x26, 0xd9, 0x82,
+	0x87, 0x39, 0x66, 0x49, 0x3a, 0x0b, 0x13, 0x32, 0x23, 0x3c, 0xe5, 0x41, 0xc1, 0x28, 0x50, 0xd7,
+	0x11, 0x56, 0xa0, 0xac, 0x76, 0x2b, 0xa1, 0x09, 0x95, 0x7a, 0x28, 0xfe, 0x54, 0xa4, 0xed, 0x6d,
+	0xd3, 0x05, 0x66, 0x38, 0xd7, 0x70, 0xfb, 0xd0, 0x70, 0x28, 0xcd, 0xb4, 0x7e, 0xb4, 0xad, 0xc3,
+	0xa2, 0x20, 0x1a, 0x38, 0xfd, 0x2a, 0xa1, 0xbd, 0x5b, 0xd5, 0xff, 0x08, 0x18, 0x88, 0xdb, 0x47,
+	0x55, 0x35, 0xd1, 0xb3, 0x3b, 0x76, 0xd7, 0x19, 0x1c, 0x04, 0x5b, 0xfb, 0x04, 0x23, 0x69, 0x0d,
+	0x2b, 0xcb, 0x9f, 0x13, 0x6b, 0xac, 0x83, 0xee, 0x05, 0xaa, 0x8b, 0xaa, 0x49, 0x96, 0x72, 0xf0,
+	0x4a, 0x9d, 0x72, 0xd7, 0x19, 0x34, 0x4d, 0x8a, 0xd2, 0x4c, 0x33, 0x35, 0x91, 0xbc, 0x4b, 0x39,
+	0xb8, 0x7d, 0x54, 0xcb, 0xa1, 0x50, 0x50, 0x59, 0x42, 0x0d, 0x03, 0xba, 0x7f, 0x1a, 0x69, 0x66,
+	0x37, 0x87, 0x42, 0x22, 0x3d, 0xd4, 0xc4, 0x71, 0xcc, 0x08, 0xe7, 0x93, 0xf9, 0x34, 0x05, 0x22,
+	0xd9, 0x4a, 0xa7, 0xdc, 0xad, 0x8f, 0x1b, 0xda, 0x78, 0xfe, 0xd7, 0xdd, 0x2b, 0xb4, 0x1f, 0xe1,
+	0x78, 0x12, 0x93, 0x08, 0x54, 0xc9, 0x8e, 0x2c, 0x69, 0x19, 0x25, 0x43, 0x1c, 0x5f, 0x93, 0x08,
+	0x74, 0x91, 0x13, 0xa9, 0xa7, 0x2c, 0xbb, 0x44, 0x88, 0xb2, 0x98, 0x30, 0x05, 0x57, 0x25, 0xec,
+	0x99, 0x1b, 0xca, 0xcf, 0x83, 0x08, 0xe9, 0x01, 0x75, 0x49, 0x08, 0x7c, 0x78, 0xb3, 0x5c, 0xfb,
+	0xf6, 0x6a, 0xed, 0xdb, 0xbf, 0x6b, 0xdf, 0xfe, 0xdc, 0xf8, 0xd6, 0x6a, 0xe3, 0x5b, 0xdf, 0x1b,
+	0xdf, 0x7a, 0xe9, 0x25, 0x29, 0x4c, 0xdf, 0xa3, 0xe0, 0x95, 0xe6, 0xa1, 0x18, 0x77, 0x36, 0x23,
+	0x30, 0xa7, 0xec, 0x4d, 0x3e, 0xc2, 0x0f, 0xe3, 0x4a, 0x51, 0x55, 0x9e, 0xe9, 0xfc, 0x6f, 0x00,
+	0xd2, 0xa0, 0xc6, 0x62, 0x31, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -141,6 +160,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OrderList) > 0 {
+		for iNdEx := len(m.OrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BadDebtList) > 0 {
+		for iNdEx := len(m.BadDebtList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BadDebtList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.AddressWhitelist) > 0 {
 		for iNdEx := len(m.AddressWhitelist) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AddressWhitelist[iNdEx])
@@ -228,6 +275,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BadDebtList) > 0 {
+		for _, e := range m.BadDebtList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrderList) > 0 {
+		for _, e := range m.OrderList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -399,6 +458,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.AddressWhitelist = append(m.AddressWhitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebtList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BadDebtList = append(m.BadDebtList, BadDebt{})
+			if err := m.BadDebtList[len(m.BadDebtList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderList = append(m.OrderList, MarginOrder{})
+			if err := m.OrderList[len(m.OrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
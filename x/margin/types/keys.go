@@ -1,6 +1,11 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName defines the module name
@@ -17,6 +22,9 @@ const (
 
 	// ParamsKey is the prefix for parameters of margin module
 	ParamsKey = "margin_params"
+
+	// InsuranceFundName defines the module account holding the insurance fund
+	InsuranceFundName = "margin_insurance_fund"
 )
 
 const MaxPageLimit = 100
@@ -30,6 +38,16 @@ var (
 	OpenMTPCountPrefix = []byte{0x04}
 	WhitelistPrefix    = []byte{0x05}
 	SQBeginBlockPrefix = []byte{0x06}
+
+	LiquidationQueuePrefix = []byte{0x07}
+	BadDebtPrefix          = []byte{0x08}
+	BadDebtCountPrefix     = []byte{0x09}
+	OrderPrefix            = []byte{0x0a}
+	OrderCountPrefix       = []byte{0x0b}
+	TakeProfitQueuePrefix  = []byte{0x0c}
+	StopLossQueuePrefix    = []byte{0x0d}
+	OrderPriceQueuePrefix  = []byte{0x0e}
+	OrderExpiryQueuePrefix = []byte{0x0f}
 )
 
 func KeyPrefix(p string) []byte {
@@ -59,3 +77,39 @@ func GetMTPKey(address string, id uint64) []byte {
 func GetMTPPrefixForAddress(address string) []byte {
 	return append(MTPPrefix, []byte(address)...)
 }
+
+// GetPriceQueuePrefix returns the prefix of a price queue of a pool and position direction
+func GetPriceQueuePrefix(queuePrefix []byte, ammPoolId uint64, position Position) []byte {
+	prefix := append([]byte{}, queuePrefix...)
+	return append(append(prefix, GetUint64Bytes(ammPoolId)...), byte(position))
+}
+
+// GetPriceQueueKey returns the price queue key of the mtp or order stored at key, ordered by price
+func GetPriceQueueKey(queuePrefix []byte, ammPoolId uint64, position Position, price sdk.Dec, key []byte) []byte {
+	if price.GT(sdk.MaxSortableDec) {
+		price = sdk.MaxSortableDec
+	}
+	queueKey := append(GetPriceQueuePrefix(queuePrefix, ammPoolId, position), sdk.SortableDecBytes(price)...)
+	return append(queueKey, key...)
+}
+
+// GetBadDebtKey returns the bad debt key of id
+func GetBadDebtKey(id uint64) []byte {
+	return append(BadDebtPrefix, GetUint64Bytes(id)...)
+}
+
+// GetOrderKey returns the margin order key of an address and id
+func GetOrderKey(address string, id uint64) []byte {
+	return append(OrderPrefix, append([]byte(address), GetUint64Bytes(id)...)...)
+}
+
+// GetOrderPrefixForAddress returns the margin order prefix of an address
+func GetOrderPrefixForAddress(address string) []byte {
+	return append(OrderPrefix, []byte(address)...)
+}
+
+// GetOrderExpiryQueueKey returns the expiry queue key of a margin order, ordered by expiry
+func GetOrderExpiryQueueKey(expiry time.Time, address string, id uint64) []byte {
+	key := append(append([]byte{}, OrderExpiryQueuePrefix...), sdk.FormatTimeBytes(expiry)...)
+	return append(key, GetOrderKey(address, id)...)
+}
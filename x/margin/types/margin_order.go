@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewMarginOrder(msg *MsgPlaceMarginOrder) MarginOrder {
+	return MarginOrder{
+		Creator:         msg.Creator,
+		Collateral:      sdk.NewCoin(msg.CollateralAsset, msg.CollateralAmount),
+		BorrowAsset:     msg.BorrowAsset,
+		Position:        msg.Position,
+		Leverage:        msg.Leverage,
+		TakeProfitPrice: msg.TakeProfitPrice,
+		StopLossPrice:   msg.StopLossPrice,
+		TriggerPrice:    msg.TriggerPrice,
+		Expiry:          msg.Expiry,
+	}
+}
+
+// IsExpired returns true when the order expiry is set and before blockTime
+func (o MarginOrder) IsExpired(blockTime time.Time) bool {
+	return o.Expiry != nil && blockTime.After(*o.Expiry)
+}
+
+// GetMsgOpen returns the open msg executed when the order is triggered
+func (o MarginOrder) GetMsgOpen() *MsgOpen {
+	return NewMsgOpen(o.Creator, o.Collateral.Denom, o.Collateral.Amount, o.BorrowAsset, o.Position, o.Leverage, o.TakeProfitPrice, o.StopLossPrice)
+}
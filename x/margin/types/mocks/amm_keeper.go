@@ -326,6 +326,49 @@ func (_c *AmmKeeper_GetPoolSnapshotOrSet_Call) RunAndReturn(run func(types.Conte
 	return _c
 }
 
+// IsPoolPaused provides a mock function with given fields: ctx, poolId
+func (_m *AmmKeeper) IsPoolPaused(ctx types.Context, poolId uint64) bool {
+	ret := _m.Called(ctx, poolId)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, uint64) bool); ok {
+		r0 = rf(ctx, poolId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// AmmKeeper_IsPoolPaused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsPoolPaused'
+type AmmKeeper_IsPoolPaused_Call struct {
+	*mock.Call
+}
+
+// IsPoolPaused is a helper method to define mock.On call
+//   - ctx types.Context
+//   - poolId uint64
+func (_e *AmmKeeper_Expecter) IsPoolPaused(ctx interface{}, poolId interface{}) *AmmKeeper_IsPoolPaused_Call {
+	return &AmmKeeper_IsPoolPaused_Call{Call: _e.mock.On("IsPoolPaused", ctx, poolId)}
+}
+
+func (_c *AmmKeeper_IsPoolPaused_Call) Run(run func(ctx types.Context, poolId uint64)) *AmmKeeper_IsPoolPaused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *AmmKeeper_IsPoolPaused_Call) Return(_a0 bool) *AmmKeeper_IsPoolPaused_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AmmKeeper_IsPoolPaused_Call) RunAndReturn(run func(types.Context, uint64) bool) *AmmKeeper_IsPoolPaused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IterateLiquidityPools provides a mock function with given fields: _a0, _a1
 func (_m *AmmKeeper) IterateLiquidityPools(_a0 types.Context, _a1 func(ammtypes.Pool) bool) {
 	_m.Called(_a0, _a1)
@@ -110,6 +110,50 @@ func (_c *OpenChecker_CheckMaxOpenPositions_Call) RunAndReturn(run func(types.Co
 	return _c
 }
 
+// CheckOpenInterestCap provides a mock function with given fields: ctx, poolId, position
+func (_m *OpenChecker) CheckOpenInterestCap(ctx types.Context, poolId uint64, position margintypes.Position) error {
+	ret := _m.Called(ctx, poolId, position)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, uint64, margintypes.Position) error); ok {
+		r0 = rf(ctx, poolId, position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OpenChecker_CheckOpenInterestCap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckOpenInterestCap'
+type OpenChecker_CheckOpenInterestCap_Call struct {
+	*mock.Call
+}
+
+// CheckOpenInterestCap is a helper method to define mock.On call
+//   - ctx types.Context
+//   - poolId uint64
+//   - position margintypes.Position
+func (_e *OpenChecker_Expecter) CheckOpenInterestCap(ctx interface{}, poolId interface{}, position interface{}) *OpenChecker_CheckOpenInterestCap_Call {
+	return &OpenChecker_CheckOpenInterestCap_Call{Call: _e.mock.On("CheckOpenInterestCap", ctx, poolId, position)}
+}
+
+func (_c *OpenChecker_CheckOpenInterestCap_Call) Run(run func(ctx types.Context, poolId uint64, position margintypes.Position)) *OpenChecker_CheckOpenInterestCap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.Context), args[1].(uint64), args[2].(margintypes.Position))
+	})
+	return _c
+}
+
+func (_c *OpenChecker_CheckOpenInterestCap_Call) Return(_a0 error) *OpenChecker_CheckOpenInterestCap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OpenChecker_CheckOpenInterestCap_Call) RunAndReturn(run func(types.Context, uint64, margintypes.Position) error) *OpenChecker_CheckOpenInterestCap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckPoolHealth provides a mock function with given fields: ctx, poolId
 func (_m *OpenChecker) CheckPoolHealth(ctx types.Context, poolId uint64) error {
 	ret := _m.Called(ctx, poolId)
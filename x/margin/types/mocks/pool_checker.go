@@ -205,6 +205,49 @@ func (_c *PoolChecker_IsPoolEnabled_Call) RunAndReturn(run func(types.Context, u
 	return _c
 }
 
+// IsPoolPaused provides a mock function with given fields: ctx, poolId
+func (_m *PoolChecker) IsPoolPaused(ctx types.Context, poolId uint64) bool {
+	ret := _m.Called(ctx, poolId)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, uint64) bool); ok {
+		r0 = rf(ctx, poolId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// PoolChecker_IsPoolPaused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsPoolPaused'
+type PoolChecker_IsPoolPaused_Call struct {
+	*mock.Call
+}
+
+// IsPoolPaused is a helper method to define mock.On call
+//   - ctx types.Context
+//   - poolId uint64
+func (_e *PoolChecker_Expecter) IsPoolPaused(ctx interface{}, poolId interface{}) *PoolChecker_IsPoolPaused_Call {
+	return &PoolChecker_IsPoolPaused_Call{Call: _e.mock.On("IsPoolPaused", ctx, poolId)}
+}
+
+func (_c *PoolChecker_IsPoolPaused_Call) Run(run func(ctx types.Context, poolId uint64)) *PoolChecker_IsPoolPaused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *PoolChecker_IsPoolPaused_Call) Return(_a0 bool) *PoolChecker_IsPoolPaused_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PoolChecker_IsPoolPaused_Call) RunAndReturn(run func(types.Context, uint64) bool) *PoolChecker_IsPoolPaused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPoolChecker creates a new instance of PoolChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPoolChecker(t interface {
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -12,6 +14,14 @@ const (
 	TypeMsgWhitelist    = "whitelist"
 	TypeMsgUpdatePools  = "update_pools"
 	TypeMsgDewhitelist  = "dewhitelist"
+
+	TypeMsgUpdateStopLoss   = "update_stop_loss"
+	TypeMsgAddCollateral    = "add_collateral"
+	TypeMsgRemoveCollateral = "remove_collateral"
+	TypeMsgLiquidate        = "liquidate"
+
+	TypeMsgPlaceMarginOrder  = "place_margin_order"
+	TypeMsgCancelMarginOrder = "cancel_margin_order"
 )
 
 var (
@@ -21,12 +31,19 @@ var (
 	_ sdk.Msg = &MsgWhitelist{}
 	_ sdk.Msg = &MsgUpdatePools{}
 	_ sdk.Msg = &MsgDewhitelist{}
+	_ sdk.Msg = &MsgUpdateStopLoss{}
+	_ sdk.Msg = &MsgAddCollateral{}
+	_ sdk.Msg = &MsgRemoveCollateral{}
+	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgPlaceMarginOrder{}
+	_ sdk.Msg = &MsgCancelMarginOrder{}
 )
 
-func NewMsgClose(creator string, id uint64) *MsgClose {
+func NewMsgClose(creator string, id uint64, amount sdk.Int) *MsgClose {
 	return &MsgClose{
 		Creator: creator,
 		Id:      id,
+		Amount:  amount,
 	}
 }
 
@@ -56,10 +73,18 @@ func (msg *MsgClose) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if !msg.Amount.IsNil() && msg.Amount.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidCloseSize, "close amount is negative (%s)", msg.Amount)
+	}
 	return nil
 }
 
-func NewMsgOpen(creator string, collateralAsset string, collateralAmount sdk.Int, borrowAsset string, position Position, leverage sdk.Dec, takeProfitPrice sdk.Dec) *MsgOpen {
+// IsPartial returns true if the msg unwinds only part of the position custody
+func (msg *MsgClose) IsPartial() bool {
+	return !msg.Amount.IsNil() && msg.Amount.IsPositive()
+}
+
+func NewMsgOpen(creator string, collateralAsset string, collateralAmount sdk.Int, borrowAsset string, position Position, leverage sdk.Dec, takeProfitPrice sdk.Dec, stopLossPrice sdk.Dec) *MsgOpen {
 	return &MsgOpen{
 		Creator:          creator,
 		CollateralAsset:  collateralAsset,
@@ -68,6 +93,7 @@ func NewMsgOpen(creator string, collateralAsset string, collateralAmount sdk.Int
 		Position:         position,
 		Leverage:         leverage,
 		TakeProfitPrice:  takeProfitPrice,
+		StopLossPrice:    stopLossPrice,
 	}
 }
 
@@ -97,6 +123,9 @@ func (msg *MsgOpen) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if !msg.StopLossPrice.IsNil() && msg.StopLossPrice.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidStopLossPrice, "stop loss price is negative (%s)", msg.StopLossPrice)
+	}
 	return nil
 }
 
@@ -244,3 +273,259 @@ func (msg *MsgDewhitelist) ValidateBasic() error {
 	}
 	return nil
 }
+
+func NewMsgUpdateStopLoss(creator string, id uint64, stopLossPrice sdk.Dec) *MsgUpdateStopLoss {
+	return &MsgUpdateStopLoss{
+		Creator:       creator,
+		Id:            id,
+		StopLossPrice: stopLossPrice,
+	}
+}
+
+func (msg *MsgUpdateStopLoss) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateStopLoss) Type() string {
+	return TypeMsgUpdateStopLoss
+}
+
+func (msg *MsgUpdateStopLoss) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateStopLoss) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateStopLoss) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.StopLossPrice.IsNil() || msg.StopLossPrice.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidStopLossPrice, "invalid stop loss price (%s)", msg.StopLossPrice)
+	}
+	return nil
+}
+
+func NewMsgAddCollateral(creator string, id uint64, collateral sdk.Coin) *MsgAddCollateral {
+	return &MsgAddCollateral{
+		Creator:    creator,
+		Id:         id,
+		Collateral: collateral,
+	}
+}
+
+func (msg *MsgAddCollateral) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddCollateral) Type() string {
+	return TypeMsgAddCollateral
+}
+
+func (msg *MsgAddCollateral) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAddCollateral) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddCollateral) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.Collateral.Validate(); err != nil || !msg.Collateral.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid collateral (%s)", msg.Collateral)
+	}
+	return nil
+}
+
+func NewMsgRemoveCollateral(creator string, id uint64, collateral sdk.Coin) *MsgRemoveCollateral {
+	return &MsgRemoveCollateral{
+		Creator:    creator,
+		Id:         id,
+		Collateral: collateral,
+	}
+}
+
+func (msg *MsgRemoveCollateral) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveCollateral) Type() string {
+	return TypeMsgRemoveCollateral
+}
+
+func (msg *MsgRemoveCollateral) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveCollateral) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveCollateral) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.Collateral.Validate(); err != nil || !msg.Collateral.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid collateral (%s)", msg.Collateral)
+	}
+	return nil
+}
+
+func NewMsgLiquidate(liquidator string, address string, id uint64) *MsgLiquidate {
+	return &MsgLiquidate{
+		Liquidator: liquidator,
+		Address:    address,
+		Id:         id,
+	}
+}
+
+func (msg *MsgLiquidate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgLiquidate) Type() string {
+	return TypeMsgLiquidate
+}
+
+func (msg *MsgLiquidate) GetSigners() []sdk.AccAddress {
+	liquidator, err := sdk.AccAddressFromBech32(msg.Liquidator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{liquidator}
+}
+
+func (msg *MsgLiquidate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgLiquidate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Liquidator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid liquidator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid position address (%s)", err)
+	}
+	return nil
+}
+
+func NewMsgPlaceMarginOrder(creator string, collateralAsset string, collateralAmount sdk.Int, borrowAsset string, position Position, leverage sdk.Dec, takeProfitPrice sdk.Dec, stopLossPrice sdk.Dec, triggerPrice sdk.Dec, expiry *time.Time) *MsgPlaceMarginOrder {
+	return &MsgPlaceMarginOrder{
+		Creator:          creator,
+		CollateralAsset:  collateralAsset,
+		CollateralAmount: collateralAmount,
+		BorrowAsset:      borrowAsset,
+		Position:         position,
+		Leverage:         leverage,
+		TakeProfitPrice:  takeProfitPrice,
+		StopLossPrice:    stopLossPrice,
+		TriggerPrice:     triggerPrice,
+		Expiry:           expiry,
+	}
+}
+
+func (msg *MsgPlaceMarginOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlaceMarginOrder) Type() string {
+	return TypeMsgPlaceMarginOrder
+}
+
+func (msg *MsgPlaceMarginOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPlaceMarginOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPlaceMarginOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.CollateralAmount.IsNil() || !msg.CollateralAmount.IsPositive() {
+		return sdkerrors.Wrapf(ErrAmountTooLow, "collateral amount must be positive (%s)", msg.CollateralAmount)
+	}
+	if msg.Position != Position_LONG && msg.Position != Position_SHORT {
+		return sdkerrors.Wrap(ErrInvalidPosition, msg.Position.String())
+	}
+	if msg.TriggerPrice.IsNil() || !msg.TriggerPrice.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidTriggerPrice, "trigger price must be positive (%s)", msg.TriggerPrice)
+	}
+	if msg.Expiry == nil {
+		return sdkerrors.Wrap(ErrInvalidOrderExpiry, "expiry must be set")
+	}
+	if !msg.StopLossPrice.IsNil() && msg.StopLossPrice.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidStopLossPrice, "stop loss price is negative (%s)", msg.StopLossPrice)
+	}
+	return nil
+}
+
+func NewMsgCancelMarginOrder(creator string, id uint64) *MsgCancelMarginOrder {
+	return &MsgCancelMarginOrder{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgCancelMarginOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelMarginOrder) Type() string {
+	return TypeMsgCancelMarginOrder
+}
+
+func (msg *MsgCancelMarginOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelMarginOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelMarginOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
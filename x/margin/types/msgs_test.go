@@ -2,7 +2,9 @@ package types
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/elys-network/elys/testutil/sample"
 	"github.com/stretchr/testify/require"
@@ -25,6 +27,19 @@ func TestMsgClosep_ValidateBasic(t *testing.T) {
 			msg: MsgClose{
 				Creator: sample.AccAddress(),
 			},
+		}, {
+			name: "negative close amount",
+			msg: MsgClose{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.NewInt(-1),
+			},
+			err: ErrInvalidCloseSize,
+		}, {
+			name: "partial close amount",
+			msg: MsgClose{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.NewInt(100),
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -193,3 +208,239 @@ func TestMsgDewhitelist_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateStopLoss_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateStopLoss
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateStopLoss{
+				Creator:       "invalid_address",
+				StopLossPrice: sdk.OneDec(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "negative price",
+			msg: MsgUpdateStopLoss{
+				Creator:       sample.AccAddress(),
+				StopLossPrice: sdk.NewDec(-1),
+			},
+			err: ErrInvalidStopLossPrice,
+		}, {
+			name: "valid",
+			msg: MsgUpdateStopLoss{
+				Creator:       sample.AccAddress(),
+				StopLossPrice: sdk.OneDec(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAddCollateral_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAddCollateral
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAddCollateral{
+				Creator:    "invalid_address",
+				Collateral: sdk.NewCoin("uusdc", sdk.NewInt(100)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero collateral",
+			msg: MsgAddCollateral{
+				Creator:    sample.AccAddress(),
+				Collateral: sdk.NewCoin("uusdc", sdk.ZeroInt()),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid",
+			msg: MsgAddCollateral{
+				Creator:    sample.AccAddress(),
+				Collateral: sdk.NewCoin("uusdc", sdk.NewInt(100)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveCollateral_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRemoveCollateral
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRemoveCollateral{
+				Creator:    "invalid_address",
+				Collateral: sdk.NewCoin("uusdc", sdk.NewInt(100)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing collateral",
+			msg: MsgRemoveCollateral{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid",
+			msg: MsgRemoveCollateral{
+				Creator:    sample.AccAddress(),
+				Collateral: sdk.NewCoin("uusdc", sdk.NewInt(100)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgLiquidate_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgLiquidate
+		err  error
+	}{
+		{
+			name: "invalid liquidator",
+			msg: MsgLiquidate{
+				Liquidator: "invalid_address",
+				Address:    sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid position address",
+			msg: MsgLiquidate{
+				Liquidator: sample.AccAddress(),
+				Address:    "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg: MsgLiquidate{
+				Liquidator: sample.AccAddress(),
+				Address:    sample.AccAddress(),
+				Id:         1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgPlaceMarginOrder_ValidateBasic(t *testing.T) {
+	expiry := time.Unix(1000, 0)
+	tests := []struct {
+		name string
+		msg  MsgPlaceMarginOrder
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgPlaceMarginOrder{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero collateral",
+			msg: MsgPlaceMarginOrder{
+				Creator:          sample.AccAddress(),
+				CollateralAmount: sdk.ZeroInt(),
+				Position:         Position_LONG,
+				TriggerPrice:     sdk.OneDec(),
+				StopLossPrice:    sdk.ZeroDec(),
+			},
+			err: ErrAmountTooLow,
+		}, {
+			name: "unspecified position",
+			msg: MsgPlaceMarginOrder{
+				Creator:          sample.AccAddress(),
+				CollateralAmount: sdk.NewInt(100),
+				TriggerPrice:     sdk.OneDec(),
+				StopLossPrice:    sdk.ZeroDec(),
+			},
+			err: ErrInvalidPosition,
+		}, {
+			name: "zero trigger price",
+			msg: MsgPlaceMarginOrder{
+				Creator:          sample.AccAddress(),
+				CollateralAmount: sdk.NewInt(100),
+				Position:         Position_SHORT,
+				TriggerPrice:     sdk.ZeroDec(),
+				StopLossPrice:    sdk.ZeroDec(),
+			},
+			err: ErrInvalidTriggerPrice,
+		}, {
+			name: "missing expiry",
+			msg: MsgPlaceMarginOrder{
+				Creator:          sample.AccAddress(),
+				CollateralAmount: sdk.NewInt(100),
+				Position:         Position_LONG,
+				TriggerPrice:     sdk.OneDec(),
+				StopLossPrice:    sdk.ZeroDec(),
+			},
+			err: ErrInvalidOrderExpiry,
+		}, {
+			name: "valid",
+			msg: MsgPlaceMarginOrder{
+				Creator:          sample.AccAddress(),
+				CollateralAmount: sdk.NewInt(100),
+				Position:         Position_LONG,
+				TriggerPrice:     sdk.OneDec(),
+				StopLossPrice:    sdk.ZeroDec(),
+				Expiry:           &expiry,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
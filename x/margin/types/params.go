@@ -31,6 +31,19 @@ var (
 	KeyIncrementalInterestPaymentEnabled        = []byte("IncrementalInterestPaymentEnabled")
 	KeyWhitelistingEnabled                      = []byte("WhitelistingEnabled")
 	KeyInvariantCheckEpoch                      = []byte("InvariantCheckEpoch")
+	KeyCollateralRemovalHealthMargin            = []byte("CollateralRemovalHealthMargin")
+	KeyLiquidatorBonus                          = []byte("LiquidatorBonus")
+	KeyMaxLiquidationsPerBlock                  = []byte("MaxLiquidationsPerBlock")
+	KeyInsuranceFundInterestShare               = []byte("InsuranceFundInterestShare")
+	KeyInsuranceFundLiquidationShare            = []byte("InsuranceFundLiquidationShare")
+	KeyBadDebtPolicy                            = []byte("BadDebtPolicy")
+	KeyMaxLongOpenInterestRatio                 = []byte("MaxLongOpenInterestRatio")
+	KeyMaxShortOpenInterestRatio                = []byte("MaxShortOpenInterestRatio")
+	KeyFundingRateMax                           = []byte("FundingRateMax")
+	KeyMaxTriggersPerBlock                      = []byte("MaxTriggersPerBlock")
+	KeyMaxOrderExecutionsPerBlock               = []byte("MaxOrderExecutionsPerBlock")
+	KeyMinOrderCollateral                       = []byte("MinOrderCollateral")
+	KeyMaxOrderDuration                         = []byte("MaxOrderDuration")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -60,6 +73,19 @@ func NewParams() Params {
 		IncrementalInterestPaymentEnabled:        true,
 		WhitelistingEnabled:                      false,
 		InvariantCheckEpoch:                      epochtypes.DayEpochID,
+		CollateralRemovalHealthMargin:            sdk.NewDecWithPrec(1, 1),
+		LiquidatorBonus:                          sdk.NewDecWithPrec(5, 2),
+		MaxLiquidationsPerBlock:                  (int64)(100),
+		InsuranceFundInterestShare:               sdk.NewDecWithPrec(1, 1),
+		InsuranceFundLiquidationShare:            sdk.NewDecWithPrec(2, 2),
+		BadDebtPolicy:                            BadDebtPolicy_SOCIALISE_TO_POOL,
+		MaxLongOpenInterestRatio:                 sdk.NewDecWithPrec(5, 1),
+		MaxShortOpenInterestRatio:                sdk.NewDecWithPrec(5, 1),
+		FundingRateMax:                           sdk.NewDecWithPrec(1, 3),
+		MaxTriggersPerBlock:                      (int64)(100),
+		MaxOrderExecutionsPerBlock:               (int64)(100),
+		MinOrderCollateral:                       sdk.NewInt(1000000),
+		MaxOrderDuration:                         (int64)(2592000), // 30 days
 	}
 }
 
@@ -90,6 +116,19 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyIncrementalInterestPaymentEnabled, &p.IncrementalInterestPaymentEnabled, validateIncrementalInterestPaymentEnabled),
 		paramtypes.NewParamSetPair(KeyWhitelistingEnabled, &p.WhitelistingEnabled, validateWhitelistingEnabled),
 		paramtypes.NewParamSetPair(KeyInvariantCheckEpoch, &p.InvariantCheckEpoch, validateInvariantCheckEpoch),
+		paramtypes.NewParamSetPair(KeyCollateralRemovalHealthMargin, &p.CollateralRemovalHealthMargin, validateCollateralRemovalHealthMargin),
+		paramtypes.NewParamSetPair(KeyLiquidatorBonus, &p.LiquidatorBonus, validateLiquidatorBonus),
+		paramtypes.NewParamSetPair(KeyMaxLiquidationsPerBlock, &p.MaxLiquidationsPerBlock, validateMaxLiquidationsPerBlock),
+		paramtypes.NewParamSetPair(KeyInsuranceFundInterestShare, &p.InsuranceFundInterestShare, validateInsuranceFundInterestShare),
+		paramtypes.NewParamSetPair(KeyInsuranceFundLiquidationShare, &p.InsuranceFundLiquidationShare, validateInsuranceFundLiquidationShare),
+		paramtypes.NewParamSetPair(KeyBadDebtPolicy, &p.BadDebtPolicy, validateBadDebtPolicy),
+		paramtypes.NewParamSetPair(KeyMaxLongOpenInterestRatio, &p.MaxLongOpenInterestRatio, validateMaxOpenInterestRatio),
+		paramtypes.NewParamSetPair(KeyMaxShortOpenInterestRatio, &p.MaxShortOpenInterestRatio, validateMaxOpenInterestRatio),
+		paramtypes.NewParamSetPair(KeyFundingRateMax, &p.FundingRateMax, validateFundingRateMax),
+		paramtypes.NewParamSetPair(KeyMaxTriggersPerBlock, &p.MaxTriggersPerBlock, validateMaxTriggersPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxOrderExecutionsPerBlock, &p.MaxOrderExecutionsPerBlock, validateMaxOrderExecutionsPerBlock),
+		paramtypes.NewParamSetPair(KeyMinOrderCollateral, &p.MinOrderCollateral, validateMinOrderCollateral),
+		paramtypes.NewParamSetPair(KeyMaxOrderDuration, &p.MaxOrderDuration, validateMaxOrderDuration),
 	}
 }
 
@@ -152,6 +191,45 @@ func (p Params) Validate() error {
 	if err := validateInvariantCheckEpoch(p.InvariantCheckEpoch); err != nil {
 		return err
 	}
+	if err := validateCollateralRemovalHealthMargin(p.CollateralRemovalHealthMargin); err != nil {
+		return err
+	}
+	if err := validateLiquidatorBonus(p.LiquidatorBonus); err != nil {
+		return err
+	}
+	if err := validateMaxLiquidationsPerBlock(p.MaxLiquidationsPerBlock); err != nil {
+		return err
+	}
+	if err := validateInsuranceFundInterestShare(p.InsuranceFundInterestShare); err != nil {
+		return err
+	}
+	if err := validateInsuranceFundLiquidationShare(p.InsuranceFundLiquidationShare); err != nil {
+		return err
+	}
+	if err := validateBadDebtPolicy(p.BadDebtPolicy); err != nil {
+		return err
+	}
+	if err := validateMaxOpenInterestRatio(p.MaxLongOpenInterestRatio); err != nil {
+		return err
+	}
+	if err := validateMaxOpenInterestRatio(p.MaxShortOpenInterestRatio); err != nil {
+		return err
+	}
+	if err := validateFundingRateMax(p.FundingRateMax); err != nil {
+		return err
+	}
+	if err := validateMaxTriggersPerBlock(p.MaxTriggersPerBlock); err != nil {
+		return err
+	}
+	if err := validateMaxOrderExecutionsPerBlock(p.MaxOrderExecutionsPerBlock); err != nil {
+		return err
+	}
+	if err := validateMinOrderCollateral(p.MinOrderCollateral); err != nil {
+		return err
+	}
+	if err := validateMaxOrderDuration(p.MaxOrderDuration); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -422,3 +500,180 @@ func validateInvariantCheckEpoch(i interface{}) error {
 
 	return nil
 }
+
+func validateCollateralRemovalHealthMargin(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("collateral removal health margin must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("collateral removal health margin must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateLiquidatorBonus(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquidator bonus must be not nil")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquidator bonus must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxLiquidationsPerBlock(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max liquidations per block must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxTriggersPerBlock(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max triggers per block must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxOrderExecutionsPerBlock(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max order executions per block must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMinOrderCollateral(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("min order collateral must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min order collateral must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxOrderDuration(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max order duration must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateInsuranceFundInterestShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("insurance fund interest share must be not nil")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("insurance fund interest share must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateInsuranceFundLiquidationShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("insurance fund liquidation share must be not nil")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("insurance fund liquidation share must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateBadDebtPolicy(i interface{}) error {
+	v, ok := i.(BadDebtPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := BadDebtPolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid bad debt policy: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxOpenInterestRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max open interest ratio must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max open interest ratio must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateFundingRateMax(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("funding rate max must be not nil")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("funding rate max must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
@@ -45,6 +45,32 @@ type Params struct {
 	IncrementalInterestPaymentEnabled        bool                                   `protobuf:"varint,17,opt,name=incremental_interest_payment_enabled,json=incrementalInterestPaymentEnabled,proto3" json:"incremental_interest_payment_enabled,omitempty"`
 	WhitelistingEnabled                      bool                                   `protobuf:"varint,18,opt,name=whitelisting_enabled,json=whitelistingEnabled,proto3" json:"whitelisting_enabled,omitempty"`
 	InvariantCheckEpoch                      string                                 `protobuf:"bytes,19,opt,name=invariant_check_epoch,json=invariantCheckEpoch,proto3" json:"invariant_check_epoch,omitempty"`
+	// health margin over the safety factor an mtp must keep after removing collateral
+	CollateralRemovalHealthMargin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=collateral_removal_health_margin,json=collateralRemovalHealthMargin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"collateral_removal_health_margin"`
+	// share of the collateral returned on liquidation that goes to the liquidator
+	LiquidatorBonus github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=liquidator_bonus,json=liquidatorBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidator_bonus"`
+	// maximum number of positions visited by the liquidation queue per pool and block
+	MaxLiquidationsPerBlock int64 `protobuf:"varint,22,opt,name=max_liquidations_per_block,json=maxLiquidationsPerBlock,proto3" json:"max_liquidations_per_block,omitempty"`
+	// share of each incremental interest payment that goes to the insurance fund
+	InsuranceFundInterestShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=insurance_fund_interest_share,json=insuranceFundInterestShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"insurance_fund_interest_share"`
+	// share of the collateral returned on liquidation or force close that goes to the insurance fund
+	InsuranceFundLiquidationShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,24,opt,name=insurance_fund_liquidation_share,json=insuranceFundLiquidationShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"insurance_fund_liquidation_share"`
+	// how a shortfall the insurance fund can't cover is handled
+	BadDebtPolicy BadDebtPolicy `protobuf:"varint,25,opt,name=bad_debt_policy,json=badDebtPolicy,proto3,enum=elys.margin.BadDebtPolicy" json:"bad_debt_policy,omitempty"`
+	// cap on the custody of the longs of a pool as a fraction of its balance, zero disables it
+	MaxLongOpenInterestRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,26,opt,name=max_long_open_interest_ratio,json=maxLongOpenInterestRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_long_open_interest_ratio"`
+	// cap on the custody of the shorts of a pool as a fraction of its balance, zero disables it
+	MaxShortOpenInterestRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,27,opt,name=max_short_open_interest_ratio,json=maxShortOpenInterestRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_short_open_interest_ratio"`
+	// funding rate per epoch when all the liabilities of a pool are on one side, zero disables funding
+	FundingRateMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,28,opt,name=funding_rate_max,json=fundingRateMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_rate_max"`
+	// maximum number of take profits and stop losses executed per pool and block
+	MaxTriggersPerBlock int64 `protobuf:"varint,29,opt,name=max_triggers_per_block,json=maxTriggersPerBlock,proto3" json:"max_triggers_per_block,omitempty"`
+	// maximum number of margin orders executed or expired per block
+	MaxOrderExecutionsPerBlock int64 `protobuf:"varint,30,opt,name=max_order_executions_per_block,json=maxOrderExecutionsPerBlock,proto3" json:"max_order_executions_per_block,omitempty"`
+	// minimum collateral of a margin order, in base currency
+	MinOrderCollateral github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,31,opt,name=min_order_collateral,json=minOrderCollateral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_order_collateral"`
+	// maximum time in seconds between the placement of a margin order and its expiry
+	MaxOrderDuration int64 `protobuf:"varint,32,opt,name=max_order_duration,json=maxOrderDuration,proto3" json:"max_order_duration,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -128,6 +154,41 @@ func (m *Params) GetInvariantCheckEpoch() string {
 	return ""
 }
 
+func (m *Params) GetMaxLiquidationsPerBlock() int64 {
+	if m != nil {
+		return m.MaxLiquidationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetBadDebtPolicy() BadDebtPolicy {
+	if m != nil {
+		return m.BadDebtPolicy
+	}
+	return BadDebtPolicy_SOCIALISE_TO_POOL
+}
+
+func (m *Params) GetMaxTriggersPerBlock() int64 {
+	if m != nil {
+		return m.MaxTriggersPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOrderExecutionsPerBlock() int64 {
+	if m != nil {
+		return m.MaxOrderExecutionsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOrderDuration() int64 {
+	if m != nil {
+		return m.MaxOrderDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "elys.margin.Params")
 }
@@ -135,49 +196,70 @@ func init() {
 func init() { proto.RegisterFile("elys/margin/params.proto", fileDescriptor_f427d3667a99d828) }
 
 var fileDescriptor_f427d3667a99d828 = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x5f, 0x4f, 0x13, 0x4b,
-	0x18, 0xc6, 0xbb, 0x07, 0x0e, 0x07, 0xa6, 0xe5, 0x00, 0x53, 0xd0, 0x89, 0x26, 0x05, 0x8c, 0x9a,
-	0x1a, 0xa1, 0x8d, 0x7a, 0x61, 0xe2, 0x9d, 0xfc, 0x33, 0x24, 0x12, 0x4a, 0xf5, 0xc2, 0x10, 0xe3,
-	0x64, 0xd8, 0x7d, 0xbb, 0x3b, 0x61, 0x77, 0x66, 0x99, 0x99, 0x42, 0xfb, 0x25, 0x8c, 0x97, 0x5e,
-	0xfa, 0x71, 0xb8, 0x31, 0xe1, 0xd2, 0x78, 0x41, 0x0c, 0x7c, 0x11, 0xb3, 0xb3, 0xbb, 0xa5, 0x48,
-	0xc0, 0x64, 0xf5, 0xaa, 0xdd, 0xf7, 0x9d, 0xf9, 0x3d, 0xcf, 0x33, 0x99, 0xc9, 0x8b, 0x08, 0x84,
-	0x7d, 0xdd, 0x8c, 0x98, 0xf2, 0xb9, 0x68, 0xc6, 0x4c, 0xb1, 0x48, 0x37, 0x62, 0x25, 0x8d, 0xc4,
-	0xe5, 0xa4, 0xd3, 0x48, 0x3b, 0x77, 0x66, 0x7d, 0xe9, 0x4b, 0x5b, 0x6f, 0x26, 0xff, 0xd2, 0x25,
-	0xf7, 0xbe, 0x56, 0xd0, 0x58, 0xcb, 0xee, 0xc1, 0x3b, 0xa8, 0x12, 0xc2, 0x21, 0x28, 0xe6, 0x03,
-	0x8d, 0x58, 0x8f, 0x38, 0x0b, 0x4e, 0x7d, 0x62, 0xa5, 0x71, 0x7c, 0x3a, 0x5f, 0xfa, 0x7e, 0x3a,
-	0xff, 0xd0, 0xe7, 0x26, 0xe8, 0xee, 0x35, 0x5c, 0x19, 0x35, 0x5d, 0xa9, 0x23, 0xa9, 0xb3, 0x9f,
-	0x65, 0xed, 0xed, 0x37, 0x4d, 0x3f, 0x06, 0xdd, 0x58, 0x03, 0xb7, 0x5d, 0xce, 0x19, 0x5b, 0xac,
-	0x87, 0x77, 0xd1, 0x0c, 0x17, 0x06, 0x14, 0x68, 0x43, 0x15, 0x33, 0x29, 0xf7, 0x9f, 0x42, 0xdc,
-	0xa9, 0x1c, 0xd4, 0x66, 0xe6, 0x1a, 0x36, 0x17, 0x64, 0xe4, 0x2f, 0xb0, 0xb9, 0xc0, 0x1e, 0xba,
-	0x75, 0x99, 0xcd, 0x85, 0xab, 0x80, 0x69, 0x20, 0xa3, 0x85, 0x04, 0x66, 0x87, 0x05, 0x36, 0x33,
-	0xd6, 0x55, 0x15, 0x0f, 0x32, 0x95, 0x7f, 0xff, 0x5c, 0x65, 0x2d, 0x63, 0xe1, 0xf7, 0x08, 0x07,
-	0xc0, 0x42, 0x13, 0x50, 0x9f, 0x71, 0x41, 0x3b, 0xcc, 0x35, 0x52, 0x91, 0xb1, 0x42, 0x0a, 0xd3,
-	0x29, 0xe9, 0x15, 0xe3, 0x62, 0xc3, 0x72, 0xf0, 0x22, 0xaa, 0x40, 0x2c, 0xdd, 0x80, 0x86, 0x20,
-	0x7c, 0x13, 0x90, 0xff, 0x16, 0x9c, 0xfa, 0x48, 0xbb, 0x6c, 0x6b, 0xaf, 0x6d, 0x09, 0x77, 0xd0,
-	0x6d, 0x05, 0x91, 0x3c, 0x64, 0x21, 0x3d, 0xe8, 0x42, 0x17, 0xa8, 0x09, 0x14, 0xe8, 0x40, 0x86,
-	0x1e, 0x19, 0x2f, 0xe4, 0x62, 0x2e, 0xc3, 0xed, 0x24, 0xb4, 0xb7, 0x39, 0x0c, 0x2f, 0x21, 0x1c,
-	0xb1, 0x1e, 0x95, 0x31, 0x08, 0x1a, 0x4b, 0xcd, 0x0d, 0x97, 0x42, 0x93, 0x09, 0x6b, 0x68, 0x3a,
-	0x62, 0xbd, 0xed, 0x18, 0x44, 0x2b, 0xaf, 0xe3, 0x0f, 0xa8, 0x1a, 0x4b, 0x19, 0xa6, 0xcb, 0x2f,
-	0x1c, 0xa1, 0x42, 0x8e, 0x66, 0x12, 0x54, 0xc2, 0xbf, 0x70, 0x13, 0xa1, 0xbb, 0x1d, 0xa9, 0x5c,
-	0xa0, 0x6e, 0x28, 0x35, 0xd0, 0x4e, 0x57, 0x78, 0x34, 0x06, 0xe5, 0x82, 0x30, 0xcc, 0x07, 0x52,
-	0x2e, 0xa4, 0x43, 0x2c, 0x72, 0x35, 0x21, 0x6e, 0x74, 0x85, 0xd7, 0x1a, 0xf0, 0xf0, 0x73, 0x44,
-	0xae, 0xc8, 0x31, 0xcf, 0x53, 0xa0, 0x35, 0xa9, 0x24, 0x5a, 0xed, 0xb9, 0xcb, 0x7b, 0x5f, 0xa6,
-	0x4d, 0xfc, 0xd1, 0x41, 0x4b, 0xf6, 0x76, 0x47, 0x09, 0x29, 0xa4, 0x83, 0x1b, 0x19, 0xb3, 0x7e,
-	0x52, 0xba, 0xe2, 0x7c, 0xb2, 0x90, 0xf3, 0xfa, 0x90, 0xc6, 0x66, 0x26, 0xd1, 0x4a, 0x15, 0x7e,
-	0x49, 0xf2, 0x0e, 0x3d, 0xfa, 0xbd, 0x9f, 0x3c, 0xda, 0xff, 0x36, 0xda, 0x83, 0x9b, 0xe1, 0x79,
-	0xd4, 0x6d, 0x54, 0xd6, 0x07, 0x34, 0x92, 0x1e, 0xef, 0x70, 0x50, 0x64, 0xaa, 0x50, 0x10, 0xa4,
-	0x0f, 0xb6, 0x32, 0x02, 0x7e, 0x83, 0x26, 0x35, 0xeb, 0x80, 0xe9, 0xe7, 0xaf, 0x6a, 0xba, 0x10,
-	0xb2, 0x92, 0x42, 0xb2, 0x17, 0xb5, 0x8d, 0xee, 0xdf, 0x98, 0x1f, 0x04, 0xdb, 0x0b, 0xc1, 0x23,
-	0x33, 0x0b, 0x4e, 0x7d, 0xbc, 0xbd, 0x78, 0x7d, 0xf4, 0xf5, 0x74, 0x21, 0x7e, 0x82, 0x66, 0x8f,
-	0x02, 0x6e, 0x20, 0xe4, 0xda, 0x70, 0xe1, 0x0f, 0x00, 0xd8, 0x02, 0xaa, 0xc3, 0xbd, 0x7c, 0xcb,
-	0x53, 0x34, 0xc7, 0xc5, 0x21, 0x53, 0x9c, 0x09, 0x43, 0xdd, 0x00, 0xdc, 0x7d, 0x6a, 0x5f, 0x34,
-	0xa9, 0xda, 0xf3, 0xae, 0x0e, 0x9a, 0xab, 0x49, 0x6f, 0x3d, 0x69, 0xbd, 0x18, 0xfd, 0xfc, 0x65,
-	0xbe, 0xb4, 0xb2, 0x7e, 0x7c, 0x56, 0x73, 0x4e, 0xce, 0x6a, 0xce, 0x8f, 0xb3, 0x9a, 0xf3, 0xe9,
-	0xbc, 0x56, 0x3a, 0x39, 0xaf, 0x95, 0xbe, 0x9d, 0xd7, 0x4a, 0xbb, 0x8f, 0x87, 0x4e, 0x23, 0x99,
-	0x4b, 0xcb, 0x02, 0xcc, 0x91, 0x54, 0xfb, 0xf6, 0xa3, 0xd9, 0xcb, 0x07, 0x98, 0x3d, 0x96, 0xbd,
-	0x31, 0x3b, 0x9d, 0x9e, 0xfd, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x49, 0x67, 0xdf, 0xbd, 0xdc, 0x06,
-	0x00, 0x00,
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4d, 0x6f, 0x1c, 0x35,
+	0x18, 0xc7, 0xb3, 0xb4, 0x84, 0xd6, 0x79, 0x77, 0xde, 0xdc, 0x6d, 0xb3, 0xd9, 0x22, 0x40, 0x41,
+	0xb4, 0x1b, 0xd1, 0x1e, 0x90, 0xe0, 0xc4, 0x26, 0x29, 0x44, 0x6a, 0x94, 0xed, 0xa6, 0x87, 0x52,
+	0x21, 0x8c, 0x77, 0xe6, 0xd9, 0x19, 0x2b, 0x33, 0xf6, 0xc4, 0xf6, 0x24, 0xbb, 0x5f, 0x02, 0x71,
+	0xe4, 0xc8, 0xc7, 0xe9, 0xb1, 0x12, 0x17, 0xc4, 0xa1, 0x42, 0xc9, 0x17, 0x41, 0xf6, 0xbc, 0xec,
+	0x24, 0xa1, 0x45, 0x0c, 0x9c, 0x92, 0xf5, 0x63, 0xff, 0xfe, 0xcf, 0x33, 0xcf, 0x8b, 0x8d, 0x08,
+	0x44, 0x63, 0xbd, 0x1d, 0x33, 0x15, 0x70, 0xb1, 0x9d, 0x30, 0xc5, 0x62, 0xdd, 0x49, 0x94, 0x34,
+	0x12, 0xcf, 0x58, 0x4b, 0x27, 0xb3, 0x34, 0x57, 0x02, 0x19, 0x48, 0xb7, 0xbe, 0x6d, 0xff, 0xcb,
+	0xb6, 0x34, 0xd7, 0xab, 0x87, 0xcd, 0x38, 0x81, 0xfc, 0xec, 0x87, 0xbf, 0xad, 0xa2, 0xe9, 0x9e,
+	0x83, 0xe1, 0x67, 0x68, 0x36, 0x82, 0x53, 0x50, 0x2c, 0x00, 0x1a, 0xb3, 0x11, 0x69, 0xb4, 0x1b,
+	0x5b, 0xb7, 0xbb, 0x9d, 0x57, 0x6f, 0x36, 0xa7, 0xfe, 0x78, 0xb3, 0xf9, 0x49, 0xc0, 0x4d, 0x98,
+	0x0e, 0x3a, 0x9e, 0x8c, 0xb7, 0x3d, 0xa9, 0x63, 0xa9, 0xf3, 0x3f, 0x0f, 0xb5, 0x7f, 0x9c, 0x23,
+	0x77, 0xc1, 0xeb, 0xcf, 0x14, 0x8c, 0x03, 0x36, 0xc2, 0x2f, 0xd1, 0x12, 0x17, 0x06, 0x14, 0x68,
+	0x43, 0x15, 0x33, 0x19, 0xf7, 0xbd, 0x5a, 0xdc, 0x85, 0x02, 0xd4, 0x67, 0xe6, 0x2d, 0x6c, 0x2e,
+	0xc8, 0x8d, 0xff, 0x81, 0xcd, 0x05, 0xf6, 0xd1, 0xda, 0x65, 0x36, 0x17, 0x9e, 0x02, 0xa6, 0x81,
+	0xdc, 0xac, 0x25, 0xb0, 0x52, 0x15, 0xd8, 0xcf, 0x59, 0xd7, 0x55, 0x7c, 0xc8, 0x55, 0xde, 0xff,
+	0xef, 0x2a, 0xbb, 0x39, 0x0b, 0x7f, 0x8f, 0x70, 0x08, 0x2c, 0x32, 0x21, 0x0d, 0x18, 0x17, 0x74,
+	0xc8, 0x3c, 0x23, 0x15, 0x99, 0xae, 0xa5, 0xb0, 0x98, 0x91, 0xbe, 0x61, 0x5c, 0x3c, 0x71, 0x1c,
+	0x7c, 0x1f, 0xcd, 0x42, 0x22, 0xbd, 0x90, 0x46, 0x20, 0x02, 0x13, 0x92, 0x0f, 0xda, 0x8d, 0xad,
+	0x1b, 0xfd, 0x19, 0xb7, 0xf6, 0xd4, 0x2d, 0xe1, 0x21, 0x5a, 0x57, 0x10, 0xcb, 0x53, 0x16, 0xd1,
+	0x93, 0x14, 0x52, 0xa0, 0x26, 0x54, 0xa0, 0x43, 0x19, 0xf9, 0xe4, 0x56, 0x2d, 0x2f, 0x56, 0x73,
+	0xdc, 0x33, 0x4b, 0x7b, 0x5e, 0xc0, 0xf0, 0x03, 0x84, 0x63, 0x36, 0xa2, 0x32, 0x01, 0x41, 0x13,
+	0xa9, 0xb9, 0xe1, 0x52, 0x68, 0x72, 0xdb, 0x39, 0xb4, 0x18, 0xb3, 0xd1, 0x61, 0x02, 0xa2, 0x57,
+	0xac, 0xe3, 0x1f, 0xd0, 0x72, 0x22, 0x65, 0x94, 0x6d, 0x9f, 0x78, 0x84, 0x6a, 0x79, 0xb4, 0x64,
+	0x51, 0x96, 0x3f, 0xf1, 0x26, 0x46, 0x77, 0x87, 0x52, 0x79, 0x40, 0xbd, 0x48, 0x6a, 0xa0, 0xc3,
+	0x54, 0xf8, 0x34, 0x01, 0xe5, 0x81, 0x30, 0x2c, 0x00, 0x32, 0x53, 0x4b, 0x87, 0x38, 0xe4, 0x8e,
+	0x25, 0x3e, 0x49, 0x85, 0xdf, 0x2b, 0x79, 0xf8, 0x0b, 0x44, 0xae, 0xc9, 0x31, 0xdf, 0x57, 0xa0,
+	0x35, 0x99, 0xb5, 0x5a, 0xfd, 0xd5, 0xcb, 0x67, 0xbf, 0xce, 0x8c, 0xf8, 0xa7, 0x06, 0x7a, 0xe0,
+	0xaa, 0x3b, 0xb6, 0xa4, 0x88, 0x96, 0x15, 0x99, 0xb0, 0xb1, 0x5d, 0xba, 0xe6, 0xf9, 0x5c, 0x2d,
+	0xcf, 0xb7, 0x2a, 0x1a, 0xfb, 0xb9, 0x44, 0x2f, 0x53, 0xb8, 0x12, 0xc9, 0x0b, 0xf4, 0xe9, 0x3f,
+	0xfb, 0x53, 0x84, 0x36, 0xef, 0x42, 0xfb, 0xf8, 0xdd, 0xf0, 0x22, 0xd4, 0x43, 0x34, 0xa3, 0x4f,
+	0x68, 0x2c, 0x7d, 0x3e, 0xe4, 0xa0, 0xc8, 0x42, 0xad, 0x40, 0x90, 0x3e, 0x39, 0xc8, 0x09, 0xf8,
+	0x08, 0xcd, 0x69, 0x36, 0x04, 0x33, 0x2e, 0xba, 0x6a, 0xb1, 0x16, 0x72, 0x36, 0x83, 0xe4, 0x1d,
+	0x75, 0x88, 0x3e, 0x7a, 0x67, 0xfc, 0x20, 0xd8, 0x20, 0x02, 0x9f, 0x2c, 0xb5, 0x1b, 0x5b, 0xb7,
+	0xfa, 0xf7, 0xdf, 0x1e, 0xfa, 0x5e, 0xb6, 0x11, 0x7f, 0x8e, 0x56, 0xce, 0x42, 0x6e, 0x20, 0xe2,
+	0xda, 0x70, 0x11, 0x94, 0x00, 0xec, 0x00, 0xcb, 0x55, 0x5b, 0x71, 0xe4, 0x11, 0x5a, 0xe5, 0xe2,
+	0x94, 0x29, 0xce, 0x84, 0xa1, 0x5e, 0x08, 0xde, 0x31, 0x75, 0x1d, 0x4d, 0x96, 0xdd, 0xf7, 0x5e,
+	0x2e, 0x8d, 0x3b, 0xd6, 0xb6, 0x67, 0x4d, 0xf8, 0x0c, 0xb5, 0x3d, 0x19, 0x45, 0xcc, 0x80, 0x62,
+	0x11, 0x2d, 0x3a, 0x3e, 0x1f, 0x3d, 0xd9, 0xcd, 0x43, 0x56, 0x6a, 0x7d, 0x9f, 0x8d, 0x09, 0xb7,
+	0x9f, 0x61, 0xbf, 0x75, 0xd4, 0x03, 0x07, 0xc5, 0xdf, 0xa1, 0xc5, 0x88, 0x9f, 0xa4, 0xdc, 0x67,
+	0x46, 0x2a, 0x3a, 0x90, 0x22, 0xd5, 0x64, 0xb5, 0xde, 0x3d, 0x30, 0xe1, 0x74, 0x2d, 0x06, 0x7f,
+	0x85, 0x9a, 0x76, 0xa4, 0x14, 0xcb, 0x76, 0x70, 0xd8, 0x56, 0xa0, 0x83, 0x48, 0x7a, 0xc7, 0x64,
+	0xcd, 0x8d, 0x96, 0xf5, 0x98, 0x8d, 0x9e, 0x56, 0x36, 0xf4, 0x40, 0x75, 0xad, 0x19, 0x9f, 0xa0,
+	0x0d, 0x2e, 0x74, 0xaa, 0x98, 0xf0, 0xf2, 0x86, 0x2c, 0x73, 0xa9, 0x43, 0xa6, 0x80, 0xac, 0xd7,
+	0x72, 0xb2, 0x59, 0x42, 0x6d, 0x6d, 0x17, 0x39, 0x3f, 0xb2, 0x44, 0x9b, 0x83, 0x2b, 0x92, 0x15,
+	0xd7, 0x73, 0x55, 0x52, 0x2f, 0x07, 0x97, 0x54, 0x2b, 0xf1, 0x66, 0xc2, 0x5d, 0xb4, 0x30, 0x60,
+	0x3e, 0xf5, 0x61, 0x60, 0x68, 0x22, 0x23, 0xee, 0x8d, 0xc9, 0x9d, 0x76, 0x63, 0x6b, 0xfe, 0x51,
+	0xb3, 0x53, 0x79, 0x9c, 0x74, 0xba, 0xcc, 0xdf, 0x85, 0x81, 0xe9, 0xb9, 0x1d, 0xfd, 0xb9, 0x41,
+	0xf5, 0x27, 0x16, 0xe8, 0x9e, 0xfb, 0xd8, 0x52, 0x04, 0xd9, 0x54, 0xae, 0x5e, 0x8e, 0x5c, 0x92,
+	0x66, 0xbd, 0x91, 0x69, 0xd3, 0x23, 0x45, 0x60, 0xa7, 0xf3, 0xfe, 0xe4, 0x7e, 0xe4, 0x12, 0x27,
+	0x68, 0xc3, 0xea, 0xe9, 0x50, 0x2a, 0xf3, 0xb7, 0x82, 0x77, 0x6b, 0x09, 0xde, 0x89, 0xd9, 0xe8,
+	0xc8, 0x32, 0xaf, 0x2b, 0xbe, 0x40, 0x8b, 0x36, 0x29, 0xb6, 0x09, 0xcb, 0xd7, 0xd0, 0xbd, 0x5a,
+	0x22, 0xf3, 0x39, 0xa7, 0x78, 0x0c, 0x3d, 0x46, 0x6b, 0x36, 0x16, 0xa3, 0x78, 0x10, 0x80, 0xaa,
+	0x16, 0xe9, 0x86, 0x2b, 0xd2, 0xe5, 0x98, 0x8d, 0x9e, 0xe7, 0xc6, 0xb2, 0x40, 0xbb, 0xa8, 0x65,
+	0x0f, 0x49, 0xe5, 0x83, 0xa2, 0x30, 0x02, 0x2f, 0xbd, 0x5a, 0xe1, 0x2d, 0x77, 0xd8, 0xf6, 0xc0,
+	0xa1, 0xdd, 0xb4, 0x57, 0xee, 0x29, 0x19, 0x3f, 0xa2, 0x95, 0x98, 0x8b, 0x9c, 0x31, 0xe9, 0x53,
+	0xb2, 0xf9, 0xaf, 0xc3, 0xda, 0x17, 0xa6, 0x8f, 0x63, 0x2e, 0x9c, 0xd2, 0x4e, 0x49, 0x2a, 0xaf,
+	0x75, 0xa7, 0xe0, 0xa7, 0x2e, 0x35, 0x82, 0xb4, 0x27, 0xd7, 0xba, 0x35, 0xec, 0xe6, 0xeb, 0x5f,
+	0xde, 0xfc, 0xe5, 0xd7, 0xcd, 0xa9, 0xee, 0xde, 0xab, 0xf3, 0x56, 0xe3, 0xf5, 0x79, 0xab, 0xf1,
+	0xe7, 0x79, 0xab, 0xf1, 0xf3, 0x45, 0x6b, 0xea, 0xf5, 0x45, 0x6b, 0xea, 0xf7, 0x8b, 0xd6, 0xd4,
+	0xcb, 0xcf, 0x2a, 0x9e, 0xd8, 0xca, 0x7c, 0x28, 0xc0, 0x9c, 0x49, 0x75, 0xec, 0x7e, 0x6c, 0x8f,
+	0x2e, 0x3d, 0x91, 0x07, 0xd3, 0xee, 0x8d, 0xfc, 0xf8, 0xaf, 0x01, 0x00, 0x7f, 0x4e, 0x39, 0x61,
+	0x7b, 0x0b, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -200,6 +282,137 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxOrderDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOrderDuration))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size := m.MinOrderCollateral.Size()
+		i -= size
+		if _, err := m.MinOrderCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
+	if m.MaxOrderExecutionsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOrderExecutionsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.MaxTriggersPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTriggersPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	{
+		size := m.FundingRateMax.Size()
+		i -= size
+		if _, err := m.FundingRateMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	{
+		size := m.MaxShortOpenInterestRatio.Size()
+		i -= size
+		if _, err := m.MaxShortOpenInterestRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	{
+		size := m.MaxLongOpenInterestRatio.Size()
+		i -= size
+		if _, err := m.MaxLongOpenInterestRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	if m.BadDebtPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BadDebtPolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	{
+		size := m.InsuranceFundLiquidationShare.Size()
+		i -= size
+		if _, err := m.InsuranceFundLiquidationShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	{
+		size := m.InsuranceFundInterestShare.Size()
+		i -= size
+		if _, err := m.InsuranceFundInterestShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.MaxLiquidationsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxLiquidationsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	{
+		size := m.LiquidatorBonus.Size()
+		i -= size
+		if _, err := m.LiquidatorBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.CollateralRemovalHealthMargin.Size()
+		i -= size
+		if _, err := m.CollateralRemovalHealthMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
 	if len(m.InvariantCheckEpoch) > 0 {
 		i -= len(m.InvariantCheckEpoch)
 		copy(dAtA[i:], m.InvariantCheckEpoch)
@@ -447,6 +660,37 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovParams(uint64(l))
 	}
+	l = m.CollateralRemovalHealthMargin.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.LiquidatorBonus.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxLiquidationsPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxLiquidationsPerBlock))
+	}
+	l = m.InsuranceFundInterestShare.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.InsuranceFundLiquidationShare.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.BadDebtPolicy != 0 {
+		n += 2 + sovParams(uint64(m.BadDebtPolicy))
+	}
+	l = m.MaxLongOpenInterestRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MaxShortOpenInterestRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.FundingRateMax.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxTriggersPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxTriggersPerBlock))
+	}
+	if m.MaxOrderExecutionsPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxOrderExecutionsPerBlock))
+	}
+	l = m.MinOrderCollateral.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxOrderDuration != 0 {
+		n += 2 + sovParams(uint64(m.MaxOrderDuration))
+	}
 	return n
 }
 
@@ -1067,6 +1311,373 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.InvariantCheckEpoch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralRemovalHealthMargin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralRemovalHealthMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidatorBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLiquidationsPerBlock", wireType)
+			}
+			m.MaxLiquidationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLiquidationsPerBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundInterestShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InsuranceFundInterestShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundLiquidationShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InsuranceFundLiquidationShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebtPolicy", wireType)
+			}
+			m.BadDebtPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BadDebtPolicy |= BadDebtPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLongOpenInterestRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLongOpenInterestRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxShortOpenInterestRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxShortOpenInterestRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingRateMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTriggersPerBlock", wireType)
+			}
+			m.MaxTriggersPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTriggersPerBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderExecutionsPerBlock", wireType)
+			}
+			m.MaxOrderExecutionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOrderExecutionsPerBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderCollateral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOrderCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderDuration", wireType)
+			}
+			m.MaxOrderDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOrderDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
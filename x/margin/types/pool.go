@@ -8,12 +8,17 @@ import (
 
 func NewPool(poolId uint64) Pool {
 	return Pool{
-		AmmPoolId:    poolId,
-		Health:       sdk.NewDec(100),
-		Enabled:      true,
-		Closed:       false,
-		InterestRate: sdk.NewDecFromIntWithPrec(sdk.NewInt(1), 1),
-		PoolAssets:   []PoolAsset{},
+		AmmPoolId:         poolId,
+		Health:            sdk.NewDec(100),
+		Enabled:           true,
+		Closed:            false,
+		InterestRate:      sdk.NewDecFromIntWithPrec(sdk.NewInt(1), 1),
+		PoolAssets:        []PoolAsset{},
+		FundingRate:       sdk.ZeroDec(),
+		LongLiabilities:   sdk.ZeroInt(),
+		ShortLiabilities:  sdk.ZeroInt(),
+		LongFundingIndex:  sdk.ZeroDec(),
+		ShortFundingIndex: sdk.ZeroDec(),
 	}
 }
 
@@ -51,6 +56,56 @@ func (p *Pool) UpdateLiabilities(ctx sdk.Context, assetDenom string, amount sdk.
 	return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid asset denom")
 }
 
+// Update the liabilities of the long or short side
+func (p *Pool) UpdatePositionLiabilities(ctx sdk.Context, position Position, amount sdk.Int, isIncrease bool) error {
+	if !isIncrease {
+		amount = amount.Neg()
+	}
+	switch position {
+	case Position_LONG:
+		p.LongLiabilities = p.LongLiabilities.Add(amount)
+	case Position_SHORT:
+		p.ShortLiabilities = p.ShortLiabilities.Add(amount)
+	default:
+		return sdkerrors.Wrap(ErrInvalidPosition, position.String())
+	}
+
+	return nil
+}
+
+// Get the funding index of the long or short side
+func (p Pool) GetFundingIndex(position Position) sdk.Dec {
+	switch position {
+	case Position_LONG:
+		return p.LongFundingIndex
+	case Position_SHORT:
+		return p.ShortFundingIndex
+	default:
+		return sdk.ZeroDec()
+	}
+}
+
+// Accrue one epoch of funding at the funding rate to the funding indexes. The crowded side pays the rate on
+// its liabilities and the other side receives the same total pro rata to its liabilities, so that funding
+// nets to zero between the sides. Nothing accrues when a side has no liabilities.
+func (p *Pool) AccrueFunding() {
+	if p.FundingRate.IsNil() || p.FundingRate.IsZero() {
+		return
+	}
+	if p.LongLiabilities.IsNil() || !p.LongLiabilities.IsPositive() || p.ShortLiabilities.IsNil() || !p.ShortLiabilities.IsPositive() {
+		return
+	}
+
+	if p.FundingRate.IsPositive() {
+		p.LongFundingIndex = p.LongFundingIndex.Add(p.FundingRate)
+		p.ShortFundingIndex = p.ShortFundingIndex.Sub(p.FundingRate.MulInt(p.LongLiabilities).QuoInt(p.ShortLiabilities))
+	} else {
+		rate := p.FundingRate.Neg()
+		p.ShortFundingIndex = p.ShortFundingIndex.Add(rate)
+		p.LongFundingIndex = p.LongFundingIndex.Sub(rate.MulInt(p.ShortLiabilities).QuoInt(p.LongLiabilities))
+	}
+}
+
 // Update the asset custody
 func (p *Pool) UpdateCustody(ctx sdk.Context, assetDenom string, amount sdk.Int, isIncrease bool) error {
 	for i, asset := range p.PoolAssets {
@@ -82,6 +82,15 @@ type Pool struct {
 	InterestRate                   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=interest_rate,json=interestRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"interest_rate"`
 	PoolAssets                     []PoolAsset                            `protobuf:"bytes,6,rep,name=poolAssets,proto3" json:"poolAssets"`
 	LastHeightInterestRateComputed int64                                  `protobuf:"varint,7,opt,name=lastHeightInterestRateComputed,proto3" json:"lastHeightInterestRateComputed,omitempty"`
+	// funding rate per epoch paid by the longs to the shorts when positive and by the shorts to the longs when negative
+	FundingRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=funding_rate,json=fundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_rate"`
+	// liabilities of the long and short mtps of the pool, in base currency
+	LongLiabilities  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=long_liabilities,json=longLiabilities,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"long_liabilities"`
+	ShortLiabilities github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=short_liabilities,json=shortLiabilities,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"short_liabilities"`
+	// cumulative funding per unit of liabilities of the long and short mtps of the pool, paid when
+	// positive and received when negative, accrued once per epoch
+	LongFundingIndex  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=long_funding_index,json=longFundingIndex,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"long_funding_index"`
+	ShortFundingIndex github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=short_funding_index,json=shortFundingIndex,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"short_funding_index"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -160,39 +169,44 @@ func init() {
 func init() { proto.RegisterFile("elys/margin/pool.proto", fileDescriptor_030dd771f91c2bb4) }
 
 var fileDescriptor_030dd771f91c2bb4 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0xc6, 0x38, 0xcd, 0x4b, 0x83, 0xc4, 0x51, 0x82, 0x85, 0x90, 0x53, 0x65, 0x40,
-	0x95, 0x50, 0x6d, 0x09, 0x36, 0xc4, 0x42, 0x5a, 0xa2, 0x46, 0x62, 0x88, 0x0e, 0xb1, 0x30, 0x60,
-	0x9d, 0xed, 0xab, 0x63, 0xf9, 0xec, 0xb3, 0x7c, 0x17, 0x41, 0xfe, 0x0b, 0xfe, 0xac, 0x8e, 0x1d,
-	0x18, 0x10, 0x43, 0x84, 0x92, 0xff, 0x80, 0x8d, 0x0d, 0xdd, 0xc5, 0xa6, 0x66, 0x42, 0xf5, 0x64,
-	0xbf, 0xaf, 0xde, 0x7d, 0xde, 0x8f, 0x7b, 0xef, 0x60, 0x44, 0xd9, 0x5a, 0x78, 0x19, 0x29, 0xe3,
-	0x24, 0xf7, 0x0a, 0xce, 0x99, 0x5b, 0x94, 0x5c, 0x72, 0x34, 0x50, 0xba, 0xbb, 0xd7, 0x9f, 0x1c,
-	0xc7, 0x3c, 0xe6, 0x5a, 0xf7, 0xd4, 0xdf, 0xde, 0x65, 0xf2, 0xcd, 0x84, 0xfe, 0x82, 0x73, 0xf6,
-	0x46, 0x08, 0x2a, 0xd1, 0x02, 0x06, 0x2c, 0x21, 0x41, 0xc2, 0x12, 0x99, 0x50, 0x61, 0x1b, 0x27,
-	0xc6, 0x69, 0x7f, 0xea, 0x5e, 0x6f, 0xc6, 0x9d, 0x1f, 0x9b, 0xf1, 0xb3, 0x38, 0x91, 0xcb, 0x55,
-	0xe0, 0x86, 0x3c, 0xf3, 0x42, 0x2e, 0x32, 0x2e, 0xaa, 0xcf, 0x99, 0x88, 0x52, 0x4f, 0xae, 0x0b,
-	0x2a, 0xdc, 0x79, 0x2e, 0x71, 0x13, 0x81, 0x2e, 0xa1, 0x17, 0xae, 0x84, 0xe4, 0xd1, 0xda, 0x3e,
-	0x68, 0x45, 0xab, 0x8f, 0xa3, 0x2b, 0x78, 0x2c, 0x49, 0x4a, 0xfd, 0xa2, 0xe4, 0x57, 0x89, 0xf4,
-	0x9b, 0x79, 0x76, 0x5b, 0x91, 0x1f, 0x29, 0xdc, 0x42, 0xd3, 0xde, 0x35, 0x32, 0xfe, 0x04, 0x0f,
-	0x9b, 0x71, 0xea, 0xec, 0xcd, 0x56, 0x31, 0x1e, 0xdc, 0xc6, 0x38, 0xaf, 0xea, 0x48, 0x61, 0x48,
-	0x54, 0xb3, 0xfd, 0x80, 0x30, 0x92, 0x87, 0xd4, 0xbe, 0xa7, 0xc9, 0xb3, 0xbb, 0x91, 0x7f, 0x6d,
-	0xc6, 0xc7, 0x6b, 0x92, 0xb1, 0x57, 0x93, 0x7f, 0x60, 0x13, 0x7c, 0xa4, 0xed, 0xe9, 0xde, 0x44,
-	0x1f, 0xe0, 0x7e, 0xc0, 0x78, 0x98, 0xfa, 0x49, 0x2e, 0x69, 0x49, 0x85, 0xb4, 0xad, 0x56, 0x75,
-	0x0c, 0x35, 0x65, 0x5e, 0x41, 0xd0, 0x18, 0x06, 0xfb, 0xb0, 0x11, 0xcd, 0x79, 0x66, 0xf7, 0x14,
-	0x13, 0x83, 0x96, 0x2e, 0x94, 0x32, 0xf9, 0x7d, 0x00, 0xa6, 0x1a, 0x2b, 0xf4, 0x14, 0xfa, 0x24,
-	0xcb, 0xd4, 0xef, 0x3c, 0xd2, 0xf3, 0x64, 0xe2, 0x5b, 0x01, 0xcd, 0xc0, 0x5a, 0x52, 0xc2, 0xe4,
-	0xb2, 0xc5, 0x70, 0x5c, 0xd0, 0x10, 0x57, 0xa7, 0x91, 0x0d, 0x3d, 0x9a, 0x93, 0x80, 0xd1, 0x48,
-	0xcf, 0xc2, 0x21, 0xae, 0x4d, 0x34, 0x02, 0x2b, 0x64, 0x5c, 0xd0, 0x48, 0x5f, 0xe0, 0x21, 0xae,
-	0x2c, 0xf4, 0x1e, 0x86, 0x75, 0x4b, 0xfc, 0x92, 0xc8, 0xfa, 0x16, 0xee, 0x9a, 0xc0, 0x51, 0x0d,
-	0xc1, 0x44, 0x52, 0xf4, 0x1a, 0xa0, 0xa8, 0x77, 0x49, 0xd8, 0xd6, 0x49, 0xf7, 0x74, 0xf0, 0x62,
-	0xe4, 0x36, 0x96, 0xd0, 0xfd, 0xbb, 0x6a, 0x53, 0x53, 0x45, 0xc2, 0x0d, 0x7f, 0x34, 0x03, 0x87,
-	0x11, 0x21, 0x2f, 0x69, 0x12, 0x2f, 0xe5, 0xbc, 0xc1, 0x3d, 0xe7, 0x59, 0xb1, 0x92, 0x34, 0xd2,
-	0x7d, 0xee, 0xe2, 0xff, 0x78, 0x4d, 0xdf, 0x5e, 0x6f, 0x1d, 0xe3, 0x66, 0xeb, 0x18, 0x3f, 0xb7,
-	0x8e, 0xf1, 0x75, 0xe7, 0x74, 0x6e, 0x76, 0x4e, 0xe7, 0xfb, 0xce, 0xe9, 0x7c, 0x7c, 0xde, 0xa8,
-	0x4a, 0x65, 0x75, 0x96, 0x53, 0xf9, 0x99, 0x97, 0xa9, 0x36, 0xbc, 0x2f, 0xf5, 0x0b, 0xa2, 0xcb,
-	0x0b, 0x2c, 0xfd, 0x40, 0xbc, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xfe, 0x73, 0xff, 0x5d,
-	0x04, 0x00, 0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0x93, 0x5f, 0xf2, 0x73, 0x92, 0x71, 0x02, 0xed, 0x52, 0x82, 0x85, 0x90, 0x53, 0xe5,
+	0x80, 0x2a, 0xa1, 0xda, 0x12, 0xdc, 0x10, 0x17, 0xd2, 0x12, 0x35, 0x12, 0x87, 0x60, 0xc4, 0x81,
+	0x3f, 0x22, 0x5a, 0xdb, 0x1b, 0xc7, 0xca, 0xda, 0x6b, 0x79, 0x37, 0xa2, 0x79, 0x0b, 0x9e, 0x89,
+	0x53, 0x8f, 0x3d, 0x70, 0x40, 0x1c, 0x22, 0x94, 0xbc, 0x01, 0x4f, 0x80, 0x76, 0x6d, 0x53, 0xe7,
+	0x84, 0xea, 0x93, 0x77, 0x46, 0xbb, 0x9f, 0x99, 0xf9, 0xee, 0xcc, 0x1a, 0xfa, 0x84, 0xae, 0xb9,
+	0x1d, 0xe1, 0x34, 0x08, 0x63, 0x3b, 0x61, 0x8c, 0x5a, 0x49, 0xca, 0x04, 0x43, 0xba, 0xf4, 0x5b,
+	0x99, 0xff, 0xe1, 0x51, 0xc0, 0x02, 0xa6, 0xfc, 0xb6, 0x5c, 0x65, 0x5b, 0x86, 0xdf, 0x9b, 0xd0,
+	0x99, 0x32, 0x46, 0x5f, 0x72, 0x4e, 0x04, 0x9a, 0x82, 0x4e, 0x43, 0xec, 0x86, 0x34, 0x14, 0x21,
+	0xe1, 0x46, 0xfd, 0xb8, 0x7e, 0xd2, 0x19, 0x59, 0x57, 0x9b, 0x41, 0xed, 0xe7, 0x66, 0xf0, 0x38,
+	0x08, 0xc5, 0x62, 0xe5, 0x5a, 0x1e, 0x8b, 0x6c, 0x8f, 0xf1, 0x88, 0xf1, 0xfc, 0x73, 0xca, 0xfd,
+	0xa5, 0x2d, 0xd6, 0x09, 0xe1, 0xd6, 0x24, 0x16, 0x4e, 0x19, 0x81, 0x2e, 0xa0, 0xe5, 0xad, 0xb8,
+	0x60, 0xfe, 0xda, 0xf8, 0xaf, 0x12, 0xad, 0x38, 0x8e, 0xe6, 0xf0, 0x40, 0xe0, 0x25, 0x99, 0x25,
+	0x29, 0x9b, 0x87, 0x62, 0x56, 0xce, 0xb3, 0x51, 0x89, 0x7c, 0x5f, 0xe2, 0xa6, 0x8a, 0xf6, 0xba,
+	0x94, 0xf1, 0x67, 0xb8, 0x57, 0x8e, 0x53, 0x64, 0xdf, 0xac, 0x14, 0xe3, 0xf0, 0x26, 0xc6, 0x59,
+	0x5e, 0xc7, 0x12, 0x7a, 0x58, 0x8a, 0x3d, 0x73, 0x31, 0xc5, 0xb1, 0x47, 0x8c, 0xff, 0x15, 0x79,
+	0x7c, 0x3b, 0xf2, 0xef, 0xcd, 0xe0, 0x68, 0x8d, 0x23, 0xfa, 0x7c, 0xb8, 0x07, 0x1b, 0x3a, 0x5d,
+	0x65, 0x8f, 0x32, 0x13, 0xbd, 0x83, 0x3b, 0x2e, 0x65, 0xde, 0x72, 0x16, 0xc6, 0x82, 0xa4, 0x84,
+	0x0b, 0x43, 0xab, 0x54, 0x47, 0x4f, 0x51, 0x26, 0x39, 0x04, 0x0d, 0x40, 0xcf, 0xc2, 0xfa, 0x24,
+	0x66, 0x91, 0xd1, 0x92, 0x4c, 0x07, 0x94, 0xeb, 0x5c, 0x7a, 0x86, 0xdf, 0x34, 0x68, 0xca, 0xb6,
+	0x42, 0x8f, 0xa0, 0x83, 0xa3, 0x48, 0x2e, 0x27, 0xbe, 0xea, 0xa7, 0xa6, 0x73, 0xe3, 0x40, 0x63,
+	0xd0, 0x16, 0x04, 0x53, 0xb1, 0xa8, 0xd0, 0x1c, 0xe7, 0xc4, 0x73, 0xf2, 0xd3, 0xc8, 0x80, 0x16,
+	0x89, 0xb1, 0x4b, 0x89, 0xaf, 0x7a, 0xa1, 0xed, 0x14, 0x26, 0xea, 0x83, 0xe6, 0x51, 0xc6, 0x89,
+	0xaf, 0x2e, 0xb0, 0xed, 0xe4, 0x16, 0x7a, 0x0b, 0xbd, 0x42, 0x92, 0x59, 0x8a, 0x45, 0x71, 0x0b,
+	0xb7, 0x4d, 0xa0, 0x5b, 0x40, 0x1c, 0x2c, 0x08, 0x7a, 0x01, 0x90, 0x14, 0xb3, 0xc4, 0x0d, 0xed,
+	0xb8, 0x71, 0xa2, 0x3f, 0xed, 0x5b, 0xa5, 0x21, 0xb4, 0xfe, 0x8e, 0xda, 0xa8, 0x29, 0x23, 0x39,
+	0xa5, 0xfd, 0x68, 0x0c, 0x26, 0xc5, 0x5c, 0x5c, 0x90, 0x30, 0x58, 0x88, 0x49, 0x89, 0x7b, 0xc6,
+	0xa2, 0x64, 0x25, 0x88, 0xaf, 0x74, 0x6e, 0x38, 0xff, 0xd8, 0x85, 0xde, 0x40, 0x77, 0xbe, 0x8a,
+	0xfd, 0x30, 0x0e, 0xb2, 0xca, 0xda, 0x95, 0x2a, 0xd3, 0x73, 0x86, 0x2a, 0xec, 0x3d, 0x1c, 0x50,
+	0x16, 0x07, 0x7b, 0x43, 0xd7, 0xa9, 0xd4, 0x48, 0x77, 0x25, 0xa7, 0x3c, 0x6e, 0x1f, 0xe1, 0x90,
+	0x2f, 0x58, 0xba, 0x3f, 0xd0, 0x50, 0x89, 0x7d, 0xa0, 0x40, 0x65, 0xf8, 0x27, 0x40, 0x2a, 0xef,
+	0x42, 0x8f, 0x30, 0xf6, 0xc9, 0xa5, 0xa1, 0x57, 0x12, 0x44, 0x29, 0x30, 0xce, 0x40, 0x13, 0xc9,
+	0x91, 0x2f, 0x45, 0x96, 0xfa, 0x3e, 0xbe, 0x5b, 0x09, 0x9f, 0xa9, 0x50, 0xe6, 0x8f, 0x5e, 0x5d,
+	0x6d, 0xcd, 0xfa, 0xf5, 0xd6, 0xac, 0xff, 0xda, 0x9a, 0xf5, 0xaf, 0x3b, 0xb3, 0x76, 0xbd, 0x33,
+	0x6b, 0x3f, 0x76, 0x66, 0xed, 0xc3, 0x93, 0x12, 0x54, 0xb6, 0xd7, 0x69, 0x4c, 0xc4, 0x17, 0x96,
+	0x2e, 0x95, 0x61, 0x5f, 0x16, 0xbf, 0x02, 0x45, 0x77, 0x35, 0xf5, 0xd2, 0x3f, 0xfb, 0x33, 0x00,
+	0x1d, 0xce, 0x79, 0x53, 0x26, 0x06, 0x00, 0x00,
 }
 
 func (m *PoolAsset) Marshal() (dAtA []byte, err error) {
@@ -305,6 +319,56 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ShortFundingIndex.Size()
+		i -= size
+		if _, err := m.ShortFundingIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.LongFundingIndex.Size()
+		i -= size
+		if _, err := m.LongFundingIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ShortLiabilities.Size()
+		i -= size
+		if _, err := m.ShortLiabilities.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.LongLiabilities.Size()
+		i -= size
+		if _, err := m.LongLiabilities.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.FundingRate.Size()
+		i -= size
+		if _, err := m.FundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if m.LastHeightInterestRateComputed != 0 {
 		i = encodeVarintPool(dAtA, i, uint64(m.LastHeightInterestRateComputed))
 		i--
@@ -436,6 +500,16 @@ func (m *Pool) Size() (n int) {
 	if m.LastHeightInterestRateComputed != 0 {
 		n += 1 + sovPool(uint64(m.LastHeightInterestRateComputed))
 	}
+	l = m.FundingRate.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.LongLiabilities.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.ShortLiabilities.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.LongFundingIndex.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.ShortFundingIndex.Size()
+	n += 1 + l + sovPool(uint64(l))
 	return n
 }
 
@@ -940,6 +1014,176 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongLiabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LongLiabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortLiabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShortLiabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongFundingIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LongFundingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortFundingIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShortFundingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -743,6 +745,12 @@ func (m *QueryGetPoolRequest) GetIndex() uint64 {
 
 type QueryGetPoolResponse struct {
 	Pool Pool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool"`
+	// custody of the longs as a fraction of the pool balance
+	LongOpenInterestRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=long_open_interest_ratio,json=longOpenInterestRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"long_open_interest_ratio"`
+	// custody of the shorts as a fraction of the pool balance
+	ShortOpenInterestRatio    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=short_open_interest_ratio,json=shortOpenInterestRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"short_open_interest_ratio"`
+	MaxLongOpenInterestRatio  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_long_open_interest_ratio,json=maxLongOpenInterestRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_long_open_interest_ratio"`
+	MaxShortOpenInterestRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_short_open_interest_ratio,json=maxShortOpenInterestRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_short_open_interest_ratio"`
 }
 
 func (m *QueryGetPoolResponse) Reset()         { *m = QueryGetPoolResponse{} }
@@ -977,6 +985,294 @@ func (m *MTPResponse) GetMtp() *MTP {
 	return nil
 }
 
+type InsuranceFundRequest struct {
+}
+
+func (m *InsuranceFundRequest) Reset()         { *m = InsuranceFundRequest{} }
+func (m *InsuranceFundRequest) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundRequest) ProtoMessage()    {}
+func (*InsuranceFundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1668b4919d9577d0, []int{20}
+}
+func (m *InsuranceFundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceFundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundRequest.Merge(m, src)
+}
+func (m *InsuranceFundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFundRequest proto.InternalMessageInfo
+
+type InsuranceFundResponse struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *InsuranceFundResponse) Reset()         { *m = InsuranceFundResponse{} }
+func (m *InsuranceFundResponse) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundResponse) ProtoMessage()    {}
+func (*InsuranceFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1668b4919d9577d0, []int{21}
+}
+func (m *InsuranceFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundResponse.Merge(m, src)
+}
+func (m *InsuranceFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFundResponse proto.InternalMessageInfo
+
+func (m *InsuranceFundResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *InsuranceFundResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+type BadDebtsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *BadDebtsRequest) Reset()         { *m = BadDebtsRequest{} }
+func (m *BadDebtsRequest) String() string { return proto.CompactTextString(m) }
+func (*BadDebtsRequest) ProtoMessage()    {}
+func (*BadDebtsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1668b4919d9577d0, []int{22}
+}
+func (m *BadDebtsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadDebtsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadDebtsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BadDebtsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadDebtsRequest.Merge(m, src)
+}
+func (m *BadDebtsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadDebtsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadDebtsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadDebtsRequest proto.InternalMessageInfo
+
+func (m *BadDebtsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type BadDebtsResponse struct {
+	BadDebts   []BadDebt           `protobuf:"bytes,1,rep,name=bad_debts,json=badDebts,proto3" json:"bad_debts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *BadDebtsResponse) Reset()         { *m = BadDebtsResponse{} }
+func (m *BadDebtsResponse) String() string { return proto.CompactTextString(m) }
+func (*BadDebtsResponse) ProtoMessage()    {}
+func (*BadDebtsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1668b4919d9577d0, []int{23}
+}
+func (m *BadDebtsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadDebtsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadDebtsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BadDebtsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadDebtsResponse.Merge(m, src)
+}
+func (m *BadDebtsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadDebtsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadDebtsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadDebtsResponse proto.InternalMessageInfo
+
+func (m *BadDebtsResponse) GetBadDebts() []BadDebt {
+	if m != nil {
+		return m.BadDebts
+	}
+	return nil
+}
+
+func (m *BadDebtsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type OrdersForAddressRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *OrdersForAddressRequest) Reset()         { *m = OrdersForAddressRequest{} }
+func (m *OrdersForAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*OrdersForAddressRequest) ProtoMessage()    {}
+func (*OrdersForAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1668b4919d9577d0, []int{24}
+}
+func (m *OrdersForAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrdersForAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrdersForAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrdersForAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrdersForAddressRequest.Merge(m, src)
+}
+func (m *OrdersForAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrdersForAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrdersForAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrdersForAddressRequest proto.InternalMessageInfo
+
+func (m *OrdersForAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *OrdersForAddressRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type OrdersForAddressResponse struct {
+	Orders     []MarginOrder       `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *OrdersForAddressResponse) Reset()         { *m = OrdersForAddressResponse{} }
+func (m *OrdersForAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*OrdersForAddressResponse) ProtoMessage()    {}
+func (*OrdersForAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1668b4919d9577d0, []int{25}
+}
+func (m *OrdersForAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrdersForAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrdersForAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrdersForAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrdersForAddressResponse.Merge(m, src)
+}
+func (m *OrdersForAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrdersForAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrdersForAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrdersForAddressResponse proto.InternalMessageInfo
+
+func (m *OrdersForAddressResponse) GetOrders() []MarginOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *OrdersForAddressResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "elys.margin.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "elys.margin.ParamsResponse")
@@ -998,76 +1294,104 @@ func init() {
 	proto.RegisterType((*QueryAllPoolResponse)(nil), "elys.margin.QueryAllPoolResponse")
 	proto.RegisterType((*MTPRequest)(nil), "elys.margin.MTPRequest")
 	proto.RegisterType((*MTPResponse)(nil), "elys.margin.MTPResponse")
+	proto.RegisterType((*InsuranceFundRequest)(nil), "elys.margin.InsuranceFundRequest")
+	proto.RegisterType((*InsuranceFundResponse)(nil), "elys.margin.InsuranceFundResponse")
+	proto.RegisterType((*BadDebtsRequest)(nil), "elys.margin.BadDebtsRequest")
+	proto.RegisterType((*BadDebtsResponse)(nil), "elys.margin.BadDebtsResponse")
+	proto.RegisterType((*OrdersForAddressRequest)(nil), "elys.margin.OrdersForAddressRequest")
+	proto.RegisterType((*OrdersForAddressResponse)(nil), "elys.margin.OrdersForAddressResponse")
 }
 
 func init() { proto.RegisterFile("elys/margin/query.proto", fileDescriptor_1668b4919d9577d0) }
 
 var fileDescriptor_1668b4919d9577d0 = []byte{
-	// 1011 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x3a, 0x4e, 0xc0, 0xcf, 0x8d, 0x9b, 0x4c, 0x43, 0x12, 0x6d, 0x8b, 0x49, 0x97, 0xd2,
-	0x5a, 0x4d, 0xbd, 0x5b, 0x87, 0xaa, 0x5c, 0xb8, 0x34, 0x51, 0x1b, 0xf5, 0x10, 0xc9, 0x98, 0x4a,
-	0xa0, 0x48, 0xc8, 0x5a, 0x67, 0xa7, 0xee, 0x28, 0xbb, 0x3b, 0x5b, 0xef, 0x98, 0xd4, 0x44, 0x41,
-	0xa8, 0x12, 0x52, 0x25, 0x7a, 0x40, 0xe2, 0x7b, 0xf0, 0x01, 0xf8, 0x04, 0x3d, 0x56, 0xe2, 0xc2,
-	0x09, 0xa1, 0x84, 0x33, 0x9f, 0x01, 0xcd, 0xec, 0xec, 0x66, 0x67, 0x77, 0x63, 0x17, 0x64, 0x29,
-	0x37, 0x7b, 0xde, 0x9b, 0xf7, 0xfb, 0xbd, 0x3f, 0xf3, 0x7b, 0x36, 0xac, 0x62, 0x77, 0x14, 0x5a,
-	0x9e, 0x3d, 0xe8, 0x13, 0xdf, 0x7a, 0x3e, 0xc4, 0x83, 0x91, 0x19, 0x0c, 0x28, 0xa3, 0xa8, 0xca,
-	0x0d, 0x66, 0x64, 0xd0, 0x97, 0xfb, 0xb4, 0x4f, 0xc5, 0xb9, 0xc5, 0x3f, 0x45, 0x2e, 0xfa, 0xb5,
-	0x3e, 0xa5, 0x7d, 0x17, 0x5b, 0x76, 0x40, 0x2c, 0xdb, 0xf7, 0x29, 0xb3, 0x19, 0xa1, 0x7e, 0x28,
-	0xad, 0xb7, 0xf7, 0x69, 0xe8, 0xd1, 0xd0, 0xea, 0xd9, 0x21, 0x8e, 0x22, 0x5b, 0xdf, 0xb6, 0x7a,
-	0x98, 0xd9, 0x2d, 0x2b, 0xb0, 0xfb, 0xc4, 0x17, 0xce, 0xd2, 0x77, 0x2d, 0xcd, 0x22, 0xb0, 0x07,
-	0xb6, 0x17, 0x47, 0x51, 0xf8, 0xb1, 0x51, 0x80, 0x63, 0xc3, 0x8a, 0x72, 0x85, 0x52, 0x37, 0x3a,
-	0x37, 0x2e, 0xc3, 0x42, 0x5b, 0x04, 0xe8, 0xe0, 0xe7, 0x43, 0x1c, 0x32, 0x63, 0x1b, 0x6a, 0xf1,
-	0x41, 0x18, 0x50, 0x3f, 0xc4, 0xa8, 0x05, 0xf3, 0x11, 0xc6, 0x9a, 0xb6, 0xae, 0x35, 0xaa, 0x9b,
-	0x57, 0xcc, 0x54, 0xae, 0x66, 0xe4, 0xbc, 0x55, 0x7e, 0xf3, 0xe7, 0x47, 0x33, 0x1d, 0xe9, 0x68,
-	0xec, 0xc1, 0x62, 0x9b, 0x86, 0x44, 0xe4, 0x27, 0x03, 0xa3, 0x47, 0x00, 0x67, 0x89, 0xc8, 0x50,
-	0x37, 0xcd, 0x28, 0x6b, 0x93, 0x67, 0x6d, 0x46, 0xf5, 0x94, 0x59, 0x9b, 0x6d, 0xbb, 0x8f, 0xe5,
-	0xdd, 0x4e, 0xea, 0xa6, 0xf1, 0x52, 0x83, 0xa5, 0x54, 0x70, 0x49, 0xf2, 0x06, 0x94, 0x3d, 0x16,
-	0x70, 0x8a, 0xb3, 0x8d, 0xea, 0xe6, 0xa2, 0x42, 0x71, 0xf7, 0x49, 0xbb, 0x23, 0xac, 0x68, 0x47,
-	0xe1, 0x50, 0x12, 0x1c, 0x6e, 0x4d, 0xe4, 0x10, 0x41, 0x28, 0x24, 0x7e, 0xd0, 0x60, 0x25, 0x21,
-	0xb1, 0x35, 0x6a, 0x53, 0xea, 0xc6, 0x79, 0xd6, 0xa1, 0x6a, 0x7b, 0x5e, 0x97, 0xd7, 0xb8, 0x4b,
-	0x1c, 0x91, 0x68, 0xb9, 0x53, 0xb1, 0x3d, 0x8f, 0x3b, 0x3d, 0x76, 0x32, 0x75, 0x28, 0xfd, 0xef,
-	0x3a, 0xbc, 0xd2, 0x60, 0x35, 0x47, 0xe1, 0x62, 0xaa, 0x71, 0x19, 0x16, 0xbe, 0x64, 0x36, 0x1b,
-	0x26, 0x43, 0xe4, 0x40, 0x2d, 0x3e, 0x48, 0x18, 0xd5, 0x68, 0x80, 0xfd, 0xae, 0xc7, 0x82, 0xee,
-	0x3e, 0x1d, 0xfa, 0x4c, 0x16, 0xe6, 0x12, 0x3f, 0xdd, 0x65, 0xc1, 0x36, 0x3f, 0x43, 0x77, 0x00,
-	0xb9, 0xe4, 0x29, 0x66, 0xc4, 0xc3, 0x29, 0xcf, 0x92, 0xf0, 0x5c, 0x8c, 0x2d, 0xb1, 0xb7, 0xf1,
-	0x3d, 0xe8, 0x49, 0x01, 0x1e, 0xd1, 0xc1, 0x03, 0xc7, 0x19, 0xe0, 0x30, 0x99, 0xb7, 0x35, 0x78,
-	0xcf, 0x8e, 0x4e, 0x04, 0x54, 0xa5, 0x13, 0x7f, 0x9d, 0x5a, 0x07, 0x5e, 0x6b, 0x70, 0xb5, 0x90,
-	0xc0, 0xc5, 0x74, 0x61, 0x0f, 0x16, 0xbf, 0x7a, 0x46, 0x18, 0x76, 0x49, 0xc8, 0xa6, 0xfd, 0xe8,
-	0xbe, 0x83, 0xa5, 0x54, 0x6c, 0x99, 0xdf, 0x35, 0xa8, 0x1c, 0xc6, 0x87, 0x22, 0xc9, 0x4a, 0xe7,
-	0xec, 0x60, 0x7a, 0x79, 0xdd, 0x85, 0xe5, 0xc7, 0x61, 0x82, 0x8e, 0x9d, 0x89, 0x0d, 0x36, 0xbe,
-	0x86, 0x0f, 0x32, 0x37, 0x24, 0xe3, 0xf3, 0x67, 0xe2, 0x13, 0xa8, 0x91, 0xb0, 0x7b, 0x78, 0x76,
-	0x47, 0x30, 0x7e, 0xbf, 0xb3, 0x40, 0xd2, 0x81, 0x8c, 0x0d, 0xb8, 0xf2, 0x05, 0x67, 0xbd, 0x83,
-	0x59, 0xfa, 0xcd, 0x2f, 0xc3, 0x1c, 0xf1, 0x1d, 0xfc, 0x42, 0x0e, 0x75, 0xf4, 0xc5, 0xd8, 0x86,
-	0x65, 0xd5, 0x59, 0xb2, 0xd8, 0x80, 0x32, 0x57, 0x07, 0xd9, 0x8e, 0x25, 0x55, 0x4e, 0x29, 0x75,
-	0xa5, 0x98, 0x0a, 0x27, 0xe3, 0x1b, 0x89, 0xf8, 0xc0, 0x75, 0xd3, 0x88, 0xd3, 0x6a, 0xec, 0x6b,
-	0x4d, 0x92, 0x4c, 0xe2, 0xe7, 0x48, 0xce, 0x4e, 0x24, 0x39, 0xbd, 0x5e, 0xdf, 0x07, 0xe0, 0x2f,
-	0x63, 0xe2, 0x13, 0xae, 0x41, 0x89, 0x38, 0x52, 0x18, 0x4a, 0xc4, 0x31, 0x5a, 0x50, 0x15, 0xf7,
-	0x24, 0x79, 0x03, 0x66, 0x3d, 0x16, 0xc8, 0xb2, 0xe4, 0x1f, 0x1e, 0x37, 0x6e, 0xfe, 0x03, 0x30,
-	0x27, 0x32, 0x47, 0x07, 0x30, 0x1f, 0x6d, 0x31, 0xa4, 0x17, 0xac, 0x36, 0x49, 0x46, 0xbf, 0x5a,
-	0x68, 0x8b, 0x00, 0x8d, 0xc6, 0xcb, 0xdf, 0xff, 0xfe, 0xa5, 0x64, 0xa0, 0x75, 0x8b, 0x3b, 0x35,
-	0x7d, 0xcc, 0x0e, 0xe9, 0xe0, 0xc0, 0xca, 0xef, 0x69, 0xf4, 0x93, 0x06, 0x97, 0xc4, 0x40, 0x48,
-	0xdd, 0x40, 0x1f, 0x66, 0x4a, 0xab, 0xae, 0x4d, 0xbd, 0x7e, 0x9e, 0x59, 0x22, 0x7f, 0x2e, 0x90,
-	0xef, 0xa3, 0x7b, 0x63, 0x90, 0xe3, 0x4b, 0xd6, 0x51, 0xea, 0xa7, 0xc4, 0x01, 0x1e, 0x1d, 0xa3,
-	0x5f, 0x35, 0x40, 0x69, 0x36, 0xd1, 0x1e, 0x41, 0x1f, 0x17, 0x83, 0x2a, 0x8b, 0x4e, 0xbf, 0x31,
-	0xde, 0x49, 0xf2, 0xdb, 0x15, 0xfc, 0x76, 0xd0, 0xc3, 0xf3, 0xf9, 0x71, 0x19, 0x6c, 0xf6, 0x46,
-	0x4d, 0x3e, 0x4a, 0xd6, 0x51, 0x6a, 0x79, 0x1e, 0xe7, 0x09, 0xfb, 0x50, 0xd9, 0xc1, 0x2c, 0x5a,
-	0x2e, 0x99, 0x76, 0x29, 0x2b, 0x28, 0xd3, 0x2e, 0x75, 0x1b, 0xbd, 0x4b, 0xbb, 0xc2, 0x08, 0xe2,
-	0x37, 0x0d, 0x56, 0xd2, 0x05, 0x3a, 0x93, 0x79, 0x74, 0xab, 0x38, 0xff, 0xdc, 0x26, 0xd2, 0x1b,
-	0x93, 0x1d, 0xff, 0x63, 0xb1, 0x9e, 0xd2, 0x41, 0x53, 0x3e, 0x05, 0xeb, 0x48, 0x7e, 0x28, 0x28,
-	0x96, 0x9c, 0xb5, 0x44, 0xc0, 0x32, 0xb3, 0x96, 0xdd, 0x16, 0x99, 0x59, 0xcb, 0x09, 0xfe, 0xbb,
-	0xcc, 0x5a, 0xa2, 0xa0, 0x79, 0x36, 0xaf, 0x34, 0x58, 0x50, 0x64, 0x19, 0x5d, 0x57, 0xf0, 0x8a,
-	0x44, 0x5e, 0x37, 0xc6, 0xb9, 0x48, 0x5a, 0x77, 0x05, 0xad, 0xdb, 0xa8, 0x71, 0x3e, 0x2d, 0x12,
-	0x36, 0x53, 0xda, 0x8e, 0x8e, 0xa0, 0x2c, 0xe6, 0x7c, 0x5d, 0x89, 0x5e, 0xa0, 0xec, 0xfa, 0xf5,
-	0x31, 0x1e, 0x12, 0xde, 0x14, 0xf0, 0x0d, 0x74, 0x73, 0xdc, 0x0b, 0xe4, 0x93, 0x2d, 0xb6, 0xc2,
-	0x31, 0xfa, 0x51, 0x83, 0x39, 0x1e, 0x20, 0x2c, 0x82, 0x57, 0x65, 0xbe, 0x08, 0x3e, 0x23, 0xd4,
-	0xc6, 0x67, 0x02, 0xbe, 0x85, 0xac, 0x49, 0xf0, 0xf9, 0xa7, 0x34, 0xbb, 0xfb, 0xa4, 0x8d, 0x56,
-	0x73, 0xf2, 0x28, 0xb1, 0xd7, 0xf2, 0x06, 0x09, 0x79, 0x4f, 0x40, 0x9a, 0xe8, 0xce, 0xd8, 0x31,
-	0x4d, 0x4f, 0x26, 0x71, 0x8e, 0xb7, 0x1e, 0xbe, 0x39, 0xa9, 0x6b, 0x6f, 0x4f, 0xea, 0xda, 0x5f,
-	0x27, 0x75, 0xed, 0xe7, 0xd3, 0xfa, 0xcc, 0xdb, 0xd3, 0xfa, 0xcc, 0x1f, 0xa7, 0xf5, 0x99, 0xbd,
-	0x8d, 0x3e, 0x61, 0xcf, 0x86, 0x3d, 0x73, 0x9f, 0x7a, 0x05, 0x11, 0x5f, 0x28, 0xff, 0x67, 0x7a,
-	0xf3, 0xe2, 0x8f, 0xcb, 0xa7, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x18, 0x6f, 0x92, 0x21, 0x8b,
-	0x0d, 0x00, 0x00,
+	// 1364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0xda, 0x4e, 0xc0, 0x2f, 0xc4, 0x84, 0xc1, 0x24, 0x66, 0x09, 0x06, 0xb6, 0xfc, 0xb1,
+	0x00, 0xef, 0x12, 0x8a, 0xe0, 0xd2, 0x0b, 0x06, 0x62, 0x45, 0x6a, 0x84, 0x6b, 0x90, 0xda, 0x22,
+	0x55, 0xd6, 0xda, 0x3b, 0x98, 0x55, 0xbc, 0x3b, 0xcb, 0xee, 0xb8, 0x89, 0x1b, 0xa5, 0xaa, 0x90,
+	0x90, 0x90, 0xca, 0xa1, 0x52, 0x7b, 0xa8, 0xd4, 0x6f, 0xd0, 0x43, 0x2f, 0x3d, 0x54, 0xea, 0x27,
+	0xe0, 0x88, 0xd4, 0x4b, 0xd5, 0x03, 0xad, 0x92, 0x7e, 0x89, 0xde, 0xaa, 0x99, 0x9d, 0x75, 0x76,
+	0xbd, 0x6b, 0x3b, 0x44, 0x96, 0x72, 0x4a, 0x3c, 0xef, 0xcd, 0xfb, 0xfd, 0xde, 0x9b, 0xf7, 0x66,
+	0x7e, 0x36, 0x2c, 0xe2, 0x4e, 0xcf, 0xd3, 0x2c, 0xdd, 0x6d, 0x9b, 0xb6, 0xf6, 0xbc, 0x8b, 0xdd,
+	0x9e, 0xea, 0xb8, 0x84, 0x12, 0x34, 0xcb, 0x0c, 0xaa, 0x6f, 0x90, 0xf3, 0x6d, 0xd2, 0x26, 0x7c,
+	0x5d, 0x63, 0xff, 0xf9, 0x2e, 0x72, 0xb1, 0x45, 0x3c, 0x8b, 0x78, 0x5a, 0x53, 0xf7, 0xb0, 0xf6,
+	0xe5, 0x72, 0x13, 0x53, 0x7d, 0x59, 0x6b, 0x11, 0xd3, 0x16, 0xf6, 0xa5, 0x36, 0x21, 0xed, 0x0e,
+	0xd6, 0x74, 0xc7, 0xd4, 0x74, 0xdb, 0x26, 0x54, 0xa7, 0x26, 0xb1, 0x3d, 0x61, 0xbd, 0x1a, 0xde,
+	0xcd, 0x91, 0xfb, 0x31, 0x1c, 0xbd, 0x6d, 0xda, 0xdc, 0x59, 0xf8, 0x16, 0xc2, 0x2c, 0x1d, 0xdd,
+	0xd5, 0xad, 0x20, 0x4a, 0x84, 0x3f, 0xed, 0x39, 0x38, 0x30, 0x2c, 0x44, 0xb6, 0x10, 0xd2, 0xf1,
+	0xd7, 0x95, 0xe3, 0x30, 0x57, 0xe3, 0x01, 0xea, 0xf8, 0x79, 0x17, 0x7b, 0x54, 0xb9, 0x07, 0xb9,
+	0x60, 0xc1, 0x73, 0x88, 0xed, 0x61, 0xb4, 0x0c, 0x33, 0x3e, 0x46, 0x41, 0x3a, 0x2f, 0x95, 0x66,
+	0x6f, 0x9e, 0x54, 0x43, 0xb5, 0x50, 0x7d, 0xe7, 0x4a, 0xe6, 0xcd, 0xbb, 0x73, 0x53, 0x75, 0xe1,
+	0xa8, 0x3c, 0x81, 0xf9, 0x1a, 0xf1, 0x4c, 0x9e, 0x9f, 0x08, 0x8c, 0x56, 0x00, 0xf6, 0x12, 0x11,
+	0xa1, 0x2e, 0xab, 0x7e, 0xd6, 0x2a, 0xcb, 0x5a, 0xf5, 0xeb, 0x2d, 0xb2, 0x56, 0x6b, 0x7a, 0x1b,
+	0x8b, 0xbd, 0xf5, 0xd0, 0x4e, 0xe5, 0x85, 0x04, 0x27, 0x42, 0xc1, 0x05, 0xc9, 0x8b, 0x90, 0xb1,
+	0xa8, 0xc3, 0x28, 0xa6, 0x4b, 0xb3, 0x37, 0xe7, 0x23, 0x14, 0xd7, 0x1e, 0xd7, 0xea, 0xdc, 0x8a,
+	0xaa, 0x11, 0x0e, 0x29, 0xce, 0xe1, 0xca, 0x58, 0x0e, 0x3e, 0x44, 0x84, 0xc4, 0x37, 0x12, 0x2c,
+	0xf4, 0x49, 0x54, 0x7a, 0x35, 0x42, 0x3a, 0x41, 0x9e, 0x45, 0x98, 0xd5, 0x2d, 0xab, 0xc1, 0x6a,
+	0xdc, 0x30, 0x0d, 0x9e, 0x68, 0xa6, 0x9e, 0xd5, 0x2d, 0x8b, 0x39, 0xad, 0x1a, 0x68, 0x25, 0x81,
+	0xc3, 0x41, 0xea, 0xf0, 0x4a, 0x82, 0xc5, 0x18, 0x85, 0xc3, 0xa9, 0xc6, 0x71, 0x98, 0x7b, 0x44,
+	0x75, 0xda, 0xed, 0x37, 0x91, 0x01, 0xb9, 0x60, 0xa1, 0xcf, 0x28, 0x47, 0x1c, 0x6c, 0x37, 0x2c,
+	0xea, 0x34, 0x5a, 0xa4, 0x6b, 0x53, 0x51, 0x98, 0x63, 0x6c, 0x75, 0x8d, 0x3a, 0xf7, 0xd8, 0x1a,
+	0xba, 0x0e, 0xa8, 0x63, 0x3e, 0xc5, 0xd4, 0xb4, 0x70, 0xc8, 0x33, 0xc5, 0x3d, 0xe7, 0x03, 0x4b,
+	0xe0, 0xad, 0x7c, 0x0d, 0x72, 0xbf, 0x00, 0x2b, 0xc4, 0xbd, 0x6b, 0x18, 0x2e, 0xf6, 0xfa, 0xfd,
+	0x56, 0x80, 0x23, 0xba, 0xbf, 0xc2, 0xa1, 0xb2, 0xf5, 0xe0, 0xe3, 0xc4, 0x4e, 0xe0, 0xb5, 0x04,
+	0x67, 0x12, 0x09, 0x1c, 0xce, 0x29, 0x3c, 0x81, 0xf9, 0x4f, 0x9f, 0x99, 0x14, 0x77, 0x4c, 0x8f,
+	0x4e, 0x7a, 0xe8, 0xbe, 0x82, 0x13, 0xa1, 0xd8, 0x22, 0xbf, 0x25, 0xc8, 0x6e, 0x04, 0x8b, 0x3c,
+	0xc9, 0x6c, 0x7d, 0x6f, 0x61, 0x72, 0x79, 0xdd, 0x80, 0xfc, 0xaa, 0xd7, 0x47, 0xc7, 0xc6, 0xd8,
+	0x03, 0x56, 0x3e, 0x83, 0x53, 0x03, 0x3b, 0x04, 0xe3, 0xe1, 0x3d, 0x71, 0x09, 0x72, 0xa6, 0xd7,
+	0xd8, 0xd8, 0xdb, 0xc3, 0x19, 0x1f, 0xad, 0xcf, 0x99, 0xe1, 0x40, 0xca, 0x35, 0x38, 0xf9, 0x09,
+	0x63, 0x5d, 0xc5, 0x34, 0x3c, 0xf3, 0x79, 0x98, 0x36, 0x6d, 0x03, 0x6f, 0x8a, 0xa6, 0xf6, 0x3f,
+	0x28, 0xff, 0xa5, 0x21, 0x1f, 0xf5, 0x16, 0x34, 0xae, 0x41, 0x86, 0x5d, 0x0f, 0xe2, 0x3c, 0x4e,
+	0x44, 0xef, 0x53, 0x42, 0x3a, 0xe2, 0x36, 0xe5, 0x4e, 0xa8, 0x0d, 0x85, 0x0e, 0xb1, 0xdb, 0x0d,
+	0x3e, 0x3e, 0xa6, 0x4d, 0xb1, 0x8b, 0x3d, 0xda, 0x70, 0x59, 0x6d, 0x38, 0xc7, 0x6c, 0x45, 0x65,
+	0xde, 0x7f, 0xbd, 0x3b, 0x77, 0xb9, 0x6d, 0xd2, 0x67, 0xdd, 0xa6, 0xda, 0x22, 0x96, 0x26, 0x5e,
+	0x13, 0xff, 0x4f, 0xd9, 0x33, 0xd6, 0xc5, 0x6b, 0x70, 0x1f, 0xb7, 0xea, 0xa7, 0x58, 0xbc, 0x87,
+	0x0e, 0xb6, 0x57, 0x45, 0xb4, 0x3a, 0x0b, 0x86, 0x4c, 0x38, 0xed, 0x3d, 0x23, 0x2e, 0x4d, 0x44,
+	0x4a, 0x1f, 0x08, 0x69, 0x81, 0x07, 0x8c, 0x43, 0xd9, 0xb0, 0x64, 0xe9, 0x9b, 0x8d, 0xa1, 0x79,
+	0x65, 0x0e, 0x84, 0x56, 0xb0, 0xf4, 0xcd, 0x8f, 0x13, 0x53, 0x73, 0xe0, 0x2c, 0xc3, 0x1b, 0x9e,
+	0xde, 0xf4, 0x81, 0x00, 0x4f, 0x5b, 0xfa, 0xe6, 0xa3, 0xc4, 0x0c, 0x95, 0x2f, 0x44, 0xa3, 0xdc,
+	0xed, 0x74, 0xc2, 0x8d, 0x32, 0xa9, 0x79, 0x7c, 0x2d, 0x41, 0x3e, 0x1a, 0x3f, 0xd6, 0x5a, 0xe9,
+	0xf1, 0xad, 0x35, 0xb1, 0x11, 0xbd, 0x0d, 0xc0, 0x2e, 0xb4, 0xb1, 0x37, 0x6f, 0x0e, 0x52, 0xa6,
+	0x21, 0xee, 0xf3, 0x94, 0x69, 0x28, 0xcb, 0x30, 0xcb, 0xf7, 0x09, 0xf2, 0x0a, 0xa4, 0x2d, 0xea,
+	0x88, 0xb2, 0xc4, 0xef, 0x4b, 0x66, 0x54, 0x16, 0x20, 0xbf, 0x6a, 0x7b, 0x5d, 0x57, 0xb7, 0x5b,
+	0x78, 0xa5, 0x6b, 0x07, 0xb7, 0x81, 0xf2, 0xa3, 0x04, 0xa7, 0x06, 0x0c, 0x63, 0x87, 0x1e, 0xc3,
+	0x91, 0xa6, 0xde, 0x61, 0x1b, 0x0a, 0x29, 0x5e, 0xaf, 0xd3, 0x91, 0xe4, 0x83, 0xb4, 0xef, 0x11,
+	0xd3, 0xae, 0xdc, 0x60, 0x75, 0xfb, 0xf9, 0xef, 0x73, 0xa5, 0x7d, 0xf4, 0x06, 0xdb, 0xe0, 0xd5,
+	0x83, 0xd8, 0xca, 0xe7, 0x70, 0xbc, 0xa2, 0x1b, 0xf7, 0x71, 0x93, 0x4e, 0x5c, 0x0c, 0xfd, 0x20,
+	0xc1, 0xfc, 0x5e, 0x6c, 0x91, 0xf0, 0x1d, 0xc8, 0x36, 0x75, 0xa3, 0x61, 0xb0, 0x45, 0xd1, 0x08,
+	0xf9, 0x48, 0x31, 0xc5, 0x0e, 0xd1, 0x0b, 0x47, 0x9b, 0x22, 0xc0, 0xe4, 0xfa, 0x61, 0x0b, 0x16,
+	0x1f, 0xba, 0x06, 0x76, 0x0f, 0xe5, 0x59, 0xfe, 0x49, 0x82, 0x42, 0x1c, 0x5d, 0xd4, 0xe6, 0x36,
+	0xcc, 0x10, 0x6e, 0x13, 0x85, 0x29, 0x44, 0xbb, 0x8c, 0xff, 0xe1, 0x9b, 0x03, 0x45, 0xeb, 0x7b,
+	0x4f, 0xac, 0x34, 0x37, 0x7f, 0xcb, 0xc1, 0x34, 0x9f, 0x5c, 0xb4, 0x0e, 0x33, 0xbe, 0x78, 0x46,
+	0x72, 0x82, 0xa2, 0x16, 0x99, 0xc9, 0x67, 0x12, 0x6d, 0x7e, 0x60, 0xa5, 0xf4, 0xe2, 0x8f, 0x7f,
+	0xbf, 0x4f, 0x29, 0xe8, 0xbc, 0xc6, 0x9c, 0xca, 0x36, 0xa6, 0x1b, 0xc4, 0x5d, 0xd7, 0xe2, 0x5f,
+	0x0f, 0xd0, 0xb7, 0x12, 0x1c, 0xe3, 0xcf, 0x90, 0x90, 0x2b, 0xe8, 0x6c, 0x34, 0xee, 0x80, 0x5a,
+	0x97, 0x8b, 0xc3, 0xcc, 0x02, 0xf9, 0x23, 0x8e, 0x7c, 0x1b, 0xdd, 0x1a, 0x81, 0x1c, 0x6c, 0xd2,
+	0xb6, 0x42, 0xdf, 0x60, 0xd6, 0x71, 0x6f, 0x1b, 0xfd, 0x22, 0x01, 0x0a, 0xb3, 0xf1, 0xe5, 0x2b,
+	0xfa, 0x20, 0x19, 0x34, 0xa2, 0xaf, 0xe5, 0x8b, 0xa3, 0x9d, 0x04, 0xbf, 0x35, 0xce, 0xaf, 0x8a,
+	0x1e, 0x0c, 0xe7, 0xc7, 0xd4, 0x57, 0xb9, 0xd9, 0x2b, 0xb3, 0xab, 0x50, 0xdb, 0x0a, 0x69, 0xf6,
+	0xed, 0x38, 0x61, 0x1b, 0xb2, 0x55, 0x4c, 0x7d, 0x4d, 0x3b, 0x70, 0x5c, 0x11, 0xe5, 0x2b, 0x9f,
+	0x49, 0xb4, 0xed, 0xff, 0xb8, 0x3c, 0x1f, 0xe2, 0x77, 0x09, 0x16, 0xc2, 0x05, 0xda, 0xeb, 0x64,
+	0x74, 0x25, 0x39, 0xff, 0xd8, 0xa4, 0xc9, 0xa5, 0xf1, 0x8e, 0xef, 0x59, 0xac, 0xa7, 0xc4, 0x2d,
+	0x8b, 0x69, 0xd5, 0xb6, 0xc4, 0x3f, 0x09, 0xc5, 0x12, 0xbd, 0xd6, 0xd7, 0x4d, 0x03, 0xbd, 0x36,
+	0x28, 0x52, 0xe5, 0xe2, 0x30, 0xf3, 0xfe, 0x7b, 0xad, 0x2f, 0xdc, 0xe2, 0x6c, 0x5e, 0x49, 0x30,
+	0x17, 0x51, 0x83, 0xe8, 0x42, 0x04, 0x2f, 0x49, 0x5b, 0xca, 0xca, 0x28, 0x17, 0x41, 0xeb, 0x06,
+	0xa7, 0x75, 0x15, 0x95, 0x86, 0xd3, 0x32, 0xbd, 0x72, 0x48, 0x52, 0xa2, 0x2d, 0xc8, 0xf0, 0x3e,
+	0x3f, 0x1f, 0x89, 0x9e, 0x20, 0x28, 0xe5, 0x0b, 0x23, 0x3c, 0x04, 0xbc, 0xca, 0xe1, 0x4b, 0xe8,
+	0xf2, 0xa8, 0x09, 0x64, 0x9d, 0xcd, 0xc5, 0xe8, 0x36, 0x7a, 0x29, 0xc1, 0x34, 0x0b, 0xe0, 0x25,
+	0xc1, 0x47, 0x65, 0x8a, 0x7c, 0x61, 0x84, 0x87, 0x80, 0xbf, 0xc3, 0xe1, 0x97, 0x91, 0x36, 0x0e,
+	0x3e, 0x3e, 0x4a, 0xe9, 0xb5, 0xc7, 0x35, 0xb4, 0x18, 0x7b, 0xde, 0x05, 0x76, 0x21, 0x6e, 0x10,
+	0x90, 0xb7, 0x38, 0xa4, 0x8a, 0xae, 0x8f, 0x6c, 0xd3, 0x70, 0x67, 0x9a, 0x86, 0x38, 0xff, 0xb0,
+	0x30, 0x18, 0x3c, 0xff, 0x04, 0x35, 0x21, 0x2b, 0xa3, 0x5c, 0xde, 0xe3, 0xfc, 0x83, 0x8d, 0xe5,
+	0xa7, 0x0c, 0xf8, 0xa5, 0x04, 0x47, 0x83, 0xd7, 0x1a, 0x2d, 0x25, 0x3d, 0xc9, 0xfd, 0xe1, 0x3d,
+	0x3b, 0xc4, 0xba, 0xff, 0x91, 0x68, 0xea, 0x46, 0x99, 0x4b, 0x80, 0xf8, 0x11, 0xfc, 0x2a, 0xc1,
+	0xc9, 0x2a, 0xa6, 0x83, 0x8f, 0x24, 0x8a, 0x5e, 0xad, 0x43, 0x5e, 0x70, 0xf9, 0xd2, 0x18, 0x2f,
+	0x41, 0xf1, 0x21, 0xa7, 0xb8, 0x8a, 0xaa, 0xc3, 0x29, 0xfa, 0x6f, 0xeb, 0x3e, 0xaf, 0x95, 0xca,
+	0x83, 0x37, 0x3b, 0x45, 0xe9, 0xed, 0x4e, 0x51, 0xfa, 0x67, 0xa7, 0x28, 0x7d, 0xb7, 0x5b, 0x9c,
+	0x7a, 0xbb, 0x5b, 0x9c, 0xfa, 0x73, 0xb7, 0x38, 0xf5, 0xe4, 0x5a, 0x48, 0x93, 0xc5, 0xc1, 0x36,
+	0x23, 0xbf, 0x87, 0x35, 0x67, 0xf8, 0x0f, 0x5f, 0x1f, 0xfe, 0x3f, 0x00, 0x3a, 0xc0, 0x1e, 0x6f,
+	0xeb, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1101,6 +1425,12 @@ type QueryClient interface {
 	Pools(ctx context.Context, in *QueryAllPoolRequest, opts ...grpc.CallOption) (*QueryAllPoolResponse, error)
 	// Queries a list of MTP items.
 	MTP(ctx context.Context, in *MTPRequest, opts ...grpc.CallOption) (*MTPResponse, error)
+	// Queries the balance of the insurance fund.
+	InsuranceFund(ctx context.Context, in *InsuranceFundRequest, opts ...grpc.CallOption) (*InsuranceFundResponse, error)
+	// Queries a list of BadDebts items.
+	BadDebts(ctx context.Context, in *BadDebtsRequest, opts ...grpc.CallOption) (*BadDebtsResponse, error)
+	// Queries a list of pending margin orders of an address.
+	GetOrdersForAddress(ctx context.Context, in *OrdersForAddressRequest, opts ...grpc.CallOption) (*OrdersForAddressResponse, error)
 }
 
 type queryClient struct {
@@ -1201,6 +1531,33 @@ func (c *queryClient) MTP(ctx context.Context, in *MTPRequest, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *queryClient) InsuranceFund(ctx context.Context, in *InsuranceFundRequest, opts ...grpc.CallOption) (*InsuranceFundResponse, error) {
+	out := new(InsuranceFundResponse)
+	err := c.cc.Invoke(ctx, "/elys.margin.Query/InsuranceFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BadDebts(ctx context.Context, in *BadDebtsRequest, opts ...grpc.CallOption) (*BadDebtsResponse, error) {
+	out := new(BadDebtsResponse)
+	err := c.cc.Invoke(ctx, "/elys.margin.Query/BadDebts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetOrdersForAddress(ctx context.Context, in *OrdersForAddressRequest, opts ...grpc.CallOption) (*OrdersForAddressResponse, error) {
+	out := new(OrdersForAddressResponse)
+	err := c.cc.Invoke(ctx, "/elys.margin.Query/GetOrdersForAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1222,6 +1579,12 @@ type QueryServer interface {
 	Pools(context.Context, *QueryAllPoolRequest) (*QueryAllPoolResponse, error)
 	// Queries a list of MTP items.
 	MTP(context.Context, *MTPRequest) (*MTPResponse, error)
+	// Queries the balance of the insurance fund.
+	InsuranceFund(context.Context, *InsuranceFundRequest) (*InsuranceFundResponse, error)
+	// Queries a list of BadDebts items.
+	BadDebts(context.Context, *BadDebtsRequest) (*BadDebtsResponse, error)
+	// Queries a list of pending margin orders of an address.
+	GetOrdersForAddress(context.Context, *OrdersForAddressRequest) (*OrdersForAddressResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1258,6 +1621,15 @@ func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryAllPoolReq
 func (*UnimplementedQueryServer) MTP(ctx context.Context, req *MTPRequest) (*MTPResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MTP not implemented")
 }
+func (*UnimplementedQueryServer) InsuranceFund(ctx context.Context, req *InsuranceFundRequest) (*InsuranceFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsuranceFund not implemented")
+}
+func (*UnimplementedQueryServer) BadDebts(ctx context.Context, req *BadDebtsRequest) (*BadDebtsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BadDebts not implemented")
+}
+func (*UnimplementedQueryServer) GetOrdersForAddress(ctx context.Context, req *OrdersForAddressRequest) (*OrdersForAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrdersForAddress not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1443,6 +1815,60 @@ func _Query_MTP_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InsuranceFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsuranceFundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InsuranceFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.margin.Query/InsuranceFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InsuranceFund(ctx, req.(*InsuranceFundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BadDebts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BadDebtsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BadDebts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.margin.Query/BadDebts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BadDebts(ctx, req.(*BadDebtsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetOrdersForAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrdersForAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOrdersForAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/elys.margin.Query/GetOrdersForAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOrdersForAddress(ctx, req.(*OrdersForAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "elys.margin.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1487,6 +1913,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MTP",
 			Handler:    _Query_MTP_Handler,
 		},
+		{
+			MethodName: "InsuranceFund",
+			Handler:    _Query_InsuranceFund_Handler,
+		},
+		{
+			MethodName: "BadDebts",
+			Handler:    _Query_BadDebts_Handler,
+		},
+		{
+			MethodName: "GetOrdersForAddress",
+			Handler:    _Query_GetOrdersForAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "elys/margin/query.proto",
@@ -2066,18 +2504,58 @@ func (m *QueryGetPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.MaxShortOpenInterestRatio.Size()
+		i -= size
+		if _, err := m.MaxShortOpenInterestRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxLongOpenInterestRatio.Size()
+		i -= size
+		if _, err := m.MaxLongOpenInterestRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ShortOpenInterestRatio.Size()
+		i -= size
+		if _, err := m.ShortOpenInterestRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LongOpenInterestRatio.Size()
+		i -= size
+		if _, err := m.LongOpenInterestRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAllPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2232,6 +2710,248 @@ func (m *MTPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InsuranceFundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BadDebtsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BadDebtsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadDebtsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BadDebtsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BadDebtsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadDebtsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BadDebts) > 0 {
+		for iNdEx := len(m.BadDebts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BadDebts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrdersForAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrdersForAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrdersForAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrdersForAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrdersForAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrdersForAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2471,6 +3191,14 @@ func (m *QueryGetPoolResponse) Size() (n int) {
 	_ = l
 	l = m.Pool.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.LongOpenInterestRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ShortOpenInterestRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxLongOpenInterestRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxShortOpenInterestRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -2535,10 +3263,106 @@ func (m *MTPResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
+func (m *InsuranceFundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InsuranceFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BadDebtsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BadDebtsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BadDebts) > 0 {
+		for _, e := range m.BadDebts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OrdersForAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OrdersForAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
@@ -3409,26 +4233,704 @@ func (m *PositionsForAddressResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mtps = append(m.Mtps, &MTP{})
+			if err := m.Mtps[len(m.Mtps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhitelistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhitelistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhitelistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhitelistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IsWhitelistedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IsWhitelistedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IsWhitelistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IsWhitelistedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IsWhitelistedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IsWhitelistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsWhitelisted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsWhitelisted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongOpenInterestRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LongOpenInterestRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortOpenInterestRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShortOpenInterestRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLongOpenInterestRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mtps = append(m.Mtps, &MTP{})
-			if err := m.Mtps[len(m.Mtps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxLongOpenInterestRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxShortOpenInterestRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3438,25 +4940,23 @@ func (m *PositionsForAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxShortOpenInterestRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3481,7 +4981,7 @@ func (m *PositionsForAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WhitelistRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3504,10 +5004,10 @@ func (m *WhitelistRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WhitelistRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3567,7 +5067,7 @@ func (m *WhitelistRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WhitelistResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3590,17 +5090,17 @@ func (m *WhitelistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WhitelistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3610,23 +5110,25 @@ func (m *WhitelistResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
+			m.Pool = append(m.Pool, Pool{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3685,7 +5187,7 @@ func (m *WhitelistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IsWhitelistedRequest) Unmarshal(dAtA []byte) error {
+func (m *MTPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3708,10 +5210,10 @@ func (m *IsWhitelistedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IsWhitelistedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MTPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IsWhitelistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MTPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3746,6 +5248,25 @@ func (m *IsWhitelistedRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3767,7 +5288,7 @@ func (m *IsWhitelistedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IsWhitelistedResponse) Unmarshal(dAtA []byte) error {
+func (m *MTPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3790,17 +5311,17 @@ func (m *IsWhitelistedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IsWhitelistedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MTPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IsWhitelistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MTPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mtp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3810,44 +5331,28 @@ func (m *IsWhitelistedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsWhitelisted", wireType)
+			if m.Mtp == nil {
+				m.Mtp = &MTP{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Mtp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IsWhitelisted = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3869,7 +5374,7 @@ func (m *IsWhitelistedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *InsuranceFundRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3892,31 +5397,12 @@ func (m *QueryGetPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InsuranceFundRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InsuranceFundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3938,7 +5424,7 @@ func (m *QueryGetPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *InsuranceFundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3961,15 +5447,47 @@ func (m *QueryGetPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InsuranceFundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InsuranceFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3996,7 +5514,8 @@ func (m *QueryGetPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4021,7 +5540,7 @@ func (m *QueryGetPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *BadDebtsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4044,10 +5563,10 @@ func (m *QueryAllPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BadDebtsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BadDebtsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4107,7 +5626,7 @@ func (m *QueryAllPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *BadDebtsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4130,15 +5649,15 @@ func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BadDebtsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BadDebtsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4165,8 +5684,8 @@ func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pool = append(m.Pool, Pool{})
-			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BadDebts = append(m.BadDebts, BadDebt{})
+			if err := m.BadDebts[len(m.BadDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4227,7 +5746,7 @@ func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MTPRequest) Unmarshal(dAtA []byte) error {
+func (m *OrdersForAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4250,10 +5769,10 @@ func (m *MTPRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MTPRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrdersForAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MTPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrdersForAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4289,10 +5808,10 @@ func (m *MTPRequest) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4302,11 +5821,28 @@ func (m *MTPRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4328,7 +5864,7 @@ func (m *MTPRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MTPResponse) Unmarshal(dAtA []byte) error {
+func (m *OrdersForAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4351,15 +5887,15 @@ func (m *MTPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MTPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrdersForAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MTPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrdersForAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mtp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4386,10 +5922,44 @@ func (m *MTPResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mtp == nil {
-				m.Mtp = &MTP{}
+			m.Orders = append(m.Orders, MarginOrder{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Mtp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
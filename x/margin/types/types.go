@@ -35,6 +35,9 @@ func NewMTP(signer string, collateralAsset string, borrowAsset string, position
 		ConsolidateLeverage:       leverage,
 		SumCollateral:             sdk.ZeroInt(),
 		TakeProfitPrice:           takeProfitPrice,
+		StopLossPrice:             sdk.ZeroDec(),
+		UnitHealthPrice:           sdk.ZeroDec(),
+		LastFundingIndex:          sdk.ZeroDec(),
 	}
 }
 
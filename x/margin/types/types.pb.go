@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -53,6 +57,33 @@ func (Position) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_cd1c09c977f732f9, []int{0}
 }
 
+type BadDebtPolicy int32
+
+const (
+	// the shortfall is absorbed by the pool liquidity providers
+	BadDebtPolicy_SOCIALISE_TO_POOL BadDebtPolicy = 0
+	// the shortfall is absorbed by the pool liquidity providers and the pool is closed to new positions
+	BadDebtPolicy_SOCIALISE_AND_CLOSE_POOL BadDebtPolicy = 1
+)
+
+var BadDebtPolicy_name = map[int32]string{
+	0: "SOCIALISE_TO_POOL",
+	1: "SOCIALISE_AND_CLOSE_POOL",
+}
+
+var BadDebtPolicy_value = map[string]int32{
+	"SOCIALISE_TO_POOL":        0,
+	"SOCIALISE_AND_CLOSE_POOL": 1,
+}
+
+func (x BadDebtPolicy) String() string {
+	return proto.EnumName(BadDebtPolicy_name, int32(x))
+}
+
+func (BadDebtPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd1c09c977f732f9, []int{1}
+}
+
 type MTP struct {
 	Address                   string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Collaterals               []types.Coin                             `protobuf:"bytes,2,rep,name=collaterals,proto3" json:"collaterals"`
@@ -71,6 +102,14 @@ type MTP struct {
 	ConsolidateLeverage       github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,15,opt,name=consolidate_leverage,json=consolidateLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"consolidate_leverage"`
 	SumCollateral             github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,16,opt,name=sum_collateral,json=sumCollateral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"sum_collateral"`
 	TakeProfitPrice           github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,17,opt,name=take_profit_price,json=takeProfitPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"take_profit_price"`
+	StopLossPrice             github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,18,opt,name=stop_loss_price,json=stopLossPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stop_loss_price"`
+	// oracle price of the traded asset at which the mtp health reaches one, the liquidation
+	// queue scales it by the safety factor so that it does not depend on the params
+	UnitHealthPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=unit_health_price,json=unitHealthPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unit_health_price"`
+	// funding index of the mtp position up to which its funding has been settled
+	LastFundingIndex github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=last_funding_index,json=lastFundingIndex,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_funding_index"`
+	// block height up to which the mtp interest has been charged
+	LastInterestHeight int64 `protobuf:"varint,21,opt,name=last_interest_height,json=lastInterestHeight,proto3" json:"last_interest_height,omitempty"`
 }
 
 func (m *MTP) Reset()         { *m = MTP{} }
@@ -176,6 +215,193 @@ func (m *MTP) GetAmmPoolId() uint64 {
 	return 0
 }
 
+func (m *MTP) GetLastInterestHeight() int64 {
+	if m != nil {
+		return m.LastInterestHeight
+	}
+	return 0
+}
+
+// BadDebt records a shortfall left by a closed mtp, in base currency
+type BadDebt struct {
+	Id        uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address   string                                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	MtpId     uint64                                 `protobuf:"varint,3,opt,name=mtp_id,json=mtpId,proto3" json:"mtp_id,omitempty"`
+	AmmPoolId uint64                                 `protobuf:"varint,4,opt,name=amm_pool_id,json=ammPoolId,proto3" json:"amm_pool_id,omitempty"`
+	Shortfall github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=shortfall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shortfall"`
+	// part of the shortfall paid by the insurance fund
+	Covered github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=covered,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"covered"`
+	// part of the shortfall left to the pool
+	Socialised  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=socialised,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"socialised"`
+	BlockHeight int64                                  `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *BadDebt) Reset()         { *m = BadDebt{} }
+func (m *BadDebt) String() string { return proto.CompactTextString(m) }
+func (*BadDebt) ProtoMessage()    {}
+func (*BadDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd1c09c977f732f9, []int{1}
+}
+func (m *BadDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BadDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadDebt.Merge(m, src)
+}
+func (m *BadDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadDebt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadDebt proto.InternalMessageInfo
+
+func (m *BadDebt) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BadDebt) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BadDebt) GetMtpId() uint64 {
+	if m != nil {
+		return m.MtpId
+	}
+	return 0
+}
+
+func (m *BadDebt) GetAmmPoolId() uint64 {
+	if m != nil {
+		return m.AmmPoolId
+	}
+	return 0
+}
+
+func (m *BadDebt) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// MarginOrder is a pending order opening a position when the oracle price reaches the trigger price
+type MarginOrder struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// escrowed collateral
+	Collateral      types.Coin                             `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral"`
+	BorrowAsset     string                                 `protobuf:"bytes,4,opt,name=borrow_asset,json=borrowAsset,proto3" json:"borrow_asset,omitempty"`
+	Position        Position                               `protobuf:"varint,5,opt,name=position,proto3,enum=elys.margin.Position" json:"position,omitempty"`
+	Leverage        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=leverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leverage"`
+	TakeProfitPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=take_profit_price,json=takeProfitPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"take_profit_price"`
+	StopLossPrice   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=stop_loss_price,json=stopLossPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stop_loss_price"`
+	TriggerPrice    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	Expiry          *time.Time                             `protobuf:"bytes,10,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+	AmmPoolId       uint64                                 `protobuf:"varint,11,opt,name=amm_pool_id,json=ammPoolId,proto3" json:"amm_pool_id,omitempty"`
+}
+
+func (m *MarginOrder) Reset()         { *m = MarginOrder{} }
+func (m *MarginOrder) String() string { return proto.CompactTextString(m) }
+func (*MarginOrder) ProtoMessage()    {}
+func (*MarginOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd1c09c977f732f9, []int{2}
+}
+func (m *MarginOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarginOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarginOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarginOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarginOrder.Merge(m, src)
+}
+func (m *MarginOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarginOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarginOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarginOrder proto.InternalMessageInfo
+
+func (m *MarginOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MarginOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MarginOrder) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
+}
+
+func (m *MarginOrder) GetBorrowAsset() string {
+	if m != nil {
+		return m.BorrowAsset
+	}
+	return ""
+}
+
+func (m *MarginOrder) GetPosition() Position {
+	if m != nil {
+		return m.Position
+	}
+	return Position_UNSPECIFIED
+}
+
+func (m *MarginOrder) GetExpiry() *time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *MarginOrder) GetAmmPoolId() uint64 {
+	if m != nil {
+		return m.AmmPoolId
+	}
+	return 0
+}
+
 type WhiteList struct {
 	ValidatorList []string `protobuf:"bytes,1,rep,name=validator_list,json=validatorList,proto3" json:"validator_list,omitempty"`
 }
@@ -184,7 +410,7 @@ func (m *WhiteList) Reset()         { *m = WhiteList{} }
 func (m *WhiteList) String() string { return proto.CompactTextString(m) }
 func (*WhiteList) ProtoMessage()    {}
 func (*WhiteList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd1c09c977f732f9, []int{1}
+	return fileDescriptor_cd1c09c977f732f9, []int{3}
 }
 func (m *WhiteList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,55 +448,83 @@ func (m *WhiteList) GetValidatorList() []string {
 
 func init() {
 	proto.RegisterEnum("elys.margin.Position", Position_name, Position_value)
+	proto.RegisterEnum("elys.margin.BadDebtPolicy", BadDebtPolicy_name, BadDebtPolicy_value)
 	proto.RegisterType((*MTP)(nil), "elys.margin.MTP")
+	proto.RegisterType((*BadDebt)(nil), "elys.margin.BadDebt")
+	proto.RegisterType((*MarginOrder)(nil), "elys.margin.MarginOrder")
 	proto.RegisterType((*WhiteList)(nil), "elys.margin.WhiteList")
 }
 
 func init() { proto.RegisterFile("elys/margin/types.proto", fileDescriptor_cd1c09c977f732f9) }
 
 var fileDescriptor_cd1c09c977f732f9 = []byte{
-	// 641 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x5d, 0x4f, 0xdb, 0x3c,
-	0x14, 0x80, 0x9b, 0xb6, 0x40, 0x73, 0xf2, 0x52, 0x8a, 0xa1, 0x6a, 0xe0, 0x95, 0x42, 0x85, 0xb4,
-	0xa9, 0xda, 0x44, 0x32, 0xd8, 0xf5, 0x2e, 0xc6, 0xc7, 0x46, 0xa5, 0x02, 0x51, 0x00, 0x21, 0xb1,
-	0x8b, 0xc8, 0x4d, 0x4c, 0x6b, 0x91, 0xc4, 0x51, 0xec, 0xb2, 0xf1, 0x2f, 0xf6, 0xb3, 0xb8, 0xe4,
-	0x72, 0x9a, 0x34, 0x34, 0xc1, 0x1f, 0x99, 0x92, 0x7e, 0xc4, 0x6c, 0x37, 0xa5, 0x57, 0xad, 0x7d,
-	0x8e, 0x1f, 0x3f, 0xa7, 0xe7, 0xd4, 0xd0, 0x20, 0xc1, 0x2d, 0xb7, 0x42, 0x9c, 0xf4, 0x68, 0x64,
-	0x89, 0xdb, 0x98, 0x70, 0x33, 0x4e, 0x98, 0x60, 0x48, 0x4b, 0x03, 0xe6, 0x30, 0xb0, 0xbe, 0xda,
-	0x63, 0x3d, 0x96, 0xed, 0x5b, 0xe9, 0xb7, 0x61, 0xca, 0xba, 0xe1, 0x31, 0x1e, 0x32, 0x6e, 0x75,
-	0x31, 0x27, 0xd6, 0xcd, 0x76, 0x97, 0x08, 0xbc, 0x6d, 0x79, 0x8c, 0x46, 0xc3, 0xf8, 0xe6, 0x2f,
-	0x15, 0x4a, 0x47, 0x67, 0x36, 0xd2, 0x61, 0x01, 0xfb, 0x7e, 0x42, 0x38, 0xd7, 0x95, 0xa6, 0xd2,
-	0x52, 0x9d, 0xf1, 0x12, 0x7d, 0x04, 0xcd, 0x63, 0x41, 0x80, 0x05, 0x49, 0x70, 0xc0, 0xf5, 0x62,
-	0xb3, 0xd4, 0xd2, 0x76, 0xd6, 0xcc, 0x21, 0xd7, 0x4c, 0xb9, 0xe6, 0x88, 0x6b, 0xee, 0x31, 0x1a,
-	0xed, 0x96, 0xef, 0x1e, 0x36, 0x0a, 0x8e, 0x7c, 0x06, 0xd9, 0xa0, 0x05, 0x14, 0x77, 0x69, 0x40,
-	0x05, 0x25, 0x5c, 0x2f, 0xa5, 0x17, 0xec, 0x9a, 0x69, 0xde, 0xcf, 0x87, 0x8d, 0xd7, 0x3d, 0x2a,
-	0xfa, 0x83, 0xae, 0xe9, 0xb1, 0xd0, 0x1a, 0xc9, 0x0e, 0x3f, 0xb6, 0xb8, 0x7f, 0x3d, 0x2a, 0xb7,
-	0x1d, 0x09, 0x47, 0x46, 0xa0, 0x2f, 0xb0, 0x46, 0x23, 0x41, 0x12, 0xc2, 0x85, 0x1b, 0x63, 0xea,
-	0xbb, 0xb2, 0x62, 0x79, 0x3a, 0xc5, 0xc6, 0x98, 0x60, 0x63, 0xea, 0xef, 0x49, 0xba, 0x17, 0xd0,
-	0xf8, 0x0b, 0x3e, 0xe0, 0x82, 0xf9, 0xa9, 0xfa, 0xdc, 0x74, 0xe8, 0xfa, 0x33, 0xf4, 0xf8, 0x34,
-	0x72, 0xe1, 0xff, 0x09, 0x78, 0x10, 0xfd, 0xe3, 0x3d, 0x3f, 0x1d, 0x7c, 0x52, 0xf9, 0x79, 0x86,
-	0x90, 0xcd, 0x3f, 0x80, 0x9a, 0xbb, 0x2e, 0x4c, 0x87, 0xcb, 0x4f, 0xa0, 0x2b, 0x68, 0x08, 0x7c,
-	0x4d, 0xdc, 0x38, 0x61, 0x57, 0x54, 0xb8, 0x72, 0xcf, 0x2a, 0x33, 0xf5, 0xac, 0x9e, 0xe2, 0xec,
-	0x8c, 0xd6, 0x91, 0xba, 0x77, 0x0a, 0x75, 0xf9, 0x9e, 0x5c, 0x59, 0x9d, 0x4e, 0x79, 0x25, 0xc7,
-	0xe6, 0x3f, 0x6e, 0x07, 0xd4, 0x80, 0xdc, 0x90, 0x04, 0xf7, 0x08, 0xd7, 0xa1, 0x59, 0x7a, 0xa1,
-	0xee, 0x3e, 0xf1, 0x9c, 0x1c, 0x80, 0x8e, 0x00, 0x42, 0x11, 0xbb, 0x7d, 0x82, 0x03, 0xd1, 0xd7,
-	0xb5, 0x17, 0x57, 0x9f, 0xe1, 0x42, 0x11, 0x1f, 0x66, 0x00, 0xb4, 0x0d, 0x95, 0x98, 0x71, 0x2a,
-	0x28, 0x8b, 0xf4, 0xff, 0x9a, 0x4a, 0xab, 0xba, 0x53, 0x37, 0xa5, 0x3f, 0xaf, 0x69, 0x8f, 0x82,
-	0xce, 0x24, 0x0d, 0x55, 0xa1, 0x48, 0x7d, 0x7d, 0xb1, 0xa9, 0xb4, 0xca, 0x4e, 0x91, 0xfa, 0xc8,
-	0x00, 0x0d, 0x87, 0xa1, 0x1b, 0x33, 0x16, 0xb8, 0xd4, 0xd7, 0xab, 0x59, 0x40, 0xc5, 0x61, 0x68,
-	0x33, 0x16, 0xb4, 0x7d, 0x84, 0x61, 0xd5, 0x63, 0x11, 0x67, 0x01, 0xf5, 0xb1, 0x20, 0xee, 0xb8,
-	0x14, 0x7d, 0x69, 0x26, 0xf7, 0x15, 0x89, 0xd5, 0x19, 0xa1, 0xd0, 0x39, 0x54, 0xf9, 0x20, 0x94,
-	0x66, 0x56, 0xaf, 0xcd, 0x34, 0x16, 0x8b, 0x7c, 0x10, 0xe6, 0x63, 0x8b, 0x2e, 0x61, 0x59, 0x1e,
-	0x87, 0x38, 0xa1, 0x1e, 0xd1, 0x97, 0x67, 0xd2, 0x5e, 0xca, 0x27, 0xc3, 0x4e, 0x31, 0x9b, 0x3b,
-	0xa0, 0x5e, 0xf4, 0xa9, 0x20, 0x1d, 0xca, 0x05, 0x7a, 0x05, 0xd5, 0x1b, 0x9c, 0xd5, 0xc4, 0x12,
-	0x37, 0xa0, 0x5c, 0xe8, 0x4a, 0x3a, 0x27, 0xce, 0xe2, 0x64, 0x37, 0x4d, 0x7b, 0xf3, 0x0e, 0x2a,
-	0xe3, 0x7e, 0xa0, 0x25, 0xd0, 0xce, 0x8f, 0x4f, 0xed, 0x83, 0xbd, 0xf6, 0xa7, 0xf6, 0xc1, 0x7e,
-	0xad, 0x80, 0x2a, 0x50, 0xee, 0x9c, 0x1c, 0x7f, 0xae, 0x29, 0x48, 0x85, 0xb9, 0xd3, 0xc3, 0x13,
-	0xe7, 0xac, 0x56, 0xdc, 0x3d, 0xb8, 0x7b, 0x34, 0x94, 0xfb, 0x47, 0x43, 0xf9, 0xfd, 0x68, 0x28,
-	0xdf, 0x9f, 0x8c, 0xc2, 0xfd, 0x93, 0x51, 0xf8, 0xf1, 0x64, 0x14, 0x2e, 0xdf, 0x4a, 0xe2, 0x69,
-	0xc3, 0xb7, 0x22, 0x22, 0xbe, 0xb2, 0xe4, 0x3a, 0x5b, 0x58, 0xdf, 0x9e, 0xbd, 0xea, 0xdd, 0xf9,
-	0xec, 0x4d, 0x7e, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x29, 0x30, 0x4e, 0x85, 0xf1, 0x05, 0x00,
-	0x00,
+	// 1050 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4d, 0x4f, 0xe3, 0x46,
+	0x18, 0xc7, 0xe3, 0x10, 0xf2, 0xf2, 0x98, 0x40, 0x18, 0x88, 0xf0, 0xd2, 0x2a, 0xa4, 0x48, 0xad,
+	0xd0, 0x56, 0xeb, 0x2c, 0xf4, 0xd2, 0x4b, 0x55, 0xf1, 0xb6, 0x25, 0x55, 0x20, 0x91, 0x03, 0x5d,
+	0x89, 0x56, 0xb2, 0x26, 0xf6, 0xe0, 0x8c, 0xb0, 0x3d, 0xd6, 0xcc, 0x84, 0x85, 0x6f, 0xb1, 0x5f,
+	0xa1, 0xdf, 0x66, 0x8f, 0x7b, 0xac, 0x7a, 0xd8, 0x56, 0xf0, 0x19, 0x7a, 0xac, 0x54, 0xf9, 0x25,
+	0xb1, 0x61, 0xf7, 0x90, 0x5a, 0xed, 0x29, 0x99, 0x79, 0x66, 0x7e, 0xf3, 0xf7, 0x33, 0xff, 0x79,
+	0x66, 0x60, 0x83, 0xb8, 0x77, 0xa2, 0xe3, 0x61, 0xee, 0x50, 0xbf, 0x23, 0xef, 0x02, 0x22, 0xf4,
+	0x80, 0x33, 0xc9, 0x90, 0x1a, 0x06, 0xf4, 0x38, 0xb0, 0xb9, 0xee, 0x30, 0x87, 0x45, 0xfd, 0x9d,
+	0xf0, 0x5f, 0x3c, 0x64, 0xb3, 0x65, 0x31, 0xe1, 0x31, 0xd1, 0x19, 0x61, 0x41, 0x3a, 0x37, 0xbb,
+	0x23, 0x22, 0xf1, 0x6e, 0xc7, 0x62, 0xd4, 0x4f, 0xe2, 0x5b, 0x0e, 0x63, 0x8e, 0x4b, 0x3a, 0x51,
+	0x6b, 0x34, 0xb9, 0xea, 0x48, 0xea, 0x11, 0x21, 0xb1, 0x17, 0xc4, 0x03, 0xb6, 0x7f, 0x5d, 0x82,
+	0x85, 0xd3, 0xf3, 0x01, 0xd2, 0xa0, 0x82, 0x6d, 0x9b, 0x13, 0x21, 0x34, 0xa5, 0xad, 0xec, 0xd4,
+	0x8c, 0x69, 0x13, 0xed, 0x83, 0x6a, 0x31, 0xd7, 0xc5, 0x92, 0x70, 0xec, 0x0a, 0xad, 0xd8, 0x5e,
+	0xd8, 0x51, 0xf7, 0x9e, 0xe9, 0xf1, 0xc2, 0x7a, 0xb8, 0xb0, 0x9e, 0x2c, 0xac, 0x1f, 0x32, 0xea,
+	0x1f, 0x94, 0xde, 0x7d, 0xd8, 0x2a, 0x18, 0xd9, 0x39, 0x68, 0x00, 0xaa, 0x4b, 0xf1, 0x88, 0xba,
+	0x54, 0x52, 0x22, 0xb4, 0x85, 0x70, 0x81, 0x03, 0x3d, 0x1c, 0xf7, 0xfb, 0x87, 0xad, 0xaf, 0x1c,
+	0x2a, 0xc7, 0x93, 0x91, 0x6e, 0x31, 0xaf, 0x93, 0x7c, 0x4d, 0xfc, 0xf3, 0x42, 0xd8, 0xd7, 0x49,
+	0x3e, 0xba, 0xbe, 0x34, 0xb2, 0x08, 0xf4, 0x33, 0x3c, 0xa3, 0xbe, 0x24, 0x9c, 0x08, 0x69, 0x06,
+	0x98, 0xda, 0x66, 0x56, 0x62, 0x69, 0x3e, 0x89, 0x1b, 0x53, 0xc2, 0x00, 0x53, 0xfb, 0x30, 0x23,
+	0xf7, 0x35, 0x6c, 0x3c, 0x81, 0x4f, 0x84, 0x64, 0x76, 0x28, 0x7d, 0x71, 0x3e, 0x74, 0xf3, 0x11,
+	0x7a, 0x3a, 0x1b, 0x99, 0xf0, 0xd9, 0x0c, 0x3c, 0xf1, 0x3f, 0xd2, 0x5d, 0x9e, 0x0f, 0x3e, 0xfb,
+	0xf2, 0x0b, 0x3f, 0x78, 0xa2, 0xfc, 0x3b, 0xa8, 0xa5, 0x5a, 0x2b, 0xf3, 0xe1, 0xd2, 0x19, 0xe8,
+	0x0a, 0x36, 0x24, 0xbe, 0x26, 0x66, 0xc0, 0xd9, 0x15, 0x95, 0x66, 0x76, 0xcf, 0xaa, 0xb9, 0xf6,
+	0xac, 0x19, 0xe2, 0x06, 0x11, 0xad, 0x97, 0xd9, 0xbd, 0x21, 0x34, 0xb3, 0xeb, 0xa4, 0x92, 0x6b,
+	0xf3, 0x49, 0x5e, 0x4b, 0xb1, 0x69, 0x72, 0x7b, 0x50, 0x73, 0xc9, 0x0d, 0xe1, 0xd8, 0x21, 0x42,
+	0x83, 0xf6, 0xc2, 0xbf, 0x94, 0x7b, 0x44, 0x2c, 0x23, 0x05, 0xa0, 0x53, 0x00, 0x4f, 0x06, 0xe6,
+	0x98, 0x60, 0x57, 0x8e, 0x35, 0xb5, 0xad, 0xe4, 0xc1, 0x79, 0x32, 0x38, 0x89, 0x00, 0x68, 0x17,
+	0xaa, 0x01, 0x13, 0x54, 0x52, 0xe6, 0x6b, 0x4b, 0x6d, 0x65, 0x67, 0x79, 0xaf, 0xa9, 0x67, 0x4e,
+	0xb7, 0x3e, 0x48, 0x82, 0xc6, 0x6c, 0x18, 0x5a, 0x86, 0x22, 0xb5, 0xb5, 0x7a, 0x5b, 0xd9, 0x29,
+	0x19, 0x45, 0x6a, 0xa3, 0x16, 0xa8, 0xd8, 0xf3, 0xcc, 0x80, 0x31, 0xd7, 0xa4, 0xb6, 0xb6, 0x1c,
+	0x05, 0x6a, 0xd8, 0xf3, 0x06, 0x8c, 0xb9, 0x5d, 0x1b, 0x61, 0x58, 0xb7, 0x98, 0x2f, 0x98, 0x4b,
+	0x6d, 0x2c, 0x89, 0x39, 0xfd, 0x14, 0x6d, 0x25, 0x97, 0xf6, 0xb5, 0x0c, 0xab, 0x97, 0xa0, 0xd0,
+	0x05, 0x2c, 0x8b, 0x89, 0x97, 0xf1, 0xac, 0xd6, 0xc8, 0x65, 0x8b, 0xba, 0x98, 0x78, 0xa9, 0x6d,
+	0xd1, 0x25, 0xac, 0x66, 0xed, 0x10, 0x70, 0x6a, 0x11, 0x6d, 0x35, 0x97, 0xec, 0x95, 0xd4, 0x19,
+	0x83, 0x10, 0x83, 0x7e, 0x82, 0x15, 0x21, 0x59, 0x60, 0xba, 0x4c, 0x88, 0x84, 0x8c, 0x72, 0x91,
+	0xeb, 0x21, 0xa6, 0xc7, 0x84, 0x88, 0xb9, 0x97, 0xb0, 0x3a, 0xf1, 0xa9, 0x4c, 0x0c, 0x92, 0x90,
+	0xd7, 0xf2, 0x69, 0x0e, 0x41, 0xb1, 0x4f, 0x62, 0xf6, 0x2f, 0x80, 0x5c, 0x2c, 0xa4, 0x79, 0x35,
+	0xf1, 0x6d, 0xea, 0x3b, 0x26, 0xf5, 0x6d, 0x72, 0xab, 0xad, 0xe7, 0x82, 0x37, 0x42, 0xd2, 0xab,
+	0x18, 0xd4, 0x0d, 0x39, 0xe8, 0x25, 0xac, 0x47, 0xf4, 0x59, 0x25, 0x1a, 0x13, 0xea, 0x8c, 0xa5,
+	0xd6, 0x6c, 0x2b, 0x3b, 0x0b, 0x46, 0xb4, 0x72, 0x37, 0x09, 0x9d, 0x44, 0x91, 0xed, 0xbf, 0x8a,
+	0x50, 0x39, 0xc0, 0xf6, 0x11, 0x19, 0xc9, 0xc4, 0x95, 0xca, 0xcc, 0x95, 0x99, 0x7b, 0xa3, 0xf8,
+	0xf8, 0xde, 0x68, 0x42, 0x39, 0x3c, 0x41, 0xd4, 0x8e, 0xea, 0x7d, 0xc9, 0x58, 0xf4, 0x64, 0xd0,
+	0xfd, 0xc8, 0xc6, 0xa5, 0xa7, 0x36, 0xee, 0x41, 0x4d, 0x8c, 0x19, 0x97, 0x57, 0xd8, 0x75, 0xb5,
+	0xc5, 0x5c, 0xf6, 0x4a, 0x01, 0xe8, 0x04, 0x2a, 0x16, 0xbb, 0x21, 0x9c, 0xd8, 0x5a, 0x39, 0x17,
+	0x6b, 0x3a, 0x1d, 0x9d, 0x01, 0x08, 0x66, 0x51, 0xec, 0x52, 0x41, 0x6c, 0xad, 0x92, 0x0b, 0x96,
+	0x21, 0xa0, 0x2f, 0x60, 0x69, 0xe4, 0x32, 0xeb, 0x7a, 0x9a, 0xfe, 0x6a, 0x94, 0x7e, 0x35, 0xea,
+	0x4b, 0xf2, 0xfe, 0x77, 0x09, 0xd4, 0xd3, 0xa8, 0x3e, 0xf4, 0xb9, 0x4d, 0xf8, 0xa7, 0x72, 0x6f,
+	0x71, 0x82, 0x25, 0xe3, 0xd3, 0xdc, 0x27, 0x4d, 0xf4, 0x3d, 0x40, 0xe6, 0x90, 0x86, 0xf9, 0x9f,
+	0xa3, 0xaa, 0x66, 0xa6, 0x44, 0xea, 0x18, 0xe7, 0xec, 0x8d, 0x89, 0x85, 0x20, 0x32, 0xda, 0xa6,
+	0x9a, 0xa1, 0xc6, 0x7d, 0xfb, 0x61, 0xd7, 0xa3, 0x92, 0xb6, 0x38, 0x5f, 0x49, 0xfb, 0x11, 0xaa,
+	0xb3, 0xb2, 0x54, 0xce, 0x65, 0xe7, 0xd9, 0xfc, 0x4f, 0x17, 0x8d, 0xca, 0xff, 0x56, 0x34, 0xaa,
+	0xff, 0x45, 0xd1, 0x18, 0x42, 0x5d, 0x72, 0xea, 0x38, 0x84, 0x27, 0xd4, 0x5a, 0x2e, 0xea, 0x52,
+	0x02, 0x89, 0xa1, 0xdf, 0x42, 0x99, 0xdc, 0x06, 0x94, 0xdf, 0x69, 0x10, 0xed, 0xf3, 0xa6, 0x1e,
+	0xbf, 0xf9, 0xf4, 0xe9, 0x9b, 0x4f, 0x3f, 0x9f, 0xbe, 0xf9, 0x0e, 0x4a, 0x6f, 0xff, 0xd8, 0x52,
+	0x8c, 0x64, 0xfc, 0xd3, 0xa3, 0xa8, 0x3e, 0x39, 0x8a, 0xdb, 0x7b, 0x50, 0x7b, 0x3d, 0xa6, 0x92,
+	0xf4, 0xa8, 0x90, 0xe8, 0x4b, 0x58, 0xbe, 0xc1, 0xd1, 0x7d, 0xc0, 0xb8, 0xe9, 0x52, 0x21, 0x35,
+	0x25, 0xbc, 0x63, 0x8d, 0xfa, 0xac, 0x37, 0x1c, 0xf6, 0xfc, 0x25, 0x54, 0xa7, 0x1b, 0x8f, 0x56,
+	0x40, 0xbd, 0x38, 0x1b, 0x0e, 0x8e, 0x0f, 0xbb, 0xaf, 0xba, 0xc7, 0x47, 0x8d, 0x02, 0xaa, 0x42,
+	0xa9, 0xd7, 0x3f, 0xfb, 0xa1, 0xa1, 0xa0, 0x1a, 0x2c, 0x0e, 0x4f, 0xfa, 0xc6, 0x79, 0xa3, 0xf8,
+	0xfc, 0x08, 0xea, 0x49, 0x71, 0x19, 0x30, 0x97, 0x5a, 0x77, 0xa8, 0x09, 0xab, 0xc3, 0xfe, 0x61,
+	0x77, 0xbf, 0xd7, 0x1d, 0x1e, 0x9b, 0xe7, 0x7d, 0x73, 0xd0, 0xef, 0xf7, 0x1a, 0x05, 0xf4, 0x39,
+	0x68, 0x69, 0xf7, 0xfe, 0xd9, 0x91, 0x79, 0xd8, 0xeb, 0x0f, 0x8f, 0xe3, 0xa8, 0x72, 0x70, 0xfc,
+	0xee, 0xbe, 0xa5, 0xbc, 0xbf, 0x6f, 0x29, 0x7f, 0xde, 0xb7, 0x94, 0xb7, 0x0f, 0xad, 0xc2, 0xfb,
+	0x87, 0x56, 0xe1, 0xb7, 0x87, 0x56, 0xe1, 0xf2, 0xeb, 0x4c, 0x56, 0x43, 0x7f, 0xbe, 0xf0, 0x89,
+	0x7c, 0xc3, 0xf8, 0x75, 0xd4, 0xe8, 0xdc, 0x3e, 0x7a, 0x78, 0x8f, 0xca, 0x51, 0xd2, 0xbe, 0xf9,
+	0x67, 0x00, 0x3a, 0x35, 0x1e, 0x1d, 0x94, 0x0b, 0x00, 0x00,
 }
 
 func (m *MTP) Marshal() (dAtA []byte, err error) {
@@ -293,6 +547,49 @@ func (m *MTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastInterestHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastInterestHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size := m.LastFundingIndex.Size()
+		i -= size
+		if _, err := m.LastFundingIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.UnitHealthPrice.Size()
+		i -= size
+		if _, err := m.UnitHealthPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.StopLossPrice.Size()
+		i -= size
+		if _, err := m.StopLossPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
 	{
 		size := m.TakeProfitPrice.Size()
 		i -= size
@@ -480,7 +777,7 @@ func (m *MTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WhiteList) Marshal() (dAtA []byte, err error) {
+func (m *BadDebt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -490,68 +787,260 @@ func (m *WhiteList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WhiteList) MarshalTo(dAtA []byte) (int, error) {
+func (m *BadDebt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WhiteList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BadDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorList) > 0 {
-		for iNdEx := len(m.ValidatorList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ValidatorList[iNdEx])
-			copy(dAtA[i:], m.ValidatorList[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorList[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Socialised.Size()
+		i -= size
+		if _, err := m.Socialised.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Covered.Size()
+		i -= size
+		if _, err := m.Covered.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Shortfall.Size()
+		i -= size
+		if _, err := m.Shortfall.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.AmmPoolId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AmmPoolId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MtpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MtpId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MarginOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MTP) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MarginOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarginOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.AmmPoolId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AmmPoolId))
+		i--
+		dAtA[i] = 0x58
 	}
-	if len(m.Collaterals) > 0 {
-		for _, e := range m.Collaterals {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if m.Expiry != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
 		}
+		i -= n1
+		i = encodeVarintTypes(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = m.Liabilities.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if len(m.InterestPaidCollaterals) > 0 {
-		for _, e := range m.InterestPaidCollaterals {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if len(m.InterestPaidCustodies) > 0 {
-		for _, e := range m.InterestPaidCustodies {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.StopLossPrice.Size()
+		i -= size
+		if _, err := m.StopLossPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TakeProfitPrice.Size()
+		i -= size
+		if _, err := m.TakeProfitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Position != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BorrowAsset) > 0 {
+		i -= len(m.BorrowAsset)
+		copy(dAtA[i:], m.BorrowAsset)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BorrowAsset)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WhiteList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhiteList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhiteList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorList) > 0 {
+		for iNdEx := len(m.ValidatorList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorList[iNdEx])
+			copy(dAtA[i:], m.ValidatorList[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MTP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Collaterals) > 0 {
+		for _, e := range m.Collaterals {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.Liabilities.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.InterestPaidCollaterals) > 0 {
+		for _, e := range m.InterestPaidCollaterals {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.InterestPaidCustodies) > 0 {
+		for _, e := range m.InterestPaidCustodies {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
 	}
 	if len(m.InterestUnpaidCollaterals) > 0 {
 		for _, e := range m.InterestUnpaidCollaterals {
@@ -596,6 +1085,86 @@ func (m *MTP) Size() (n int) {
 	n += 2 + l + sovTypes(uint64(l))
 	l = m.TakeProfitPrice.Size()
 	n += 2 + l + sovTypes(uint64(l))
+	l = m.StopLossPrice.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	l = m.UnitHealthPrice.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	l = m.LastFundingIndex.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	if m.LastInterestHeight != 0 {
+		n += 2 + sovTypes(uint64(m.LastInterestHeight))
+	}
+	return n
+}
+
+func (m *BadDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MtpId != 0 {
+		n += 1 + sovTypes(uint64(m.MtpId))
+	}
+	if m.AmmPoolId != 0 {
+		n += 1 + sovTypes(uint64(m.AmmPoolId))
+	}
+	l = m.Shortfall.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Covered.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Socialised.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *MarginOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.BorrowAsset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovTypes(uint64(m.Position))
+	}
+	l = m.Leverage.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TakeProfitPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.StopLossPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AmmPoolId != 0 {
+		n += 1 + sovTypes(uint64(m.AmmPoolId))
+	}
 	return n
 }
 
@@ -1182,6 +1751,763 @@ func (m *MTP) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopLossPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StopLossPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitHealthPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnitHealthPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFundingIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastFundingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInterestHeight", wireType)
+			}
+			m.LastInterestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastInterestHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BadDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BadDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BadDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MtpId", wireType)
+			}
+			m.MtpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MtpId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmPoolId", wireType)
+			}
+			m.AmmPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmmPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shortfall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Covered", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Covered.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Socialised", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Socialised.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarginOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarginOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarginOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorrowAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= Position(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeProfitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakeProfitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopLossPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StopLossPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmPoolId", wireType)
+			}
+			m.AmmPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmmPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -112,3 +112,33 @@ func (k Keeper) GetAssetPriceFromDenom(ctx sdk.Context, denom string) sdk.Dec {
 	}
 	return price.Price.Quo(Pow10(info.Decimal))
 }
+
+// GetAssetPriceHistoryFromDenom returns up to limit of the latest prices of a denom recorded since
+// the given timestamp, read from the source of its latest price and sorted by timestamp
+func (k Keeper) GetAssetPriceHistoryFromDenom(ctx sdk.Context, denom string, since uint64, limit int) (prices []sdk.Dec) {
+	info, found := k.GetAssetInfo(ctx, denom)
+	if !found {
+		return nil
+	}
+	latest, found := k.GetAssetPrice(ctx, info.Display)
+	if !found {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	end := append(types.PriceKeyPrefixAssetAndSource(latest.Asset, latest.Source), []byte("/")...)
+	iterator := store.ReverseIterator(types.PriceKey(latest.Asset, latest.Source, since), sdk.PrefixEndBytes(end))
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(prices) < limit; iterator.Next() {
+		var val types.Price
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		prices = append(prices, val.Price.Quo(Pow10(info.Decimal)))
+	}
+
+	// latest first so far
+	for i, j := 0, len(prices)-1; i < j; i, j = i+1, j-1 {
+		prices[i], prices[j] = prices[j], prices[i]
+	}
+	return prices
+}